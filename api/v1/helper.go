@@ -18,6 +18,8 @@ package v1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -33,6 +35,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	intstrutil "k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +44,7 @@ import (
 
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/render"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/schedule"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
 )
 
@@ -285,6 +290,93 @@ func NeedToUpdateSriov(ifaceSpec *Interface, ifaceStatus *InterfaceExt) bool {
 	return false
 }
 
+// GetVfAttributesToUpdate returns, by VF ID, the administrative attributes of the VF groups
+// for the VFs whose attributes drifted from the policy. The attributes are applied
+// in place through the PF so a drift doesn't require a drain or a reset of the PF.
+func GetVfAttributesToUpdate(ifaceSpec *Interface, ifaceStatus *InterfaceExt) map[int]*VfAttributes {
+	toUpdate := map[int]*VfAttributes{}
+	if ifaceSpec.NumVfs == 0 {
+		return toUpdate
+	}
+	for i := range ifaceStatus.VFs {
+		vfStatus := &ifaceStatus.VFs[i]
+		for _, groupSpec := range ifaceSpec.VfGroups {
+			if !IndexInRange(vfStatus.VfID, groupSpec.VfRange) {
+				continue
+			}
+			if attr := groupSpec.VfAttributes.mismatch(vfStatus); attr != "" {
+				log.V(0).Info("GetVfAttributesToUpdate(): VF attribute needs update",
+					"address", ifaceStatus.PciAddress, "vf", vfStatus.VfID, "attribute", attr)
+				toUpdate[vfStatus.VfID] = groupSpec.VfAttributes
+			}
+			break
+		}
+	}
+	return toUpdate
+}
+
+// NeedToUpdateVfAttributes returns true if the administrative attributes of a VF drifted from the policy
+func NeedToUpdateVfAttributes(ifaceSpec *Interface, ifaceStatus *InterfaceExt) bool {
+	return len(GetVfAttributesToUpdate(ifaceSpec, ifaceStatus)) > 0
+}
+
+// mismatch returns the name of the first administrative attribute of the VF
+// that differs from the desired one, empty if all the managed attributes match
+func (a *VfAttributes) mismatch(vf *VirtualFunction) string {
+	if a == nil {
+		return ""
+	}
+	if a.Vlan != nil && *a.Vlan != vf.Vlan {
+		return "vlan"
+	}
+	if a.VlanQoS != nil && *a.VlanQoS != vf.VlanQoS {
+		return "vlanQoS"
+	}
+	if a.SpoofChk != nil && vf.SpoofChk != nil && *a.SpoofChk != *vf.SpoofChk {
+		return "spoofChk"
+	}
+	if a.Trust != nil && vf.Trust != nil && *a.Trust != *vf.Trust {
+		return "trust"
+	}
+	if a.MinTxRate != nil && *a.MinTxRate != vf.MinTxRate {
+		return "minTxRate"
+	}
+	if a.MaxTxRate != nil && *a.MaxTxRate != vf.MaxTxRate {
+		return "maxTxRate"
+	}
+	return ""
+}
+
+// String returns the attributes defined in the policy
+func (a *VfAttributes) String() string {
+	if a == nil {
+		return "<nil>"
+	}
+	attrs := []string{}
+	add := func(name string, v interface{}) {
+		attrs = append(attrs, fmt.Sprintf("%s:%v", name, v))
+	}
+	if a.Vlan != nil {
+		add("vlan", *a.Vlan)
+	}
+	if a.VlanQoS != nil {
+		add("vlanQoS", *a.VlanQoS)
+	}
+	if a.SpoofChk != nil {
+		add("spoofChk", *a.SpoofChk)
+	}
+	if a.Trust != nil {
+		add("trust", *a.Trust)
+	}
+	if a.MinTxRate != nil {
+		add("minTxRate", *a.MinTxRate)
+	}
+	if a.MaxTxRate != nil {
+		add("maxTxRate", *a.MaxTxRate)
+	}
+	return "{" + strings.Join(attrs, " ") + "}"
+}
+
 type ByPriority []SriovNetworkNodePolicy
 
 func (a ByPriority) Len() int {
@@ -302,7 +394,7 @@ func (a ByPriority) Swap(i, j int) {
 	a[i], a[j] = a[j], a[i]
 }
 
-// Match check if node is selected by NodeSelector
+// Match check if node is selected by NodeSelector and NodeAffinity
 func (p *SriovNetworkNodePolicy) Selected(node *corev1.Node) bool {
 	for k, v := range p.Spec.NodeSelector {
 		if nv, ok := node.Labels[k]; ok && nv == v {
@@ -310,9 +402,98 @@ func (p *SriovNetworkNodePolicy) Selected(node *corev1.Node) bool {
 		}
 		return false
 	}
+	if p.Spec.NodeAffinity == nil {
+		return true
+	}
+	return slices.ContainsFunc(p.Spec.NodeAffinity.NodeSelectorTerms, func(term corev1.NodeSelectorTerm) bool {
+		return nodeSelectorTermMatches(&term, node)
+	})
+}
+
+// nodeSelectorTermMatches returns true if the node matches all the expressions of the term,
+// an empty or invalid term matches no node
+func nodeSelectorTermMatches(term *corev1.NodeSelectorTerm, node *corev1.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	expressions, err := nodeSelectorRequirementsAsSelector(term.MatchExpressions)
+	if err != nil || !expressions.Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	fields, err := nodeSelectorRequirementsAsSelector(term.MatchFields)
+	if err != nil || !fields.Matches(labels.Set{nodeFieldName: node.Name}) {
+		return false
+	}
 	return true
 }
 
+// nodeFieldName is the only node field supported by the matchFields of the node selector terms
+const nodeFieldName = "metadata.name"
+
+// nodeSelectorRequirementsAsSelector converts the node selector requirements to a label selector
+func nodeSelectorRequirementsAsSelector(reqs []corev1.NodeSelectorRequirement) (labels.Selector, error) {
+	selector := labels.NewSelector()
+	for _, req := range reqs {
+		var op selection.Operator
+		switch req.Operator {
+		case corev1.NodeSelectorOpIn:
+			op = selection.In
+		case corev1.NodeSelectorOpNotIn:
+			op = selection.NotIn
+		case corev1.NodeSelectorOpExists:
+			op = selection.Exists
+		case corev1.NodeSelectorOpDoesNotExist:
+			op = selection.DoesNotExist
+		case corev1.NodeSelectorOpGt:
+			op = selection.GreaterThan
+		case corev1.NodeSelectorOpLt:
+			op = selection.LessThan
+		default:
+			return nil, fmt.Errorf("%q is not a valid node selector operator", req.Operator)
+		}
+		r, err := labels.NewRequirement(req.Key, op, req.Values)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*r)
+	}
+	return selector, nil
+}
+
+// ValidateNodeAffinity checks the node selector terms can be evaluated, every term must have
+// at least one expression and only the metadata.name field can be matched
+func ValidateNodeAffinity(affinity *corev1.NodeSelector) error {
+	if affinity == nil {
+		return nil
+	}
+	if len(affinity.NodeSelectorTerms) == 0 {
+		return fmt.Errorf("nodeAffinity must have at least one node selector term")
+	}
+	for i, term := range affinity.NodeSelectorTerms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			return fmt.Errorf("node selector term %d must have matchExpressions or matchFields", i)
+		}
+		if _, err := nodeSelectorRequirementsAsSelector(term.MatchExpressions); err != nil {
+			return fmt.Errorf("invalid matchExpressions in node selector term %d: %v", i, err)
+		}
+		for _, req := range term.MatchFields {
+			if req.Key != nodeFieldName {
+				return fmt.Errorf("invalid matchFields in node selector term %d: only %s is supported, got %s", i, nodeFieldName, req.Key)
+			}
+		}
+		if _, err := nodeSelectorRequirementsAsSelector(term.MatchFields); err != nil {
+			return fmt.Errorf("invalid matchFields in node selector term %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// IsDryRun returns true if the policy has the dry-run annotation,
+// dry-run policies are not applied to the nodes
+func (p *SriovNetworkNodePolicy) IsDryRun() bool {
+	return p.GetAnnotations()[consts.PolicyDryRunAnnotation] == "true"
+}
+
 func StringInArray(val string, array []string) bool {
 	for i := range array {
 		if array[i] == val {
@@ -346,13 +527,21 @@ func UniqueAppend(inSlice []string, strings ...string) []string {
 
 // Apply policy to SriovNetworkNodeState CR
 func (p *SriovNetworkNodePolicy) Apply(state *SriovNetworkNodeState, equalPriority bool) error {
+	return p.ApplyKeepingPfs(state, equalPriority, nil)
+}
+
+// ApplyKeepingPfs applies the policy to the SriovNetworkNodeState CR like Apply.
+// The PFs in configuredPfs, already configured by the policy, stay selected when their link speed or
+// firmware version no longer match the nicSelector, a link down or a firmware upgrade must not reset
+// the PF and destroy the VFs used by the pods.
+func (p *SriovNetworkNodePolicy) ApplyKeepingPfs(state *SriovNetworkNodeState, equalPriority bool, configuredPfs []string) error {
 	s := p.Spec.NicSelector
 	if s.IsEmpty() {
 		// Empty NicSelector match none
 		return nil
 	}
 	for _, iface := range state.Status.Interfaces {
-		if s.Selected(&iface) {
+		if s.Selected(&iface) || (slices.Contains(configuredPfs, iface.PciAddress) && s.SelectedIgnoringVolatileFields(&iface)) {
 			log.Info("Update interface", "name:", iface.Name)
 			result := Interface{
 				PciAddress:        iface.PciAddress,
@@ -362,6 +551,10 @@ func (p *SriovNetworkNodePolicy) Apply(state *SriovNetworkNodeState, equalPriori
 				EswitchMode:       p.Spec.EswitchMode,
 				NumVfs:            p.Spec.NumVfs,
 				ExternallyManaged: p.Spec.ExternallyManaged,
+				Intel:             p.Spec.Intel,
+				Mellanox:          p.Spec.Mellanox,
+				DevlinkParams:     p.Spec.DevlinkParams,
+				Ethtool:           p.Spec.Ethtool.GetPF(),
 			}
 			if p.Spec.NumVfs > 0 {
 				group, err := p.generatePfNameVfGroup(&iface)
@@ -405,52 +598,157 @@ func (p *SriovNetworkNodePolicy) ApplyBridgeConfig(state *SriovNetworkNodeState)
 			return fmt.Errorf("software bridge management can't be used when link is externally managed")
 		}
 	}
+	if p.Spec.Bridge.OVS != nil && p.Spec.Bridge.OVS.Bond != nil {
+		p.applyBondBridgeConfig(state)
+		return nil
+	}
+	skipBridges := false
+	if p.Spec.Bridge.OVS != nil && len(p.Spec.Bridge.OVS.Ports) > 0 {
+		selected := 0
+		for i := range state.Status.Interfaces {
+			if p.Spec.NicSelector.Selected(&state.Status.Interfaces[i]) {
+				selected++
+			}
+		}
+		// the port names must be unique on the node, only this node is left without the bridges of the policy
+		if selected > 1 {
+			log.Info("additional OVS ports can't be used when the policy creates a bridge for each of the selected PFs, skip the bridges on the node",
+				"policy", p.Name, "node", state.Name, "selected", selected)
+			skipBridges = true
+		}
+	}
 	for _, iface := range state.Status.Interfaces {
 		if p.Spec.NicSelector.Selected(&iface) {
-			if p.Spec.Bridge.OVS == nil {
+			// a PF can be an uplink of a single bridge only, detach it from the bridges
+			// configured by the policies with lower priority
+			removeUplinkFromBridges(state, iface.PciAddress)
+			if p.Spec.Bridge.OVS == nil || skipBridges {
 				// The policy has no OVS bridge config, this means that the node's state should have no managed OVS bridges for the interfaces that match the policy.
-				// Currently PF to OVS bridge mapping is always 1 to 1 (bonding is not supported at the moment), meaning we can remove the OVS bridge
-				// config from the node's state if it has the interface (that matches "empty-bridge" policy) in the uplink section.
-				state.Spec.Bridges.OVS = slices.DeleteFunc(state.Spec.Bridges.OVS, func(br OVSConfigExt) bool {
-					return slices.ContainsFunc(br.Uplinks, func(uplink OVSUplinkConfigExt) bool {
-						return uplink.PciAddress == iface.PciAddress
-					})
-				})
-				if len(state.Spec.Bridges.OVS) == 0 {
-					state.Spec.Bridges.OVS = nil
-				}
 				continue
 			}
 			ovsBridge := OVSConfigExt{
-				Name:   GenerateBridgeName(&iface),
-				Bridge: p.Spec.Bridge.OVS.Bridge,
-				Uplinks: []OVSUplinkConfigExt{{
-					PciAddress: iface.PciAddress,
-					Name:       iface.Name,
-					Interface:  p.Spec.Bridge.OVS.Uplink.Interface,
-				}},
-			}
-			if p.Spec.Mtu > 0 {
-				mtu := p.Spec.Mtu
-				ovsBridge.Uplinks[0].Interface.MTURequest = &mtu
+				Name:    GenerateBridgeName(&iface),
+				Bridge:  p.Spec.Bridge.OVS.Bridge,
+				Uplinks: []OVSUplinkConfigExt{p.generateUplinkConfig(&iface)},
+				Ports:   p.Spec.Bridge.OVS.Ports,
 			}
 			log.Info("Update bridge for interface", "name", iface.Name, "bridge", ovsBridge.Name)
-
-			// We need to keep slices with bridges ordered to avoid unnecessary updates in the K8S API.
-			// Use binary search to insert (or update) the bridge config to the right place in the slice to keep it sorted.
-			pos, exist := slices.BinarySearchFunc(state.Spec.Bridges.OVS, ovsBridge, func(x, y OVSConfigExt) int {
-				return strings.Compare(x.Name, y.Name)
-			})
-			if exist {
-				state.Spec.Bridges.OVS[pos] = ovsBridge
-			} else {
-				state.Spec.Bridges.OVS = slices.Insert(state.Spec.Bridges.OVS, pos, ovsBridge)
-			}
+			insertBridge(state, ovsBridge)
 		}
 	}
 	return nil
 }
 
+// applyBondBridgeConfig adds all PFs that match the policy (and the bond members list, if set)
+// to a single bridge as members of the bond
+func (p *SriovNetworkNodePolicy) applyBondBridgeConfig(state *SriovNetworkNodeState) {
+	bond := p.Spec.Bridge.OVS.Bond
+	var members []InterfaceExt
+	for _, iface := range state.Status.Interfaces {
+		if !p.Spec.NicSelector.Selected(&iface) {
+			continue
+		}
+		if len(bond.Members) > 0 && !slices.Contains(bond.Members, iface.PciAddress) {
+			continue
+		}
+		members = append(members, iface)
+	}
+	if len(members) == 0 {
+		return
+	}
+	slices.SortFunc(members, func(x, y InterfaceExt) int {
+		return strings.Compare(x.PciAddress, y.PciAddress)
+	})
+	// the bridge is named after the policy and the bond, a bridge of a single PF set by another policy
+	// can't take the same name
+	ovsBridge := OVSConfigExt{
+		Name:   GenerateBondBridgeName(p.Name, bond.Name),
+		Bridge: p.Spec.Bridge.OVS.Bridge,
+		Ports:  p.Spec.Bridge.OVS.Ports,
+		Bond: &OVSBondConfigExt{
+			Name:        bond.Name,
+			Mode:        bond.Mode,
+			LACP:        bond.LACP,
+			OtherConfig: bond.OtherConfig,
+		},
+	}
+	if ovsBridge.Bond.Name == "" {
+		ovsBridge.Bond.Name = GenerateBondName(ovsBridge.Name)
+	}
+	for i := range members {
+		removeUplinkFromBridges(state, members[i].PciAddress)
+		ovsBridge.Uplinks = append(ovsBridge.Uplinks, p.generateUplinkConfig(&members[i]))
+	}
+	unbondSingleUplink(&ovsBridge)
+	log.Info("Update bond bridge for interfaces", "bridge", ovsBridge.Name, "members", len(members))
+	insertBridge(state, ovsBridge)
+}
+
+// unbondSingleUplink converts a bond bridge with a single member left to a bridge with a plain uplink,
+// named after the PF like the bridges created for a single PF
+func unbondSingleUplink(br *OVSConfigExt) {
+	if br.Bond == nil || len(br.Uplinks) != 1 {
+		return
+	}
+	br.Bond = nil
+	br.Name = GenerateBridgeName(&InterfaceExt{PciAddress: br.Uplinks[0].PciAddress})
+}
+
+// generateUplinkConfig returns uplink configuration from the policy for the provided PF
+func (p *SriovNetworkNodePolicy) generateUplinkConfig(iface *InterfaceExt) OVSUplinkConfigExt {
+	uplink := OVSUplinkConfigExt{
+		PciAddress: iface.PciAddress,
+		Name:       iface.Name,
+		Interface:  p.Spec.Bridge.OVS.Uplink.Interface,
+	}
+	if p.Spec.Mtu > 0 {
+		mtu := p.Spec.Mtu
+		uplink.Interface.MTURequest = &mtu
+	}
+	return uplink
+}
+
+// removeUplinkFromBridges removes the PF from the uplinks of the bridges in the state,
+// bridges without uplinks are removed from the state and bonds with a single member left
+// are converted to plain uplinks
+func removeUplinkFromBridges(state *SriovNetworkNodeState, pciAddress string) {
+	hasUplink := func(uplink OVSUplinkConfigExt) bool {
+		return uplink.PciAddress == pciAddress
+	}
+	state.Spec.Bridges.OVS = slices.DeleteFunc(state.Spec.Bridges.OVS, func(br OVSConfigExt) bool {
+		return len(br.Uplinks) == 1 && hasUplink(br.Uplinks[0])
+	})
+	for i := range state.Spec.Bridges.OVS {
+		br := &state.Spec.Bridges.OVS[i]
+		if slices.ContainsFunc(br.Uplinks, hasUplink) {
+			// copy uplinks to keep the slice shared with other objects unchanged
+			br.Uplinks = slices.DeleteFunc(slices.Clone(br.Uplinks), hasUplink)
+			unbondSingleUplink(br)
+		}
+	}
+	// keep the bridges sorted by name after the renames
+	slices.SortFunc(state.Spec.Bridges.OVS, func(x, y OVSConfigExt) int {
+		return strings.Compare(x.Name, y.Name)
+	})
+	if len(state.Spec.Bridges.OVS) == 0 {
+		state.Spec.Bridges.OVS = nil
+	}
+}
+
+// insertBridge inserts (or updates) the bridge config to the state
+func insertBridge(state *SriovNetworkNodeState, ovsBridge OVSConfigExt) {
+	// We need to keep slices with bridges ordered to avoid unnecessary updates in the K8S API.
+	// Use binary search to insert (or update) the bridge config to the right place in the slice to keep it sorted.
+	pos, exist := slices.BinarySearchFunc(state.Spec.Bridges.OVS, ovsBridge, func(x, y OVSConfigExt) int {
+		return strings.Compare(x.Name, y.Name)
+	})
+	if exist {
+		state.Spec.Bridges.OVS[pos] = ovsBridge
+	} else {
+		state.Spec.Bridges.OVS = slices.Insert(state.Spec.Bridges.OVS, pos, ovsBridge)
+	}
+}
+
 // mergeConfigs merges configs from multiple polices where the last one has the
 // highest priority. This merge is dependent on: 1. SR-IOV partition is
 // configured with the #-notation in pfName, 2. The VF groups are
@@ -479,6 +777,192 @@ func (iface Interface) mergeConfigs(input *Interface, equalPriority bool) {
 	if input.NumVfs < iface.NumVfs {
 		input.NumVfs = iface.NumVfs
 	}
+	// the NIC settings are taken from the highest priority policy setting them
+	if input.Intel == nil {
+		input.Intel = iface.Intel
+	}
+	if input.Mellanox == nil {
+		input.Mellanox = iface.Mellanox
+	}
+	input.DevlinkParams = mergeDevlinkParams(input.DevlinkParams, iface.DevlinkParams)
+	if input.Ethtool == nil {
+		input.Ethtool = iface.Ethtool
+	}
+}
+
+// mergeDevlinkParams returns the devlink parameters of both lists, the parameters of the highest priority list
+// take precedence over the parameters with the same name
+func mergeDevlinkParams(highPriority, lowPriority []DevlinkParam) []DevlinkParam {
+	result := slices.Clone(highPriority)
+	for _, param := range lowPriority {
+		if !slices.ContainsFunc(result, func(p DevlinkParam) bool { return p.Name == param.Name }) {
+			result = append(result, param)
+		}
+	}
+	return result
+}
+
+// GetConflicts returns the conflicts of the policy with the other policies selecting the node on the PFs
+// of the node state, the Nodes field of the conflicts is set to the name of the node.
+// The default and the dry-run policies are never applied to the nodes and don't conflict.
+func (p *SriovNetworkNodePolicy) GetConflicts(policies []SriovNetworkNodePolicy, node *corev1.Node, state *SriovNetworkNodeState) []PolicyConflict {
+	if !p.isAppliedToPFs() || !p.Selected(node) {
+		return nil
+	}
+	conflicts := []PolicyConflict{}
+	for i := range policies {
+		other := &policies[i]
+		if other.Name == p.Name || !other.isAppliedToPFs() || !other.Selected(node) {
+			continue
+		}
+		// the policies are applied by priority, the last one applied takes precedence
+		overridden := other.Spec.Priority < p.Spec.Priority ||
+			(other.Spec.Priority == p.Spec.Priority && other.Name > p.Name)
+		add := func(reason, message string) {
+			if slices.ContainsFunc(conflicts, func(c PolicyConflict) bool { return c.Policy == other.Name && c.Reason == reason }) {
+				return
+			}
+			conflicts = append(conflicts, PolicyConflict{Policy: other.Name, Reason: reason, Message: message,
+				Overridden: overridden, Nodes: []string{node.Name}})
+		}
+
+		// the device plugin exposes a resource with the selectors of the first policy
+		if p.Spec.ResourceName == other.Spec.ResourceName &&
+			(getDeviceType(p) != getDeviceType(other) || p.Spec.IsRdma != other.Spec.IsRdma) {
+			add(consts.PolicyConflictResourceName, fmt.Sprintf("resource %s is also exposed by policy %s with deviceType %s and isRdma %t",
+				p.Spec.ResourceName, other.Name, getDeviceType(other), other.Spec.IsRdma))
+		}
+
+		for j := range state.Status.Interfaces {
+			iface := &state.Status.Interfaces[j]
+			if !p.Spec.NicSelector.Selected(iface) || !other.Spec.NicSelector.Selected(iface) {
+				continue
+			}
+			group, err := p.generatePfNameVfGroup(iface)
+			if err != nil {
+				continue
+			}
+			otherGroup, err := other.generatePfNameVfGroup(iface)
+			if err != nil {
+				continue
+			}
+			switch {
+			case group.ResourceName == otherGroup.ResourceName:
+				// a PF has a single VF group per resource
+				add(consts.PolicyConflictResourceName, fmt.Sprintf("resource %s of PF %s is also configured by policy %s",
+					group.ResourceName, iface.Name, other.Name))
+			case group.isVFRangeOverlapping(*otherGroup):
+				add(consts.PolicyConflictVfRange, fmt.Sprintf("VF range %s of PF %s overlaps VF range %s of policy %s",
+					group.VfRange, iface.Name, otherGroup.VfRange, other.Name))
+			}
+			if p.Spec.Mtu > 0 && other.Spec.Mtu > 0 && p.Spec.Mtu != other.Spec.Mtu {
+				add(consts.PolicyConflictMtu, fmt.Sprintf("mtu %d of PF %s conflicts with mtu %d of policy %s",
+					p.Spec.Mtu, iface.Name, other.Spec.Mtu, other.Name))
+			}
+			if p.Spec.LinkType != "" && other.Spec.LinkType != "" && !strings.EqualFold(p.Spec.LinkType, other.Spec.LinkType) {
+				add(consts.PolicyConflictLinkType, fmt.Sprintf("linkType %s of PF %s conflicts with linkType %s of policy %s",
+					p.Spec.LinkType, iface.Name, other.Spec.LinkType, other.Name))
+			}
+			if getEswitchMode(p) != getEswitchMode(other) {
+				add(consts.PolicyConflictEswitchMode, fmt.Sprintf("eSwitchMode %s of PF %s conflicts with eSwitchMode %s of policy %s",
+					getEswitchMode(p), iface.Name, getEswitchMode(other), other.Name))
+			}
+		}
+	}
+	return conflicts
+}
+
+// isAppliedToPFs returns true if the policy configures the PFs it selects on the nodes
+func (p *SriovNetworkNodePolicy) isAppliedToPFs() bool {
+	return p.Name != consts.DefaultPolicyName && !p.IsDryRun() && p.Spec.NumVfs > 0 && !p.Spec.NicSelector.IsEmpty()
+}
+
+func getDeviceType(p *SriovNetworkNodePolicy) string {
+	if p.Spec.DeviceType == "" {
+		return consts.DeviceTypeNetDevice
+	}
+	return p.Spec.DeviceType
+}
+
+func getEswitchMode(p *SriovNetworkNodePolicy) string {
+	if p.Spec.EswitchMode == "" {
+		return ESwithModeLegacy
+	}
+	return p.Spec.EswitchMode
+}
+
+// MergePolicyConflicts adds the conflicts to the list, the nodes of the conflicts
+// with the same policy and reason are merged. The returned list is sorted.
+func MergePolicyConflicts(list []PolicyConflict, conflicts ...PolicyConflict) []PolicyConflict {
+	for _, c := range conflicts {
+		i := slices.IndexFunc(list, func(l PolicyConflict) bool { return l.Policy == c.Policy && l.Reason == c.Reason })
+		if i < 0 {
+			c.Nodes = slices.Clone(c.Nodes)
+			list = append(list, c)
+			continue
+		}
+		for _, node := range c.Nodes {
+			if !slices.Contains(list[i].Nodes, node) {
+				list[i].Nodes = append(list[i].Nodes, node)
+			}
+		}
+	}
+	for i := range list {
+		sort.Strings(list[i].Nodes)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Policy != list[j].Policy {
+			return list[i].Policy < list[j].Policy
+		}
+		return list[i].Reason < list[j].Reason
+	})
+	return list
+}
+
+// GetCmode returns the configuration mode of the devlink parameter, runtime if not set
+func (p *DevlinkParam) GetCmode() string {
+	if p.Cmode == "" {
+		return consts.DevlinkParamCmodeRuntime
+	}
+	return p.Cmode
+}
+
+// GetDevlinkParams returns the devlink parameters to set on the PF, including the MSI-X vectors of the Intel settings.
+// The MSI-X vectors are a driverinit parameter, they are applied with the other driverinit parameters:
+// the VFs are removed, the driver of the device is reloaded and the VFs are created again.
+func (iface *Interface) GetDevlinkParams() []DevlinkParam {
+	if iface.Intel == nil || iface.Intel.MsixVecPerPfMax == nil {
+		return iface.DevlinkParams
+	}
+	return append(slices.Clone(iface.DevlinkParams), DevlinkParam{
+		Name:  consts.DevlinkParamIntelMsixVecPerPfMax,
+		Value: strconv.Itoa(*iface.Intel.MsixVecPerPfMax),
+		Cmode: consts.DevlinkParamCmodeDriverinit,
+	})
+}
+
+// GetPF returns a copy of the ethtool settings of the PFs, nil if not set
+func (c *EthtoolConfig) GetPF() *EthtoolSettings {
+	if c == nil {
+		return nil
+	}
+	return c.PF.DeepCopy()
+}
+
+// GetVFs returns a copy of the ethtool settings of the VFs, nil if not set
+func (c *EthtoolConfig) GetVFs() *EthtoolSettings {
+	if c == nil {
+		return nil
+	}
+	return c.VFs.DeepCopy()
+}
+
+// HasEthtoolSettings returns true if ethtool settings are requested for the PF or for one of its VF groups
+func (i *Interface) HasEthtoolSettings() bool {
+	if i.Ethtool != nil && !i.ExternallyManaged {
+		return true
+	}
+	return slices.ContainsFunc(i.VfGroups, func(g VfGroup) bool { return g.Ethtool != nil })
 }
 
 func (gr VfGroup) isVFRangeOverlapping(group VfGroup) bool {
@@ -529,6 +1013,8 @@ func (p *SriovNetworkNodePolicy) generatePfNameVfGroup(iface *InterfaceExt) (*Vf
 		Mtu:          p.Spec.Mtu,
 		IsRdma:       p.Spec.IsRdma,
 		VdpaType:     p.Spec.VdpaType,
+		VfAttributes: p.Spec.VfAttributes.DeepCopy(),
+		Ethtool:      p.Spec.Ethtool.GetVFs(),
 	}, nil
 }
 
@@ -587,7 +1073,19 @@ func (selector *SriovNetworkNicSelector) IsEmpty() bool {
 		selector.DeviceID == "" &&
 		len(selector.RootDevices) == 0 &&
 		len(selector.PfNames) == 0 &&
-		len(selector.NetFilter) == 0
+		len(selector.NetFilter) == 0 &&
+		!selector.HasPfStatusFields()
+}
+
+// HasPfStatusFields returns true if the selector matches properties of the PFs the device plugin
+// can't select on, only the PFs reported in the node state can be matched against them
+func (selector *SriovNetworkNicSelector) HasPfStatusFields() bool {
+	return len(selector.Drivers) > 0 ||
+		selector.MinLinkSpeed > 0 ||
+		len(selector.NumaNodes) > 0 ||
+		len(selector.FirmwareVersions) > 0 ||
+		len(selector.PhysicalSlots) > 0 ||
+		len(selector.PciLabels) > 0
 }
 
 func (selector *SriovNetworkNicSelector) Selected(iface *InterfaceExt) bool {
@@ -617,10 +1115,65 @@ func (selector *SriovNetworkNicSelector) Selected(iface *InterfaceExt) bool {
 	if selector.NetFilter != "" && !NetFilterMatch(selector.NetFilter, iface.NetFilter) {
 		return false
 	}
+	if len(selector.Drivers) > 0 && !slices.Contains(selector.Drivers, iface.Driver) {
+		return false
+	}
+	if selector.MinLinkSpeed > 0 {
+		speed, ok := iface.GetLinkSpeedMbps()
+		if !ok || speed < selector.MinLinkSpeed {
+			return false
+		}
+	}
+	if len(selector.NumaNodes) > 0 && (iface.NumaNode == nil || !slices.Contains(selector.NumaNodes, *iface.NumaNode)) {
+		return false
+	}
+	if len(selector.FirmwareVersions) > 0 && !matchesAnyPattern(selector.FirmwareVersions, iface.FirmwareVersion) {
+		return false
+	}
+	if len(selector.PhysicalSlots) > 0 && !matchesAnyPattern(selector.PhysicalSlots, iface.PhysicalSlot) {
+		return false
+	}
+	if len(selector.PciLabels) > 0 && !matchesAnyPattern(selector.PciLabels, iface.PciLabel) {
+		return false
+	}
 
 	return true
 }
 
+// SelectedIgnoringVolatileFields returns true if the PF is selected by the nicSelector when its link speed
+// and firmware version, which change with the link state or a firmware upgrade, are not considered
+func (selector *SriovNetworkNicSelector) SelectedIgnoringVolatileFields(iface *InterfaceExt) bool {
+	s := *selector
+	s.MinLinkSpeed = 0
+	s.FirmwareVersions = nil
+	return s.Selected(iface)
+}
+
+// matchesAnyPattern returns true if the value is not empty and matches one of the shell patterns
+func matchesAnyPattern(patterns []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		matched, err := filepath.Match(pattern, value)
+		return err == nil && matched
+	})
+}
+
+// GetLinkSpeedMbps returns the link speed of the PF in Mb/s,
+// false if the speed is unknown, e.g. when the link is down
+func (iface *InterfaceExt) GetLinkSpeedMbps() (int, bool) {
+	fields := strings.Fields(iface.LinkSpeed)
+	if len(fields) == 0 {
+		return 0, false
+	}
+	speed, err := strconv.Atoi(fields[0])
+	if err != nil || speed <= 0 {
+		return 0, false
+	}
+	return speed, true
+}
+
 func (s *SriovNetworkNodeState) GetInterfaceStateByPciAddress(addr string) *InterfaceExt {
 	for _, iface := range s.Status.Interfaces {
 		if addr == iface.PciAddress {
@@ -708,6 +1261,11 @@ func (cr *SriovIBNetwork) NetworkNamespace() string {
 	return cr.Spec.NetworkNamespace
 }
 
+// GetNetworkStatus returns the status of the NetworkAttachmentDefinition rendered for the network
+func (cr *SriovIBNetwork) GetNetworkStatus() *NetworkStatus {
+	return &cr.Status.NetworkStatus
+}
+
 // RenderNetAttDef renders a net-att-def for sriov CNI
 func (cr *SriovNetwork) RenderNetAttDef() (*uns.Unstructured, error) {
 	logger := log.WithName("RenderNetAttDef")
@@ -827,6 +1385,11 @@ func (cr *SriovNetwork) NetworkNamespace() string {
 	return cr.Spec.NetworkNamespace
 }
 
+// GetNetworkStatus returns the status of the NetworkAttachmentDefinition rendered for the network
+func (cr *SriovNetwork) GetNetworkStatus() *NetworkStatus {
+	return &cr.Status.NetworkStatus
+}
+
 // RenderNetAttDef renders a net-att-def for sriov CNI
 func (cr *OVSNetwork) RenderNetAttDef() (*uns.Unstructured, error) {
 	logger := log.WithName("RenderNetAttDef")
@@ -890,6 +1453,11 @@ func (cr *OVSNetwork) NetworkNamespace() string {
 	return cr.Spec.NetworkNamespace
 }
 
+// GetNetworkStatus returns the status of the NetworkAttachmentDefinition rendered for the network
+func (cr *OVSNetwork) GetNetworkStatus() *NetworkStatus {
+	return &cr.Status.NetworkStatus
+}
+
 // NetFilterMatch -- parse netFilter and check for a match
 func NetFilterMatch(netFilter string, netValue string) (isMatch bool) {
 	logger := log.WithName("NetFilterMatch")
@@ -913,6 +1481,49 @@ func NetFilterMatch(netFilter string, netValue string) (isMatch bool) {
 	return netFilterResult[0][1] == netValueResult[0][1] && netFilterResult[0][2] == netValueResult[0][2]
 }
 
+// NodeLabelSelector returns the selector of the nodes in the pool, a pool without node selector selects all the nodes
+func (s *SriovNetworkPoolConfig) NodeLabelSelector() (labels.Selector, error) {
+	nodeSelector := s.Spec.NodeSelector
+	if nodeSelector == nil {
+		nodeSelector = &metav1.LabelSelector{}
+	}
+	selector, err := metav1.LabelSelectorAsSelector(nodeSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create label selector for pool %s: %v", s.Name, err)
+	}
+	return selector, nil
+}
+
+// IsOvsHardwareOffloadPool returns true if the pool only configures the OVS hardware offload,
+// these pools don't manage the drain and the configuration of the nodes
+func (s *SriovNetworkPoolConfig) IsOvsHardwareOffloadPool() bool {
+	return s.Spec.OvsHardwareOffloadConfig.Name != ""
+}
+
+// FindNodePoolConfig returns the pool selecting the node, nil if the node is not part of any pool.
+// A node can't be part of more than one pool.
+func FindNodePoolConfig(node *corev1.Node, pools []SriovNetworkPoolConfig) (*SriovNetworkPoolConfig, error) {
+	var selected *SriovNetworkPoolConfig
+	for i := range pools {
+		npc := &pools[i]
+		if npc.IsOvsHardwareOffloadPool() {
+			continue
+		}
+		selector, err := npc.NodeLabelSelector()
+		if err != nil {
+			return nil, err
+		}
+		if !selector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		if selected != nil {
+			return nil, fmt.Errorf("node is part of more then one pool: %s, %s", selected.Name, npc.Name)
+		}
+		selected = npc
+	}
+	return selected, nil
+}
+
 // MaxUnavailable calculate the max number of unavailable nodes to represent the number of nodes
 // we can drain in parallel
 func (s *SriovNetworkPoolConfig) MaxUnavailable(numOfNodes int) (int, error) {
@@ -949,15 +1560,117 @@ func (s *SriovNetworkPoolConfig) MaxUnavailable(numOfNodes int) (int, error) {
 	return maxunavail, nil
 }
 
+// InMaintenanceWindow returns true if the drain and the reboot of the nodes in the pool can start at the given time.
+// Pools without maintenance windows are always open. When the pool is closed it also returns
+// the start of the next window, zero if no window will open.
+func (s *SriovNetworkPoolConfig) InMaintenanceWindow(now time.Time) (bool, time.Time, error) {
+	if len(s.Spec.MaintenanceWindows) == 0 {
+		return true, time.Time{}, nil
+	}
+
+	var next time.Time
+	for i := range s.Spec.MaintenanceWindows {
+		window := &s.Spec.MaintenanceWindows[i]
+		sched, loc, err := window.parse()
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		localNow := now.In(loc)
+		// the window is open if it started during the last duration
+		start := sched.Next(localNow.Add(-window.Duration.Duration))
+		if start.IsZero() {
+			continue
+		}
+		if !start.After(localNow) {
+			return true, time.Time{}, nil
+		}
+		if next.IsZero() || start.Before(next) {
+			next = start
+		}
+	}
+	return false, next, nil
+}
+
+// Validate checks the schedule, the duration and the time zone of the maintenance window
+func (w *MaintenanceWindow) Validate() error {
+	_, _, err := w.parse()
+	return err
+}
+
+func (w *MaintenanceWindow) parse() (*schedule.Schedule, *time.Location, error) {
+	if w.Duration.Duration <= 0 {
+		return nil, nil, fmt.Errorf("invalid maintenance window duration %q: must be positive", w.Duration.Duration)
+	}
+	sched, err := schedule.Parse(w.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid maintenance window: %v", err)
+	}
+	loc := time.UTC
+	if w.TimeZone != "" {
+		loc, err = time.LoadLocation(w.TimeZone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid maintenance window time zone %q: %v", w.TimeZone, err)
+		}
+	}
+	return sched, loc, nil
+}
+
 // GenerateBridgeName generate predictable name for the software bridge
 // current format is: br-0000_00_03.0
 func GenerateBridgeName(iface *InterfaceExt) string {
 	return fmt.Sprintf("br-%s", strings.ReplaceAll(iface.PciAddress, ":", "_"))
 }
 
-// NeedToUpdateBridges returns true if bridge for the host requires update
+// GenerateBondBridgeName generate predictable name for the software bridge with a bond of the policy,
+// the name fits in the size of a network interface name
+// current format is: br-bond-1a2b3c4
+func GenerateBondBridgeName(policyName, bondName string) string {
+	sum := sha256.Sum256([]byte(policyName + "/" + bondName))
+	return "br-bond-" + hex.EncodeToString(sum[:4])[:7]
+}
+
+// GenerateBondName generate predictable name for the bond port of the software bridge
+// current format is: br-bond-1a2b3c4-bond
+func GenerateBondName(bridgeName string) string {
+	return bridgeName + "-bond"
+}
+
+// NeedToUpdateBridges returns true if bridge for the host requires update,
+// the observed state of the bridges is reported only in the status and is not taken into account
 func NeedToUpdateBridges(bridgeSpec, bridgeStatus *Bridges) bool {
-	return !equality.Semantic.DeepEqual(bridgeSpec, bridgeStatus)
+	return !equality.Semantic.DeepEqual(withoutState(bridgeSpec), withoutState(bridgeStatus))
+}
+
+// withoutState returns a copy of the bridges without the observed state
+func withoutState(bridges *Bridges) *Bridges {
+	if bridges == nil {
+		return nil
+	}
+	result := bridges.DeepCopy()
+	for i := range result.OVS {
+		result.OVS[i].State = nil
+	}
+	return result
+}
+
+// NeedToUpdateBridgesIgnoringFlowsAndQoS returns true if bridge for the host requires update,
+// QoS and flows of the bridges are not taken into account because they can be updated without
+// re-creation of the bridges
+func NeedToUpdateBridgesIgnoringFlowsAndQoS(bridgeSpec, bridgeStatus *Bridges) bool {
+	return NeedToUpdateBridges(withoutFlowsAndQoS(bridgeSpec), withoutFlowsAndQoS(bridgeStatus))
+}
+
+// withoutFlowsAndQoS returns a copy of the bridges without QoS and flows configuration
+func withoutFlowsAndQoS(bridges *Bridges) *Bridges {
+	if bridges == nil {
+		return nil
+	}
+	result := bridges.DeepCopy()
+	for i := range result.OVS {
+		result.OVS[i].Bridge.QoS = nil
+		result.OVS[i].Bridge.Flows = nil
+	}
+	return result
 }
 
 // SetKeepUntilTime sets an annotation to hold the "keep until time" for the node’s state.
@@ -1000,6 +1713,339 @@ func (s *SriovNetworkNodeState) ResetKeepUntilTime() bool {
 	return true
 }
 
+// IsPaused returns true if the configuration of the node is paused by the paused annotation
+func (s *SriovNetworkNodeState) IsPaused() bool {
+	return s.GetAnnotations()[consts.NodeStatePausedAnnotation] == "true"
+}
+
+// SetPlannedSpec stores the spec rendered with the dry-run policies in the planned-spec annotation.
+// A nil spec removes the annotation. Returns true if the annotation changed.
+func (s *SriovNetworkNodeState) SetPlannedSpec(spec *SriovNetworkNodeStateSpec) (bool, error) {
+	annotations := s.GetAnnotations()
+	current, exist := annotations[consts.NodeStatePlannedSpecAnnotation]
+	if spec == nil {
+		if !exist {
+			return false, nil
+		}
+		delete(annotations, consts.NodeStatePlannedSpecAnnotation)
+		s.SetAnnotations(annotations)
+		return true, nil
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal planned spec: %w", err)
+	}
+	if exist && current == string(data) {
+		return false, nil
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[consts.NodeStatePlannedSpecAnnotation] = string(data)
+	s.SetAnnotations(annotations)
+	return true, nil
+}
+
+// GetPlannedSpec returns the spec stored in the planned-spec annotation and its hash.
+// Returns nil if the annotation is not set.
+func (s *SriovNetworkNodeState) GetPlannedSpec() (*SriovNetworkNodeStateSpec, string, error) {
+	data, exist := s.GetAnnotations()[consts.NodeStatePlannedSpecAnnotation]
+	if !exist {
+		return nil, "", nil
+	}
+	hash := PlannedSpecHash(data)
+	spec := &SriovNetworkNodeStateSpec{}
+	if err := json.Unmarshal([]byte(data), spec); err != nil {
+		return nil, hash, fmt.Errorf("failed to unmarshal planned spec: %w", err)
+	}
+	return spec, hash, nil
+}
+
+// PlannedSpecHash returns the hash used to match a plan with the planned-spec annotation it was computed for
+func PlannedSpecHash(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:8])
+}
+
+// IsPlanCurrent returns true if the plan in the status was computed for the current
+// planned-spec annotation and generation of the state object
+func (s *SriovNetworkNodeState) IsPlanCurrent() bool {
+	data, exist := s.GetAnnotations()[consts.NodeStatePlannedSpecAnnotation]
+	if !exist || s.Status.Plan == nil {
+		return false
+	}
+	return s.Status.Plan.PlannedSpecHash == PlannedSpecHash(data) &&
+		s.Status.Plan.ObservedGeneration == s.GetGeneration()
+}
+
+// SetAppliedOverride records the name of the SriovNetworkNodeOverride applied to the spec in the applied-override annotation.
+// An empty name removes the annotation. Returns true if the annotation changed.
+func (s *SriovNetworkNodeState) SetAppliedOverride(name string) bool {
+	annotations := s.GetAnnotations()
+	current, exist := annotations[consts.NodeStateAppliedOverrideAnnotation]
+	if name == "" {
+		if !exist {
+			return false
+		}
+		delete(annotations, consts.NodeStateAppliedOverrideAnnotation)
+		s.SetAnnotations(annotations)
+		return true
+	}
+	if exist && current == name {
+		return false
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[consts.NodeStateAppliedOverrideAnnotation] = name
+	s.SetAnnotations(annotations)
+	return true
+}
+
+// GetAppliedOverride returns the name of the SriovNetworkNodeOverride applied to the spec
+func (s *SriovNetworkNodeState) GetAppliedOverride() string {
+	return s.GetAnnotations()[consts.NodeStateAppliedOverrideAnnotation]
+}
+
+// Apply applies the overrides to the PFs configured by the policies in the SriovNetworkNodeState spec.
+// Returns the PFs changed by the overrides and the overrides that don't match any of them.
+func (o *SriovNetworkNodeOverride) Apply(state *SriovNetworkNodeState) (applied, unmatched []string) {
+	for _, override := range o.Spec.Interfaces {
+		idx := slices.IndexFunc(state.Spec.Interfaces, override.matches)
+		if idx < 0 {
+			unmatched = append(unmatched, override.String())
+			continue
+		}
+		applied = append(applied, state.Spec.Interfaces[idx].PciAddress)
+		if override.Exclude {
+			state.Spec.Interfaces = slices.Delete(state.Spec.Interfaces, idx, idx+1)
+			continue
+		}
+		override.apply(&state.Spec.Interfaces[idx])
+	}
+	return applied, unmatched
+}
+
+// String returns the PF selected by the override
+func (o *InterfaceOverride) String() string {
+	if o.PciAddress != "" {
+		return o.PciAddress
+	}
+	return o.Name
+}
+
+func (o *InterfaceOverride) matches(iface Interface) bool {
+	if o.PciAddress != "" {
+		return o.PciAddress == iface.PciAddress
+	}
+	return o.Name != "" && o.Name == iface.Name
+}
+
+func (o *InterfaceOverride) apply(iface *Interface) {
+	if o.NumVfs != nil {
+		iface.NumVfs = *o.NumVfs
+		iface.VfGroups = truncateVfGroups(iface.VfGroups, *o.NumVfs)
+	}
+	if o.Mtu != nil {
+		iface.Mtu = *o.Mtu
+		for i := range iface.VfGroups {
+			iface.VfGroups[i].Mtu = *o.Mtu
+		}
+	}
+	if o.LinkType != "" {
+		iface.LinkType = o.LinkType
+	}
+}
+
+// truncateVfGroups removes the VFs above numVfs from the VF ranges of the groups
+func truncateVfGroups(groups []VfGroup, numVfs int) []VfGroup {
+	truncated := []VfGroup{}
+	for _, group := range groups {
+		rngSt, rngEnd, err := parseRange(group.VfRange)
+		if err != nil || rngSt >= numVfs {
+			continue
+		}
+		if rngEnd >= numVfs {
+			group.VfRange = strconv.Itoa(rngSt) + "-" + strconv.Itoa(numVfs-1)
+		}
+		truncated = append(truncated, group)
+	}
+	return truncated
+}
+
+// DiffInterfaces returns the changes required to move from the current to the planned interfaces spec
+func DiffInterfaces(current, planned Interfaces) []InterfaceChange {
+	changes := []InterfaceChange{}
+	currentByPci := make(map[string]Interface, len(current))
+	for _, iface := range current {
+		currentByPci[iface.PciAddress] = iface
+	}
+	plannedByPci := make(map[string]Interface, len(planned))
+	for _, iface := range planned {
+		plannedByPci[iface.PciAddress] = iface
+	}
+
+	for _, iface := range planned {
+		cur, exist := currentByPci[iface.PciAddress]
+		if !exist {
+			changes = append(changes, InterfaceChange{
+				PciAddress: iface.PciAddress,
+				Name:       iface.Name,
+				Action:     consts.InterfaceChangeAdd,
+				Changes:    diffInterfaceFields(&Interface{}, &iface),
+			})
+			continue
+		}
+		fields := diffInterfaceFields(&cur, &iface)
+		if len(fields) == 0 {
+			continue
+		}
+		changes = append(changes, InterfaceChange{
+			PciAddress: iface.PciAddress,
+			Name:       iface.Name,
+			Action:     consts.InterfaceChangeUpdate,
+			Changes:    fields,
+		})
+	}
+
+	for _, iface := range current {
+		if _, exist := plannedByPci[iface.PciAddress]; exist {
+			continue
+		}
+		changes = append(changes, InterfaceChange{
+			PciAddress: iface.PciAddress,
+			Name:       iface.Name,
+			Action:     consts.InterfaceChangeRemove,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].PciAddress < changes[j].PciAddress })
+	return changes
+}
+
+func diffInterfaceFields(current, planned *Interface) []string {
+	fields := []string{}
+	diff := func(name string, c, p interface{}) {
+		if c != p {
+			fields = append(fields, fmt.Sprintf("%s: %v -> %v", name, c, p))
+		}
+	}
+	diff("name", current.Name, planned.Name)
+	diff("numVfs", current.NumVfs, planned.NumVfs)
+	diff("mtu", current.Mtu, planned.Mtu)
+	diff("linkType", current.LinkType, planned.LinkType)
+	diff("eSwitchMode", current.EswitchMode, planned.EswitchMode)
+	diff("externallyManaged", current.ExternallyManaged, planned.ExternallyManaged)
+	if !equality.Semantic.DeepEqual(current.Intel, planned.Intel) {
+		fields = append(fields, fmt.Sprintf("intel: %s -> %s", formatPlanValue(current.Intel), formatPlanValue(planned.Intel)))
+	}
+	if !equality.Semantic.DeepEqual(current.Mellanox, planned.Mellanox) {
+		fields = append(fields, fmt.Sprintf("mellanox: %s -> %s", formatPlanValue(current.Mellanox), formatPlanValue(planned.Mellanox)))
+	}
+	if !equality.Semantic.DeepEqual(current.DevlinkParams, planned.DevlinkParams) {
+		fields = append(fields, fmt.Sprintf("devlinkParams: %s -> %s", formatPlanValue(current.DevlinkParams), formatPlanValue(planned.DevlinkParams)))
+	}
+	if !equality.Semantic.DeepEqual(current.Ethtool, planned.Ethtool) {
+		fields = append(fields, fmt.Sprintf("ethtool: %s -> %s", formatPlanValue(current.Ethtool), formatPlanValue(planned.Ethtool)))
+	}
+
+	currentGroups := make(map[string]VfGroup, len(current.VfGroups))
+	for _, group := range current.VfGroups {
+		currentGroups[group.ResourceName] = group
+	}
+	plannedGroups := make(map[string]VfGroup, len(planned.VfGroups))
+	for _, group := range planned.VfGroups {
+		plannedGroups[group.ResourceName] = group
+		cur, exist := currentGroups[group.ResourceName]
+		if !exist {
+			fields = append(fields, fmt.Sprintf("vfGroup %s: added %s", group.ResourceName, formatPlanValue(group)))
+			continue
+		}
+		if !equality.Semantic.DeepEqual(cur, group) {
+			fields = append(fields, fmt.Sprintf("vfGroup %s: %s -> %s", group.ResourceName, formatPlanValue(cur), formatPlanValue(group)))
+		}
+	}
+	for _, group := range current.VfGroups {
+		if _, exist := plannedGroups[group.ResourceName]; !exist {
+			fields = append(fields, fmt.Sprintf("vfGroup %s: removed", group.ResourceName))
+		}
+	}
+	return fields
+}
+
+// DiffBridges returns the changes required to move from the current to the planned OVS bridges
+func DiffBridges(current, planned Bridges) []BridgeChange {
+	changes := []BridgeChange{}
+	currentByName := make(map[string]OVSConfigExt, len(current.OVS))
+	for _, br := range current.OVS {
+		currentByName[br.Name] = br
+	}
+	plannedByName := make(map[string]OVSConfigExt, len(planned.OVS))
+	for _, br := range planned.OVS {
+		plannedByName[br.Name] = br
+		cur, exist := currentByName[br.Name]
+		if !exist {
+			changes = append(changes, BridgeChange{
+				Name:    br.Name,
+				Action:  consts.InterfaceChangeAdd,
+				Changes: diffBridgeFields(&OVSConfigExt{}, &br),
+			})
+			continue
+		}
+		fields := diffBridgeFields(&cur, &br)
+		if len(fields) == 0 {
+			continue
+		}
+		changes = append(changes, BridgeChange{
+			Name:    br.Name,
+			Action:  consts.InterfaceChangeUpdate,
+			Changes: fields,
+		})
+	}
+	for _, br := range current.OVS {
+		if _, exist := plannedByName[br.Name]; !exist {
+			changes = append(changes, BridgeChange{Name: br.Name, Action: consts.InterfaceChangeRemove})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes
+}
+
+func diffBridgeFields(current, planned *OVSConfigExt) []string {
+	fields := []string{}
+	diff := func(name string, c, p interface{}) {
+		if !equality.Semantic.DeepEqual(c, p) {
+			fields = append(fields, fmt.Sprintf("%s: %s -> %s", name, formatPlanValue(c), formatPlanValue(p)))
+		}
+	}
+	diff("bridge", current.Bridge, planned.Bridge)
+	diff("uplinks", current.Uplinks, planned.Uplinks)
+	diff("bond", current.Bond, planned.Bond)
+	diff("ports", current.Ports, planned.Ports)
+	return fields
+}
+
+// DiffSystem returns the system settings that change between the current and the planned spec
+// in the "field: current -> planned" format
+func DiffSystem(current, planned System) []string {
+	fields := []string{}
+	if current.RdmaMode != planned.RdmaMode {
+		fields = append(fields, fmt.Sprintf("rdmaMode: %q -> %q", current.RdmaMode, planned.RdmaMode))
+	}
+	return fields
+}
+
+// formatPlanValue returns the JSON representation of a value reported in a plan,
+// pointers are reported with the value they point to
+func formatPlanValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	return string(data)
+}
+
 func OwnerRefToString(cr client.Object) string {
 	if cr == nil {
 		return "owner-object-not-found"
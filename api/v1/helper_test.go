@@ -23,12 +23,16 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	intstrutil "k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	v1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
@@ -776,6 +780,95 @@ func TestSriovNetworkNodePolicyApply(t *testing.T) {
 			equalP:             false,
 			expectedInterfaces: nil,
 		},
+		{
+			// the pf ethtool settings are set on the interface, the vf ones on the vf group of the policy
+			tname:        "ethtool settings of the pf and of the vfs",
+			currentState: newNodeState(),
+			policy: func() *v1.SriovNetworkNodePolicy {
+				policy := newNodePolicy()
+				policy.Spec.Ethtool = &v1.EthtoolConfig{
+					PF:  &v1.EthtoolSettings{Channels: &v1.EthtoolChannels{Combined: ptr.To(8)}},
+					VFs: &v1.EthtoolSettings{Features: map[string]bool{"rx-vlan-filter": false}},
+				}
+				return policy
+			}(),
+			equalP: false,
+			expectedInterfaces: []v1.Interface{
+				{
+					Name:       "ens803f1",
+					NumVfs:     2,
+					PciAddress: "0000:86:00.1",
+					VfGroups: []v1.VfGroup{
+						{
+							DeviceType:   consts.DeviceTypeNetDevice,
+							ResourceName: "p1res",
+							VfRange:      "0-1",
+							PolicyName:   "p1",
+							Ethtool:      &v1.EthtoolSettings{Features: map[string]bool{"rx-vlan-filter": false}},
+						},
+					},
+					Ethtool: &v1.EthtoolSettings{Channels: &v1.EthtoolChannels{Combined: ptr.To(8)}},
+				},
+			},
+		},
+		{
+			// the devlink params of the lower priority policies are kept if not set by the policy
+			tname: "devlink params merged on the same pf",
+			currentState: func() *v1.SriovNetworkNodeState {
+				st := newNodeState()
+				st.Spec.Interfaces = []v1.Interface{
+					{
+						Name:       "ens803f1",
+						NumVfs:     3,
+						PciAddress: "0000:86:00.1",
+						VfGroups: []v1.VfGroup{
+							{
+								DeviceType:   consts.DeviceTypeNetDevice,
+								ResourceName: "prevres",
+								VfRange:      "2-2",
+								PolicyName:   "p2",
+							},
+						},
+						DevlinkParams: []v1.DevlinkParam{
+							{Name: "max_macs", Value: "16"},
+							{Name: "enable_roce", Value: "false", Cmode: consts.DevlinkParamCmodeDriverinit},
+						},
+					},
+				}
+				return st
+			}(),
+			policy: func() *v1.SriovNetworkNodePolicy {
+				policy := newNodePolicy()
+				policy.Spec.DevlinkParams = []v1.DevlinkParam{{Name: "max_macs", Value: "32", Cmode: consts.DevlinkParamCmodeDriverinit}}
+				return policy
+			}(),
+			equalP: false,
+			expectedInterfaces: []v1.Interface{
+				{
+					Name:       "ens803f1",
+					NumVfs:     3,
+					PciAddress: "0000:86:00.1",
+					VfGroups: []v1.VfGroup{
+						{
+							DeviceType:   consts.DeviceTypeNetDevice,
+							ResourceName: "p1res",
+							VfRange:      "0-1",
+							PolicyName:   "p1",
+						},
+						{
+							DeviceType:   consts.DeviceTypeNetDevice,
+							ResourceName: "prevres",
+							VfRange:      "2-2",
+							PolicyName:   "p2",
+						},
+					},
+					DevlinkParams: []v1.DevlinkParam{
+						{Name: "max_macs", Value: "32", Cmode: consts.DevlinkParamCmodeDriverinit},
+						{Name: "enable_roce", Value: "false", Cmode: consts.DevlinkParamCmodeDriverinit},
+					},
+				},
+			},
+		},
 		{
 			tname:        "bad pf partition",
 			currentState: newNodeState(),
@@ -1127,6 +1220,48 @@ func TestNeedToUpdateSriov(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "VF vlan drifted",
+			args: args{
+				ifaceSpec: &v1.Interface{
+					NumVfs: 1,
+					VfGroups: []v1.VfGroup{
+						{
+							VfRange:      "0-0",
+							DeviceType:   consts.DeviceTypeVfioPci,
+							VfAttributes: &v1.VfAttributes{Vlan: ptr.To(100), Trust: ptr.To(true)},
+						},
+					},
+				},
+				ifaceStatus: &v1.InterfaceExt{
+					NumVfs: 1,
+					VFs:    []v1.VirtualFunction{{VfID: 0, Driver: "vfio-pci", Vlan: 0, Trust: ptr.To(true)}},
+				},
+			},
+			// drifted VF attributes are applied in place, see TestGetVfAttributesToUpdate
+			want: false,
+		},
+		{
+			name: "VF attributes match",
+			args: args{
+				ifaceSpec: &v1.Interface{
+					NumVfs: 1,
+					VfGroups: []v1.VfGroup{
+						{
+							VfRange:      "0-0",
+							DeviceType:   consts.DeviceTypeVfioPci,
+							VfAttributes: &v1.VfAttributes{Vlan: ptr.To(100), SpoofChk: ptr.To(false), MaxTxRate: ptr.To(1000)},
+						},
+					},
+				},
+				ifaceStatus: &v1.InterfaceExt{
+					NumVfs: 1,
+					VFs: []v1.VirtualFunction{{VfID: 0, Driver: "vfio-pci", Vlan: 100, SpoofChk: ptr.To(false),
+						Trust: ptr.To(true), MaxTxRate: 1000}},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1495,6 +1630,296 @@ func TestSriovNetworkNodePolicyApplyBridgeConfig(t *testing.T) {
 				},
 			}},
 		},
+		{
+			tname:        "bond of multiple PFs",
+			currentState: newNodeState(),
+			policy: &v1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "p1",
+				},
+				Spec: v1.SriovNetworkNodePolicySpec{
+					DeviceType: consts.DeviceTypeNetDevice,
+					NicSelector: v1.SriovNetworkNicSelector{
+						RootDevices: []string{"0000:86:00.0", "0000:86:00.1", "0000:86:00.2"},
+					},
+					NumVfs:       2,
+					Priority:     99,
+					EswitchMode:  "switchdev",
+					ResourceName: "p1res",
+					Bridge: v1.Bridge{OVS: &v1.OVSConfig{
+						Bridge: v1.OVSBridgeConfig{DatapathType: "test"},
+						Uplink: v1.OVSUplinkConfig{
+							Interface: v1.OVSInterfaceConfig{
+								Type: "test",
+							}},
+						Bond: &v1.OVSBondConfig{
+							Mode:    "balance-tcp",
+							LACP:    "active",
+							Members: []string{"0000:86:00.2", "0000:86:00.1"},
+						},
+					}},
+				},
+			},
+			expectedBridges: v1.Bridges{OVS: []v1.OVSConfigExt{
+				{
+					Name:   "br-bond-e0b82f1",
+					Bridge: v1.OVSBridgeConfig{DatapathType: "test"},
+					Uplinks: []v1.OVSUplinkConfigExt{{
+						Name:       "ens803f1",
+						PciAddress: "0000:86:00.1",
+						Interface:  v1.OVSInterfaceConfig{Type: "test"},
+					}, {
+						Name:       "ens803f2",
+						PciAddress: "0000:86:00.2",
+						Interface:  v1.OVSInterfaceConfig{Type: "test"},
+					}},
+					Bond: &v1.OVSBondConfigExt{
+						Name: "br-bond-e0b82f1-bond",
+						Mode: "balance-tcp",
+						LACP: "active",
+					},
+				},
+			}},
+		},
+		{
+			tname: "bond replaces bridges set by policy with lower priority",
+			currentState: func() *v1.SriovNetworkNodeState {
+				st := newNodeState()
+				st.Spec.Bridges = v1.Bridges{OVS: []v1.OVSConfigExt{
+					{
+						Name:   "br-0000_86_00.0",
+						Bridge: v1.OVSBridgeConfig{DatapathType: "foo"},
+						Uplinks: []v1.OVSUplinkConfigExt{{
+							Name:       "ens803f0",
+							PciAddress: "0000:86:00.0",
+						}},
+					},
+					{
+						Name:   "br-0000_86_00.1",
+						Bridge: v1.OVSBridgeConfig{DatapathType: "foo"},
+						Uplinks: []v1.OVSUplinkConfigExt{{
+							Name:       "ens803f1",
+							PciAddress: "0000:86:00.1",
+						}},
+					},
+				}}
+				return st
+			}(),
+			policy: &v1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "p1",
+				},
+				Spec: v1.SriovNetworkNodePolicySpec{
+					DeviceType: consts.DeviceTypeNetDevice,
+					NicSelector: v1.SriovNetworkNicSelector{
+						RootDevices: []string{"0000:86:00.1", "0000:86:00.2"},
+					},
+					NumVfs:       2,
+					Priority:     99,
+					Mtu:          9000,
+					EswitchMode:  "switchdev",
+					ResourceName: "p1res",
+					Bridge: v1.Bridge{OVS: &v1.OVSConfig{
+						Bridge: v1.OVSBridgeConfig{DatapathType: "test"},
+						Bond:   &v1.OVSBondConfig{Name: "bond0", Mode: "active-backup"},
+					}},
+				},
+			},
+			expectedBridges: v1.Bridges{OVS: []v1.OVSConfigExt{
+				{
+					Name:   "br-0000_86_00.0",
+					Bridge: v1.OVSBridgeConfig{DatapathType: "foo"},
+					Uplinks: []v1.OVSUplinkConfigExt{{
+						Name:       "ens803f0",
+						PciAddress: "0000:86:00.0",
+					}},
+				},
+				{
+					Name:   "br-bond-a22f51e",
+					Bridge: v1.OVSBridgeConfig{DatapathType: "test"},
+					Uplinks: []v1.OVSUplinkConfigExt{{
+						Name:       "ens803f1",
+						PciAddress: "0000:86:00.1",
+						Interface:  v1.OVSInterfaceConfig{MTURequest: ptr.To(9000)},
+					}, {
+						Name:       "ens803f2",
+						PciAddress: "0000:86:00.2",
+						Interface:  v1.OVSInterfaceConfig{MTURequest: ptr.To(9000)},
+					}},
+					Bond: &v1.OVSBondConfigExt{Name: "bond0", Mode: "active-backup"},
+				},
+			}},
+		},
+		{
+			tname: "bridge of a higher priority policy on a bond member doesn't replace the bond bridge",
+			currentState: func() *v1.SriovNetworkNodeState {
+				st := newNodeState()
+				st.Spec.Bridges = v1.Bridges{OVS: []v1.OVSConfigExt{
+					{
+						Name:   "br-bond-a22f51e",
+						Bridge: v1.OVSBridgeConfig{DatapathType: "foo"},
+						Uplinks: []v1.OVSUplinkConfigExt{
+							{Name: "ens803f0", PciAddress: "0000:86:00.0"},
+							{Name: "ens803f1", PciAddress: "0000:86:00.1"},
+							{Name: "ens803f2", PciAddress: "0000:86:00.2"},
+						},
+						Bond: &v1.OVSBondConfigExt{Name: "bond0"},
+					},
+				}}
+				return st
+			}(),
+			policy: &v1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "p2",
+				},
+				Spec: v1.SriovNetworkNodePolicySpec{
+					DeviceType: consts.DeviceTypeNetDevice,
+					NicSelector: v1.SriovNetworkNicSelector{
+						RootDevices: []string{"0000:86:00.0"},
+					},
+					NumVfs:       2,
+					Priority:     10,
+					EswitchMode:  "switchdev",
+					ResourceName: "p2res",
+					Bridge: v1.Bridge{OVS: &v1.OVSConfig{
+						Bridge: v1.OVSBridgeConfig{DatapathType: "test"},
+					}},
+				},
+			},
+			expectedBridges: v1.Bridges{OVS: []v1.OVSConfigExt{
+				{
+					Name:    "br-0000_86_00.0",
+					Bridge:  v1.OVSBridgeConfig{DatapathType: "test"},
+					Uplinks: []v1.OVSUplinkConfigExt{{Name: "ens803f0", PciAddress: "0000:86:00.0"}},
+				},
+				{
+					Name:   "br-bond-a22f51e",
+					Bridge: v1.OVSBridgeConfig{DatapathType: "foo"},
+					Uplinks: []v1.OVSUplinkConfigExt{
+						{Name: "ens803f1", PciAddress: "0000:86:00.1"},
+						{Name: "ens803f2", PciAddress: "0000:86:00.2"},
+					},
+					Bond: &v1.OVSBondConfigExt{Name: "bond0"},
+				},
+			}},
+		},
+		{
+			tname: "remove PF from bond set by policy with lower priority",
+			currentState: func() *v1.SriovNetworkNodeState {
+				st := newNodeState()
+				st.Spec.Bridges = v1.Bridges{OVS: []v1.OVSConfigExt{
+					{
+						Name:   "br-bond-a22f51e",
+						Bridge: v1.OVSBridgeConfig{DatapathType: "foo"},
+						Uplinks: []v1.OVSUplinkConfigExt{{
+							Name:       "ens803f0",
+							PciAddress: "0000:86:00.0",
+						}, {
+							Name:       "ens803f1",
+							PciAddress: "0000:86:00.1",
+						}},
+						Bond: &v1.OVSBondConfigExt{Name: "bond0"},
+					},
+				}}
+				return st
+			}(),
+			policy: &v1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "p1",
+				},
+				Spec: v1.SriovNetworkNodePolicySpec{
+					DeviceType: consts.DeviceTypeNetDevice,
+					NicSelector: v1.SriovNetworkNicSelector{
+						RootDevices: []string{"0000:86:00.1"},
+					},
+					NumVfs:       2,
+					Priority:     99,
+					EswitchMode:  "switchdev",
+					ResourceName: "p1res",
+				},
+			},
+			// the bond with a single member left is converted to a plain uplink
+			expectedBridges: v1.Bridges{OVS: []v1.OVSConfigExt{
+				{
+					Name:   "br-0000_86_00.0",
+					Bridge: v1.OVSBridgeConfig{DatapathType: "foo"},
+					Uplinks: []v1.OVSUplinkConfigExt{{
+						Name:       "ens803f0",
+						PciAddress: "0000:86:00.0",
+					}},
+				},
+			}},
+		},
+		{
+			tname:        "additional ports",
+			currentState: newNodeState(),
+			policy: &v1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "p1",
+				},
+				Spec: v1.SriovNetworkNodePolicySpec{
+					DeviceType: consts.DeviceTypeNetDevice,
+					NicSelector: v1.SriovNetworkNicSelector{
+						RootDevices: []string{"0000:86:00.0"},
+					},
+					NumVfs:       2,
+					Priority:     99,
+					EswitchMode:  "switchdev",
+					ResourceName: "p1res",
+					Bridge: v1.Bridge{OVS: &v1.OVSConfig{
+						Ports: []v1.OVSPortConfig{
+							{Name: "patch-br-int", Type: "patch", Peer: "patch-br-sriov"},
+							{Name: "host0", Type: "internal", IPAddresses: []string{"192.168.1.10/24"}},
+						},
+					}},
+				},
+			},
+			expectedBridges: v1.Bridges{OVS: []v1.OVSConfigExt{
+				{
+					Name: "br-0000_86_00.0",
+					Uplinks: []v1.OVSUplinkConfigExt{{
+						Name:       "ens803f0",
+						PciAddress: "0000:86:00.0",
+					}},
+					Ports: []v1.OVSPortConfig{
+						{Name: "patch-br-int", Type: "patch", Peer: "patch-br-sriov"},
+						{Name: "host0", Type: "internal", IPAddresses: []string{"192.168.1.10/24"}},
+					},
+				},
+			}},
+		},
+		{
+			tname: "additional ports with a bridge for each PF",
+			currentState: func() *v1.SriovNetworkNodeState {
+				st := newNodeState()
+				st.Spec.Bridges = v1.Bridges{OVS: []v1.OVSConfigExt{{
+					Name:    "br-0000_86_00.0",
+					Uplinks: []v1.OVSUplinkConfigExt{{Name: "ens803f0", PciAddress: "0000:86:00.0"}},
+				}}}
+				return st
+			}(),
+			policy: &v1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "p1",
+				},
+				Spec: v1.SriovNetworkNodePolicySpec{
+					DeviceType: consts.DeviceTypeNetDevice,
+					NicSelector: v1.SriovNetworkNicSelector{
+						RootDevices: []string{"0000:86:00.0", "0000:86:00.1"},
+					},
+					NumVfs:       2,
+					Priority:     99,
+					EswitchMode:  "switchdev",
+					ResourceName: "p1res",
+					Bridge: v1.Bridge{OVS: &v1.OVSConfig{
+						Ports: []v1.OVSPortConfig{{Name: "host0", Type: "internal"}},
+					}},
+				},
+			},
+			// the bridges of the policy are skipped on the node, the PFs are still removed from
+			// the bridges of the policies with lower priority
+			expectedBridges: v1.Bridges{},
+		},
 	}
 	for _, tc := range testtable {
 		t.Run(tc.tname, func(t *testing.T) {
@@ -1519,6 +1944,17 @@ func TestGenerateBridgeName(t *testing.T) {
 	}
 }
 
+func TestGenerateBondBridgeName(t *testing.T) {
+	name := v1.GenerateBondBridgeName("p1", "bond0")
+	assert.Equal(t, "br-bond-a22f51e", name)
+	assert.LessOrEqual(t, len(name), 15)
+	assert.NotEqual(t, name, v1.GenerateBondBridgeName("p2", "bond0"))
+}
+
+func TestGenerateBondName(t *testing.T) {
+	assert.Equal(t, "br-0000_86_00.2-bond", v1.GenerateBondName("br-0000_86_00.2"))
+}
+
 func TestNeedToUpdateBridges(t *testing.T) {
 	testtable := []struct {
 		tname          string
@@ -1538,6 +1974,13 @@ func TestNeedToUpdateBridges(t *testing.T) {
 			statusBridge:   &v1.Bridges{OVS: []v1.OVSConfigExt{}},
 			expectedResult: true,
 		},
+		{
+			tname:      "observed state is ignored",
+			specBridge: &v1.Bridges{OVS: []v1.OVSConfigExt{{Bridge: v1.OVSBridgeConfig{DatapathType: "test"}}}},
+			statusBridge: &v1.Bridges{OVS: []v1.OVSConfigExt{{Bridge: v1.OVSBridgeConfig{DatapathType: "test"},
+				State: &v1.OVSBridgeState{HwOffload: "true", Uplinks: []v1.OVSInterfaceState{{PciAddress: "0000:d8:00.0", LinkState: "up"}}}}}},
+			expectedResult: false,
+		},
 	}
 	for _, tc := range testtable {
 		t.Run(tc.tname, func(t *testing.T) {
@@ -1548,3 +1991,565 @@ func TestNeedToUpdateBridges(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffInterfaces(t *testing.T) {
+	current := v1.Interfaces{
+		{PciAddress: "0000:86:00.0", Name: "ens803f0", NumVfs: 4,
+			VfGroups: []v1.VfGroup{{ResourceName: "res1", DeviceType: consts.DeviceTypeNetDevice, VfRange: "0-3", PolicyName: "p1"}}},
+		{PciAddress: "0000:86:00.1", Name: "ens803f1", NumVfs: 2},
+		{PciAddress: "0000:87:00.0", Name: "ens804f0", NumVfs: 2},
+	}
+	planned := v1.Interfaces{
+		{PciAddress: "0000:86:00.0", Name: "ens803f0", NumVfs: 8,
+			VfGroups: []v1.VfGroup{{ResourceName: "res1", DeviceType: consts.DeviceTypeVfioPci, VfRange: "0-7", PolicyName: "p1"}}},
+		{PciAddress: "0000:86:00.1", Name: "ens803f1", NumVfs: 2},
+		{PciAddress: "0000:88:00.0", Name: "ens805f0", NumVfs: 1},
+	}
+
+	changes := v1.DiffInterfaces(current, planned)
+	if len(changes) != 3 {
+		t.Fatalf("DiffInterfaces() returned %d changes, want 3: %+v", len(changes), changes)
+	}
+	expected := []struct{ pci, action string }{
+		{"0000:86:00.0", consts.InterfaceChangeUpdate},
+		{"0000:87:00.0", consts.InterfaceChangeRemove},
+		{"0000:88:00.0", consts.InterfaceChangeAdd},
+	}
+	for i, e := range expected {
+		if changes[i].PciAddress != e.pci || changes[i].Action != e.action {
+			t.Errorf("DiffInterfaces()[%d] = %s %s, want %s %s", i, changes[i].PciAddress, changes[i].Action, e.pci, e.action)
+		}
+	}
+	if len(changes[0].Changes) != 2 || changes[0].Changes[0] != "numVfs: 4 -> 8" {
+		t.Errorf("DiffInterfaces() unexpected changes for updated interface: %v", changes[0].Changes)
+	}
+
+	if changes := v1.DiffInterfaces(current, current); len(changes) != 0 {
+		t.Errorf("DiffInterfaces() with equal interfaces = %+v, want no changes", changes)
+	}
+}
+
+func TestDiffInterfacesPointerFields(t *testing.T) {
+	current := v1.Interfaces{{PciAddress: "0000:86:00.0", Name: "ens803f0", NumVfs: 4,
+		Intel: &v1.IntelConfig{FwLldp: ptr.To(true)}}}
+	planned := v1.Interfaces{{PciAddress: "0000:86:00.0", Name: "ens803f0", NumVfs: 4,
+		Intel: &v1.IntelConfig{FwLldp: ptr.To(false)}}}
+
+	changes := v1.DiffInterfaces(current, planned)
+	if len(changes) != 1 {
+		t.Fatalf("DiffInterfaces() returned %d changes, want 1: %+v", len(changes), changes)
+	}
+	expected := []string{`intel: {"fwLldp":true} -> {"fwLldp":false}`}
+	if !reflect.DeepEqual(changes[0].Changes, expected) {
+		t.Errorf("DiffInterfaces() changes = %v, want %v", changes[0].Changes, expected)
+	}
+}
+
+func TestDiffBridges(t *testing.T) {
+	current := v1.Bridges{OVS: []v1.OVSConfigExt{
+		{Name: "br-0000_86_00.0", Bridge: v1.OVSBridgeConfig{DatapathType: "netdev"},
+			Uplinks: []v1.OVSUplinkConfigExt{{PciAddress: "0000:86:00.0", Name: "ens803f0"}}},
+		{Name: "br-0000_87_00.0", Uplinks: []v1.OVSUplinkConfigExt{{PciAddress: "0000:87:00.0", Name: "ens804f0"}}},
+	}}
+	planned := v1.Bridges{OVS: []v1.OVSConfigExt{
+		{Name: "br-0000_86_00.0", Bridge: v1.OVSBridgeConfig{DatapathType: "system"},
+			Uplinks: []v1.OVSUplinkConfigExt{{PciAddress: "0000:86:00.0", Name: "ens803f0"}}},
+		{Name: "br-0000_88_00.0", Uplinks: []v1.OVSUplinkConfigExt{{PciAddress: "0000:88:00.0", Name: "ens805f0"}}},
+	}}
+
+	changes := v1.DiffBridges(current, planned)
+	if len(changes) != 3 {
+		t.Fatalf("DiffBridges() returned %d changes, want 3: %+v", len(changes), changes)
+	}
+	expected := []struct{ name, action string }{
+		{"br-0000_86_00.0", consts.InterfaceChangeUpdate},
+		{"br-0000_87_00.0", consts.InterfaceChangeRemove},
+		{"br-0000_88_00.0", consts.InterfaceChangeAdd},
+	}
+	for i, e := range expected {
+		if changes[i].Name != e.name || changes[i].Action != e.action {
+			t.Errorf("DiffBridges()[%d] = %s %s, want %s %s", i, changes[i].Name, changes[i].Action, e.name, e.action)
+		}
+	}
+	if len(changes[0].Changes) != 1 || changes[0].Changes[0] != `bridge: {"datapathType":"netdev"} -> {"datapathType":"system"}` {
+		t.Errorf("DiffBridges() unexpected changes for updated bridge: %v", changes[0].Changes)
+	}
+
+	if changes := v1.DiffBridges(current, current); len(changes) != 0 {
+		t.Errorf("DiffBridges() with equal bridges = %+v, want no changes", changes)
+	}
+}
+
+func TestDiffSystem(t *testing.T) {
+	changes := v1.DiffSystem(v1.System{}, v1.System{RdmaMode: consts.RdmaSubsystemModeExclusive})
+	expected := []string{`rdmaMode: "" -> "exclusive"`}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("DiffSystem() = %v, want %v", changes, expected)
+	}
+	if changes := v1.DiffSystem(v1.System{RdmaMode: "shared"}, v1.System{RdmaMode: "shared"}); len(changes) != 0 {
+		t.Errorf("DiffSystem() with equal settings = %v, want no changes", changes)
+	}
+}
+
+func TestPlannedSpec(t *testing.T) {
+	ns := &v1.SriovNetworkNodeState{}
+	ns.Generation = 2
+	spec := &v1.SriovNetworkNodeStateSpec{Interfaces: v1.Interfaces{{PciAddress: "0000:86:00.0", NumVfs: 4}}}
+
+	changed, err := ns.SetPlannedSpec(spec)
+	if err != nil || !changed {
+		t.Fatalf("SetPlannedSpec() = %v, %v, want true, nil", changed, err)
+	}
+	if changed, _ = ns.SetPlannedSpec(spec); changed {
+		t.Errorf("SetPlannedSpec() with the same spec should not change the annotation")
+	}
+
+	got, hash, err := ns.GetPlannedSpec()
+	if err != nil {
+		t.Fatalf("GetPlannedSpec() unexpected error: %v", err)
+	}
+	if !cmp.Equal(got, spec) {
+		t.Errorf("GetPlannedSpec() = %+v, want %+v", got, spec)
+	}
+
+	if ns.IsPlanCurrent() {
+		t.Errorf("IsPlanCurrent() = true without a plan in the status")
+	}
+	ns.Status.Plan = &v1.NodeStatePlan{PlannedSpecHash: hash, ObservedGeneration: 2}
+	if !ns.IsPlanCurrent() {
+		t.Errorf("IsPlanCurrent() = false for a plan matching the planned spec")
+	}
+	ns.Generation = 3
+	if ns.IsPlanCurrent() {
+		t.Errorf("IsPlanCurrent() = true for a plan computed for a previous generation")
+	}
+
+	if changed, _ = ns.SetPlannedSpec(nil); !changed {
+		t.Errorf("SetPlannedSpec(nil) should remove the annotation")
+	}
+	if got, _, _ = ns.GetPlannedSpec(); got != nil {
+		t.Errorf("GetPlannedSpec() = %+v after removal, want nil", got)
+	}
+}
+
+func TestSriovNetworkNodeOverrideApply(t *testing.T) {
+	ns := &v1.SriovNetworkNodeState{Spec: v1.SriovNetworkNodeStateSpec{Interfaces: v1.Interfaces{
+		{PciAddress: "0000:86:00.0", Name: "ens803f0", NumVfs: 8, Mtu: 9000,
+			VfGroups: []v1.VfGroup{
+				{ResourceName: "res1", VfRange: "0-3", PolicyName: "p1", Mtu: 9000},
+				{ResourceName: "res2", VfRange: "4-7", PolicyName: "p2", Mtu: 9000},
+			}},
+		{PciAddress: "0000:86:00.1", Name: "ens803f1", NumVfs: 4,
+			VfGroups: []v1.VfGroup{{ResourceName: "res1", VfRange: "0-3", PolicyName: "p1"}}},
+	}}}
+	override := &v1.SriovNetworkNodeOverride{Spec: v1.SriovNetworkNodeOverrideSpec{Interfaces: []v1.InterfaceOverride{
+		{Name: "ens803f0", NumVfs: ptr.To(3), Mtu: ptr.To(1500)},
+		{PciAddress: "0000:86:00.1", Exclude: true},
+		{PciAddress: "0000:87:00.0", NumVfs: ptr.To(2)},
+	}}}
+
+	applied, unmatched := override.Apply(ns)
+	assert.Equal(t, []string{"0000:86:00.0", "0000:86:00.1"}, applied)
+	assert.Equal(t, []string{"0000:87:00.0"}, unmatched)
+	assert.Equal(t, v1.Interfaces{
+		{PciAddress: "0000:86:00.0", Name: "ens803f0", NumVfs: 3, Mtu: 1500,
+			VfGroups: []v1.VfGroup{{ResourceName: "res1", VfRange: "0-2", PolicyName: "p1", Mtu: 1500}}},
+	}, ns.Spec.Interfaces)
+
+	if !ns.SetAppliedOverride("worker-0") || ns.GetAppliedOverride() != "worker-0" {
+		t.Errorf("SetAppliedOverride() should record the applied override")
+	}
+	if ns.SetAppliedOverride("worker-0") {
+		t.Errorf("SetAppliedOverride() with the same override should not change the annotation")
+	}
+	if !ns.SetAppliedOverride("") || ns.GetAppliedOverride() != "" {
+		t.Errorf("SetAppliedOverride(\"\") should remove the annotation")
+	}
+}
+
+func TestSriovNetworkNicSelectorSelected(t *testing.T) {
+	iface := &v1.InterfaceExt{
+		Name:            "ens803f0",
+		PciAddress:      "0000:86:00.0",
+		Vendor:          "8086",
+		DeviceID:        "159b",
+		Driver:          "ice",
+		LinkSpeed:       "25000 Mb/s",
+		NumaNode:        ptr.To(1),
+		FirmwareVersion: "4.40 0x8001c967 1.3534.0",
+		PhysicalSlot:    "3",
+		PciLabel:        "NIC1 Port1",
+	}
+	testtable := []struct {
+		tname    string
+		selector v1.SriovNetworkNicSelector
+		expected bool
+	}{
+		{
+			tname:    "all the fields match",
+			selector: v1.SriovNetworkNicSelector{Vendor: "8086", Drivers: []string{"i40e", "ice"}, MinLinkSpeed: 25000, NumaNodes: []int{1}, FirmwareVersions: []string{"4.40*"}, PhysicalSlots: []string{"3"}, PciLabels: []string{"NIC1*"}},
+			expected: true,
+		},
+		{
+			tname:    "driver doesn't match",
+			selector: v1.SriovNetworkNicSelector{Drivers: []string{"mlx5_core"}},
+			expected: false,
+		},
+		{
+			tname:    "link speed lower than the minimum",
+			selector: v1.SriovNetworkNicSelector{MinLinkSpeed: 100000},
+			expected: false,
+		},
+		{
+			tname:    "NUMA node doesn't match",
+			selector: v1.SriovNetworkNicSelector{NumaNodes: []int{0}},
+			expected: false,
+		},
+		{
+			tname:    "firmware version doesn't match",
+			selector: v1.SriovNetworkNicSelector{FirmwareVersions: []string{"4.30*"}},
+			expected: false,
+		},
+		{
+			tname:    "physical slot doesn't match",
+			selector: v1.SriovNetworkNicSelector{PhysicalSlots: []string{"1", "2"}},
+			expected: false,
+		},
+		{
+			tname:    "PCI label doesn't match",
+			selector: v1.SriovNetworkNicSelector{PciLabels: []string{"NIC2*"}},
+			expected: false,
+		},
+	}
+	for _, tc := range testtable {
+		t.Run(tc.tname, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.selector.Selected(iface))
+			assert.False(t, tc.selector.IsEmpty())
+		})
+	}
+
+	// the PFs without link or without NUMA node are not selected
+	down := &v1.InterfaceExt{Name: "ens803f1", LinkSpeed: "-1 Mb/s"}
+	assert.False(t, (&v1.SriovNetworkNicSelector{MinLinkSpeed: 1000}).Selected(down))
+	assert.False(t, (&v1.SriovNetworkNicSelector{NumaNodes: []int{0}}).Selected(down))
+}
+
+func TestSriovNetworkNodePolicyApplyKeepingPfs(t *testing.T) {
+	policy := &v1.SriovNetworkNodePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1"},
+		Spec: v1.SriovNetworkNodePolicySpec{
+			NicSelector:  v1.SriovNetworkNicSelector{Vendor: "8086", MinLinkSpeed: 25000, FirmwareVersions: []string{"4.40*"}},
+			NumVfs:       4,
+			ResourceName: "p1res",
+		},
+	}
+	newState := func() *v1.SriovNetworkNodeState {
+		return &v1.SriovNetworkNodeState{
+			Status: v1.SriovNetworkNodeStateStatus{Interfaces: []v1.InterfaceExt{
+				// the link of the configured PF went down
+				{Name: "ens803f0", PciAddress: "0000:86:00.0", Vendor: "8086", TotalVfs: 64, LinkSpeed: "-1 Mb/s", FirmwareVersion: "4.40"},
+				// the firmware of the configured PF was upgraded
+				{Name: "ens803f1", PciAddress: "0000:86:00.1", Vendor: "8086", TotalVfs: 64, LinkSpeed: "25000 Mb/s", FirmwareVersion: "4.50"},
+				// the PF not configured yet is still selected on all the fields
+				{Name: "ens804f0", PciAddress: "0000:87:00.0", Vendor: "8086", TotalVfs: 64, LinkSpeed: "-1 Mb/s", FirmwareVersion: "4.40"},
+				{Name: "ens805f0", PciAddress: "0000:88:00.0", Vendor: "15b3", TotalVfs: 64, LinkSpeed: "25000 Mb/s", FirmwareVersion: "4.40"},
+			}},
+		}
+	}
+
+	state := newState()
+	assert.NoError(t, policy.Apply(state, false))
+	assert.Empty(t, state.Spec.Interfaces)
+
+	state = newState()
+	assert.NoError(t, policy.ApplyKeepingPfs(state, false, []string{"0000:86:00.0", "0000:86:00.1", "0000:88:00.0"}))
+	addresses := []string{}
+	for _, iface := range state.Spec.Interfaces {
+		addresses = append(addresses, iface.PciAddress)
+		assert.Equal(t, 4, iface.NumVfs)
+	}
+	assert.Equal(t, []string{"0000:86:00.0", "0000:86:00.1"}, addresses)
+}
+
+func TestSriovNetworkNodePolicySelected(t *testing.T) {
+	newNode := func(name string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	worker := map[string]string{"node-role.kubernetes.io/worker": "", "sriov": "true"}
+	excluded := map[string]string{"node-role.kubernetes.io/worker": "", "sriov": "true", "example.com/no-sriov": ""}
+	testtable := []struct {
+		tname    string
+		policy   v1.SriovNetworkNodePolicySpec
+		node     *corev1.Node
+		expected bool
+	}{
+		{
+			tname:    "nodeSelector matches",
+			policy:   v1.SriovNetworkNodePolicySpec{NodeSelector: map[string]string{"sriov": "true"}},
+			node:     newNode("worker-0", worker),
+			expected: true,
+		},
+		{
+			tname:    "nodeSelector doesn't match",
+			policy:   v1.SriovNetworkNodePolicySpec{NodeSelector: map[string]string{"sriov": "false"}},
+			node:     newNode("worker-0", worker),
+			expected: false,
+		},
+		{
+			tname: "nodeAffinity excludes the nodes with a label",
+			policy: v1.SriovNetworkNodePolicySpec{NodeAffinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "node-role.kubernetes.io/worker", Operator: corev1.NodeSelectorOpExists},
+					{Key: "example.com/no-sriov", Operator: corev1.NodeSelectorOpDoesNotExist},
+				}}}}},
+			node:     newNode("worker-0", excluded),
+			expected: false,
+		},
+		{
+			tname: "nodeAffinity matches one of the terms",
+			policy: v1.SriovNetworkNodePolicySpec{NodeAffinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "sriov", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"true"}}}},
+				{MatchFields: []corev1.NodeSelectorRequirement{{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"worker-0", "worker-1"}}}},
+			}}},
+			node:     newNode("worker-1", worker),
+			expected: true,
+		},
+		{
+			tname: "nodeAffinity and nodeSelector must both match",
+			policy: v1.SriovNetworkNodePolicySpec{NodeSelector: map[string]string{"sriov": "false"},
+				NodeAffinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "sriov", Operator: corev1.NodeSelectorOpExists}}},
+				}}},
+			node:     newNode("worker-0", worker),
+			expected: false,
+		},
+		{
+			tname:    "empty node selector term matches no node",
+			policy:   v1.SriovNetworkNodePolicySpec{NodeAffinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{}}}},
+			node:     newNode("worker-0", worker),
+			expected: false,
+		},
+	}
+	for _, tc := range testtable {
+		t.Run(tc.tname, func(t *testing.T) {
+			p := &v1.SriovNetworkNodePolicy{Spec: tc.policy}
+			assert.Equal(t, tc.expected, p.Selected(tc.node))
+		})
+	}
+}
+
+func TestSriovNetworkNodePolicyGetConflicts(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Labels: map[string]string{"sriov": "true"}}}
+	ns := &v1.SriovNetworkNodeState{Status: v1.SriovNetworkNodeStateStatus{Interfaces: v1.InterfaceExts{
+		{Name: "ens803f0", Vendor: "8086", PciAddress: "0000:86:00.0", TotalVfs: 64},
+		{Name: "ens803f1", Vendor: "8086", PciAddress: "0000:86:00.1", TotalVfs: 64},
+	}}}
+	newPolicy := func(name string, priority int, spec v1.SriovNetworkNodePolicySpec) v1.SriovNetworkNodePolicy {
+		spec.NodeSelector = map[string]string{"sriov": "true"}
+		spec.Priority = priority
+		return v1.SriovNetworkNodePolicy{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+	}
+	policies := []v1.SriovNetworkNodePolicy{
+		newPolicy("p1", 10, v1.SriovNetworkNodePolicySpec{NicSelector: v1.SriovNetworkNicSelector{PfNames: []string{"ens803f0#0-3"}},
+			NumVfs: 8, ResourceName: "res1", Mtu: 9000, LinkType: "eth"}),
+		// overlapping VF range with a different MTU and eSwitchMode
+		newPolicy("p2", 5, v1.SriovNetworkNodePolicySpec{NicSelector: v1.SriovNetworkNicSelector{PfNames: []string{"ens803f0#2-5"}},
+			NumVfs: 8, ResourceName: "res2", Mtu: 1500, LinkType: "ETH", EswitchMode: v1.ESwithModeSwitchDev}),
+		// same resource on the same PF
+		newPolicy("p3", 10, v1.SriovNetworkNodePolicySpec{NicSelector: v1.SriovNetworkNicSelector{PfNames: []string{"ens803f0#6-7"}},
+			NumVfs: 8, ResourceName: "res1"}),
+		// same resource with another device type on another PF
+		newPolicy("p4", 20, v1.SriovNetworkNodePolicySpec{NicSelector: v1.SriovNetworkNicSelector{PfNames: []string{"ens803f1"}},
+			NumVfs: 8, ResourceName: "res1", DeviceType: consts.DeviceTypeVfioPci}),
+		// no conflict with a disjoint VF range
+		newPolicy("p5", 10, v1.SriovNetworkNodePolicySpec{NicSelector: v1.SriovNetworkNicSelector{PfNames: []string{"ens803f0#4-5"}},
+			NumVfs: 8, ResourceName: "res5", Mtu: 9000}),
+	}
+	dryRun := newPolicy("p6", 0, v1.SriovNetworkNodePolicySpec{NicSelector: v1.SriovNetworkNicSelector{Vendor: "8086"},
+		NumVfs: 8, ResourceName: "res6", Mtu: 1000})
+	dryRun.Annotations = map[string]string{consts.PolicyDryRunAnnotation: "true"}
+	policies = append(policies, dryRun)
+
+	conflicts := v1.MergePolicyConflicts(nil, policies[0].GetConflicts(policies, node, ns)...)
+	assert.Equal(t, []v1.PolicyConflict{
+		{Policy: "p2", Reason: consts.PolicyConflictEswitchMode, Overridden: true, Nodes: []string{"worker-0"},
+			Message: "eSwitchMode legacy of PF ens803f0 conflicts with eSwitchMode switchdev of policy p2"},
+		{Policy: "p2", Reason: consts.PolicyConflictMtu, Overridden: true, Nodes: []string{"worker-0"},
+			Message: "mtu 9000 of PF ens803f0 conflicts with mtu 1500 of policy p2"},
+		{Policy: "p2", Reason: consts.PolicyConflictVfRange, Overridden: true, Nodes: []string{"worker-0"},
+			Message: "VF range 0-3 of PF ens803f0 overlaps VF range 2-5 of policy p2"},
+		{Policy: "p3", Reason: consts.PolicyConflictResourceName, Overridden: true, Nodes: []string{"worker-0"},
+			Message: "resource res1 of PF ens803f0 is also configured by policy p3"},
+		{Policy: "p4", Reason: consts.PolicyConflictResourceName, Nodes: []string{"worker-0"},
+			Message: "resource res1 is also exposed by policy p4 with deviceType vfio-pci and isRdma false"},
+	}, conflicts)
+
+	// the conflicts of the same policies on other nodes are merged
+	other := node.DeepCopy()
+	other.Name = "worker-1"
+	conflicts = v1.MergePolicyConflicts(conflicts, policies[0].GetConflicts(policies, other, ns)...)
+	assert.Len(t, conflicts, 5)
+	assert.Equal(t, []string{"worker-0", "worker-1"}, conflicts[0].Nodes)
+
+	assert.Empty(t, dryRun.GetConflicts(policies, node, ns))
+	node.Labels = nil
+	assert.Empty(t, policies[0].GetConflicts(policies, node, ns))
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	// Wednesday
+	now := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+	window := func(schedule string, duration time.Duration, timeZone string) v1.MaintenanceWindow {
+		return v1.MaintenanceWindow{Schedule: schedule, Duration: metav1.Duration{Duration: duration}, TimeZone: timeZone}
+	}
+
+	tests := []struct {
+		tname          string
+		windows        []v1.MaintenanceWindow
+		expectedOpen   bool
+		expectedNext   time.Time
+		expectingError bool
+	}{
+		{
+			tname:        "no windows",
+			expectedOpen: true,
+		},
+		{
+			tname:        "inside a daily window",
+			windows:      []v1.MaintenanceWindow{window("0 10 * * *", time.Hour, "")},
+			expectedOpen: true,
+		},
+		{
+			tname:        "after a daily window",
+			windows:      []v1.MaintenanceWindow{window("0 9 * * *", time.Hour, "")},
+			expectedNext: time.Date(2025, time.January, 16, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			tname:        "earliest of multiple windows",
+			windows:      []v1.MaintenanceWindow{window("0 2 * * 6", 4*time.Hour, ""), window("0 22 * * *", time.Hour, "")},
+			expectedNext: time.Date(2025, time.January, 15, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			tname:        "window in another time zone",
+			windows:      []v1.MaintenanceWindow{window("0 11 * * *", time.Hour, "Europe/Paris")},
+			expectedOpen: true,
+		},
+		{
+			tname:          "invalid schedule",
+			windows:        []v1.MaintenanceWindow{window("0 25 * * *", time.Hour, "")},
+			expectingError: true,
+		},
+		{
+			tname:          "invalid duration",
+			windows:        []v1.MaintenanceWindow{window("0 10 * * *", 0, "")},
+			expectingError: true,
+		},
+		{
+			tname:          "invalid time zone",
+			windows:        []v1.MaintenanceWindow{window("0 10 * * *", time.Hour, "Mars/Olympus")},
+			expectingError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.tname, func(t *testing.T) {
+			pool := &v1.SriovNetworkPoolConfig{Spec: v1.SriovNetworkPoolConfigSpec{MaintenanceWindows: tc.windows}}
+			open, next, err := pool.InMaintenanceWindow(now)
+			if tc.expectingError {
+				if err == nil {
+					t.Errorf("InMaintenanceWindow() expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("InMaintenanceWindow() unexpected error: %v", err)
+			}
+			if open != tc.expectedOpen {
+				t.Errorf("InMaintenanceWindow() open = %v, want %v", open, tc.expectedOpen)
+			}
+			if !next.Equal(tc.expectedNext) {
+				t.Errorf("InMaintenanceWindow() next = %v, want %v", next, tc.expectedNext)
+			}
+		})
+	}
+}
+
+func TestFindNodePoolConfig(t *testing.T) {
+	pool := func(name string, selector map[string]string, hwOffload bool) v1.SriovNetworkPoolConfig {
+		npc := v1.SriovNetworkPoolConfig{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if selector != nil {
+			npc.Spec.NodeSelector = &metav1.LabelSelector{MatchLabels: selector}
+		}
+		if hwOffload {
+			npc.Spec.OvsHardwareOffloadConfig.Name = "mcp-offloading"
+		}
+		return npc
+	}
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Labels: map[string]string{"pool": "a"}}}
+
+	tests := []struct {
+		tname          string
+		pools          []v1.SriovNetworkPoolConfig
+		expectedPool   string
+		expectingError bool
+	}{
+		{
+			tname: "no pools",
+		},
+		{
+			tname:        "matching pool",
+			pools:        []v1.SriovNetworkPoolConfig{pool("b", map[string]string{"pool": "b"}, false), pool("a", map[string]string{"pool": "a"}, false)},
+			expectedPool: "a",
+		},
+		{
+			tname: "hardware offload pools are skipped",
+			pools: []v1.SriovNetworkPoolConfig{pool("offload", map[string]string{"pool": "a"}, true)},
+		},
+		{
+			tname:          "node in more than one pool",
+			pools:          []v1.SriovNetworkPoolConfig{pool("a", map[string]string{"pool": "a"}, false), pool("all", nil, false)},
+			expectingError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.tname, func(t *testing.T) {
+			npc, err := v1.FindNodePoolConfig(node, tc.pools)
+			if tc.expectingError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.expectedPool == "" {
+				assert.Nil(t, npc)
+				return
+			}
+			assert.Equal(t, tc.expectedPool, npc.Name)
+		})
+	}
+}
+
+func TestGetVfAttributesToUpdate(t *testing.T) {
+	attrs := &v1.VfAttributes{Vlan: ptr.To(100), Trust: ptr.To(true), MaxTxRate: ptr.To(1000)}
+	ifaceSpec := &v1.Interface{
+		NumVfs: 3,
+		VfGroups: []v1.VfGroup{
+			{VfRange: "0-1", DeviceType: consts.DeviceTypeVfioPci, VfAttributes: attrs},
+			{VfRange: "2-2", DeviceType: consts.DeviceTypeNetDevice},
+		},
+	}
+	ifaceStatus := &v1.InterfaceExt{
+		NumVfs: 3,
+		VFs: []v1.VirtualFunction{
+			{VfID: 0, Driver: "vfio-pci", Vlan: 100, Trust: ptr.To(true), MaxTxRate: 1000},
+			{VfID: 1, Driver: "vfio-pci", Vlan: 100, Trust: ptr.To(false), MaxTxRate: 1000},
+			{VfID: 2, Driver: "iavf", Vlan: 300},
+		},
+	}
+	assert.Equal(t, map[int]*v1.VfAttributes{1: attrs}, v1.GetVfAttributesToUpdate(ifaceSpec, ifaceStatus))
+	assert.True(t, v1.NeedToUpdateVfAttributes(ifaceSpec, ifaceStatus))
+	assert.False(t, v1.NeedToUpdateSriov(ifaceSpec, ifaceStatus))
+
+	ifaceStatus.VFs[1].Trust = ptr.To(true)
+	assert.Empty(t, v1.GetVfAttributesToUpdate(ifaceSpec, ifaceStatus))
+	assert.False(t, v1.NeedToUpdateVfAttributes(ifaceSpec, ifaceStatus))
+}
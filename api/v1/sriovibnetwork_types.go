@@ -47,8 +47,7 @@ type SriovIBNetworkSpec struct {
 
 // SriovIBNetworkStatus defines the observed state of SriovIBNetwork
 type SriovIBNetworkStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	NetworkStatus `json:",inline"`
 }
 
 //+kubebuilder:object:root=true
@@ -75,8 +75,33 @@ type SriovNetworkSpec struct {
 
 // SriovNetworkStatus defines the observed state of SriovNetwork
 type SriovNetworkStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	NetworkStatus `json:",inline"`
+}
+
+// NetworkStatus contains the state of the NetworkAttachmentDefinition rendered for a network object,
+// it is shared by the SriovNetwork, SriovIBNetwork and OVSNetwork status
+type NetworkStatus struct {
+	// generation of the network object observed when the status was updated
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// NetworkAttachmentDefinition rendered for the network object
+	NetworkAttachmentDefinition *NetworkAttachmentDefinitionRef `json:"networkAttachmentDefinition,omitempty"`
+	// sha256 hash of the rendered CNI config
+	ConfigHash string `json:"configHash,omitempty"`
+	// error of the last attempt to render or apply the NetworkAttachmentDefinition, including a conflict
+	// with the NetworkAttachmentDefinition of another network object, empty if it succeeded
+	LastError string `json:"lastError,omitempty"`
+	// Ready and Degraded conditions of the network
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// NetworkAttachmentDefinitionRef identifies the NetworkAttachmentDefinition rendered for a network object
+type NetworkAttachmentDefinitionRef struct {
+	// namespace of the NetworkAttachmentDefinition
+	Namespace string `json:"namespace"`
+	// name of the NetworkAttachmentDefinition
+	Name string `json:"name"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,86 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SriovNetworkNodeOverrideSpec defines the desired state of SriovNetworkNodeOverride
+type SriovNetworkNodeOverrideSpec struct {
+	// Interfaces are the overrides of the PFs configured by the policies on the node
+	Interfaces []InterfaceOverride `json:"interfaces,omitempty"`
+}
+
+// InterfaceOverride overrides the configuration rendered by the policies for a PF of the node
+type InterfaceOverride struct {
+	// PCI address of the PF, either pciAddress or name must be set
+	PciAddress string `json:"pciAddress,omitempty"`
+	// name of the PF, either pciAddress or name must be set
+	Name string `json:"name,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// number of VFs of the PF, the VF ranges of the policies are truncated to the number of VFs
+	NumVfs *int `json:"numVfs,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// MTU of the PF and of its VFs
+	Mtu *int `json:"mtu,omitempty"`
+	// +kubebuilder:validation:Enum=eth;ETH;ib;IB
+	// link type of the PF
+	LinkType string `json:"linkType,omitempty"`
+	// exclude the PF from the configuration of the node, e.g. a bad port
+	Exclude bool `json:"exclude,omitempty"`
+}
+
+// SriovNetworkNodeOverrideStatus defines the observed state of SriovNetworkNodeOverride
+type SriovNetworkNodeOverrideStatus struct {
+	// generation of the override applied to the SriovNetworkNodeState of the node
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// PFs of the node changed by the override
+	AppliedInterfaces []string `json:"appliedInterfaces,omitempty"`
+	// overrides that don't match a PF configured by the policies on the node
+	UnmatchedInterfaces []string `json:"unmatchedInterfaces,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Applied",type=string,JSONPath=`.status.appliedInterfaces`
+//+kubebuilder:printcolumn:name="Unmatched",type=string,JSONPath=`.status.unmatchedInterfaces`
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// SriovNetworkNodeOverride is the Schema for the sriovnetworknodeoverrides API.
+// The override named after a node is applied to the SriovNetworkNodeState of the node
+// after the policies, its settings take precedence over the ones of the policies.
+type SriovNetworkNodeOverride struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SriovNetworkNodeOverrideSpec   `json:"spec,omitempty"`
+	Status SriovNetworkNodeOverrideStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SriovNetworkNodeOverrideList contains a list of SriovNetworkNodeOverride
+type SriovNetworkNodeOverrideList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SriovNetworkNodeOverride `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SriovNetworkNodeOverride{}, &SriovNetworkNodeOverrideList{})
+}
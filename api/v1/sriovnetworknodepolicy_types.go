@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,6 +30,9 @@ type SriovNetworkNodePolicySpec struct {
 	ResourceName string `json:"resourceName"`
 	// NodeSelector selects the nodes to be configured
 	NodeSelector map[string]string `json:"nodeSelector"`
+	// NodeAffinity selects the nodes to be configured with node selector terms, the same as the
+	// required node affinity of a pod. The nodes must match one of the terms and the nodeSelector labels.
+	NodeAffinity *corev1.NodeSelector `json:"nodeAffinity,omitempty"`
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=99
 	// Priority of the policy, higher priority policies can override lower ones.
@@ -65,6 +69,148 @@ type SriovNetworkNodePolicySpec struct {
 	// contains bridge configuration for matching PFs,
 	// valid only for eSwitchMode==switchdev
 	Bridge Bridge `json:"bridge,omitempty"`
+	// Administrative attributes of the VFs, set on the host through the PF.
+	// Unset attributes are not managed by the operator.
+	VfAttributes *VfAttributes `json:"vfAttributes,omitempty"`
+	// Settings of the Intel E810 (ice) and X710/XL710 (i40e) NICs, applied by the intel plugin.
+	// Unset settings are not managed by the operator.
+	Intel *IntelConfig `json:"intel,omitempty"`
+	// Settings of the Mellanox NICs, applied by the mellanox plugin.
+	// Unset settings are not managed by the operator.
+	Mellanox *MellanoxConfig `json:"mellanox,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	// Devlink parameters of the matching PFs, set on the host by the config daemon.
+	// Unset parameters are not managed by the operator.
+	DevlinkParams []DevlinkParam `json:"devlinkParams,omitempty"`
+	// Ethtool settings of the matching PFs and of their VFs, set on the host by the config daemon.
+	// Unset settings are not managed by the operator.
+	Ethtool *EthtoolConfig `json:"ethtool,omitempty"`
+}
+
+// VfAttributes contains the administrative attributes of the VFs configured through the PF.
+// They apply to all the device types, including the VFs bound to vfio-pci and the VFs used outside pods.
+type VfAttributes struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4095
+	// VLAN ID of the VFs, 0 disables the VLAN tagging.
+	Vlan *int `json:"vlan,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=7
+	// VLAN QoS priority of the VFs, only valid with vlan.
+	VlanQoS *int `json:"vlanQoS,omitempty"`
+	// Enable the spoof checking of the VFs.
+	SpoofChk *bool `json:"spoofChk,omitempty"`
+	// Enable the trust mode of the VFs.
+	Trust *bool `json:"trust,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// Minimum tx rate of the VFs in Mbps, 0 disables the limit.
+	MinTxRate *int `json:"minTxRate,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// Maximum tx rate of the VFs in Mbps, 0 disables the limit.
+	MaxTxRate *int `json:"maxTxRate,omitempty"`
+}
+
+// DevlinkParam is a devlink parameter of the PF, equivalent to
+// `devlink dev param set pci/<pciAddress> name <name> value <value> cmode <cmode>`
+type DevlinkParam struct {
+	// +kubebuilder:validation:MinLength=1
+	// Name of the devlink parameter.
+	Name string `json:"name"`
+	// Value of the devlink parameter, the booleans are "true" or "false".
+	Value string `json:"value"`
+	// +kubebuilder:validation:Enum=runtime;driverinit;permanent
+	// +kubebuilder:default=runtime
+	// Configuration mode of the parameter. The node is drained and the driver of the device is reloaded
+	// to apply the driverinit parameters, the node is drained and rebooted to apply the permanent parameters.
+	Cmode string `json:"cmode,omitempty"`
+}
+
+// EthtoolConfig contains the ethtool settings of the PFs and of their VFs.
+type EthtoolConfig struct {
+	// Settings of the PFs, not applied to the externally managed PFs.
+	PF *EthtoolSettings `json:"pf,omitempty"`
+	// Settings of the VFs of the policy bound to a kernel driver, applied while the VFs are in the host network namespace.
+	VFs *EthtoolSettings `json:"vfs,omitempty"`
+}
+
+// EthtoolSettings contains the offloads, ring sizes and queue counts of a network device,
+// equivalent to `ethtool -K`, `ethtool -G` and `ethtool -L`.
+type EthtoolSettings struct {
+	// Offload features to enable or disable, for example gro, lro or rx-vlan-filter.
+	// The names are the ones reported by `ethtool -k`.
+	Features map[string]bool `json:"features,omitempty"`
+	// Sizes of the rx and tx rings.
+	Rings *EthtoolRings `json:"rings,omitempty"`
+	// Number of queues of the device.
+	Channels *EthtoolChannels `json:"channels,omitempty"`
+}
+
+// EthtoolRings contains the sizes of the rings of a network device.
+type EthtoolRings struct {
+	// +kubebuilder:validation:Minimum=1
+	// Size of the rx ring.
+	Rx *int `json:"rx,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// Size of the tx ring.
+	Tx *int `json:"tx,omitempty"`
+}
+
+// EthtoolChannels contains the number of queues of a network device.
+type EthtoolChannels struct {
+	// +kubebuilder:validation:Minimum=0
+	// Number of rx only queues.
+	Rx *int `json:"rx,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// Number of tx only queues.
+	Tx *int `json:"tx,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// Number of combined rx/tx queues.
+	Combined *int `json:"combined,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// Number of queues used for other purposes, like link interrupts.
+	Other *int `json:"other,omitempty"`
+}
+
+// IntelConfig contains the settings of the Intel NICs applied by the intel plugin.
+// The settings not supported by the driver of the NIC fail the configuration of the node.
+type IntelConfig struct {
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9._-]+\.pkg$`
+	// Name of the DDP package in /lib/firmware/intel/ice/ddp on the host to load for the NIC, only supported by the ice driver.
+	// The package is loaded by the driver after a reboot of the node.
+	DDPPackage string `json:"ddpPackage,omitempty"`
+	// Enable the firmware LLDP agent of the NIC, the agent must be disabled to configure DCB from the host.
+	FwLldp *bool `json:"fwLldp,omitempty"`
+	// Allow the trusted VFs in promiscuous mode to receive all the traffic of the PF
+	// instead of the traffic of the VLANs and MAC addresses of the VF.
+	VfTruePromiscSupport *bool `json:"vfTruePromiscSupport,omitempty"`
+	// Enable RoCEv2 on the NIC, only supported by the ice driver.
+	EnableRoce *bool `json:"enableRoce,omitempty"`
+	// Enable iWARP on the NIC, only supported by the ice driver.
+	EnableIwarp *bool `json:"enableIwarp,omitempty"`
+	// +kubebuilder:validation:Enum=5;9
+	// Number of layers of the Tx scheduler, only supported by the ice driver.
+	// The change is applied after a reboot of the node.
+	TxSchedulingLayers *int `json:"txSchedulingLayers,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// Maximum number of MSI-X vectors of the PF, only supported by the ice driver.
+	// The change is applied with a reload of the device.
+	MsixVecPerPfMax *int `json:"msixVecPerPfMax,omitempty"`
+}
+
+// MellanoxConfig contains the settings of the Mellanox NICs applied by the mellanox plugin.
+type MellanoxConfig struct {
+	// Firmware parameters of the NIC set with mstconfig, for example ATS: "True" or MAX_ACC_OUT_READ: "32".
+	// The values can be the names or the numbers reported by `mstconfig query`. SRIOV_EN, NUM_OF_VFS
+	// and LINK_TYPE_P1/P2 are managed from the other fields of the policy and can't be set here.
+	// The changes are applied after a firmware reset or a reboot of the node.
+	FirmwareParams map[string]string `json:"firmwareParams,omitempty"`
+	// +kubebuilder:validation:Enum=dpu;nic
+	// Mode of the BlueField DPUs, only supported by the BlueField-2 and BlueField-3 devices.
+	// In dpu mode the embedded Arm cores manage the eSwitch of the device, in nic mode the device
+	// behaves as a ConnectX NIC managed from the host. The change is applied with a reset of the firmware
+	// and a reboot of the node.
+	BlueFieldMode string `json:"blueFieldMode,omitempty"`
 }
 
 type SriovNetworkNicSelector struct {
@@ -81,6 +227,21 @@ type SriovNetworkNicSelector struct {
 	// - "openstack/NetworkID:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
 	// - "aws/NetworkID:xxxxxxxx"
 	NetFilter string `json:"netFilter,omitempty"`
+	// Names of the drivers of SR-IoV PF, e.g. "ice" or "mlx5_core".
+	Drivers []string `json:"drivers,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// Minimum link speed of SR-IoV PF in Mb/s, the PFs without link are not selected.
+	// A PF already configured by the policy stays selected when its link goes down.
+	MinLinkSpeed int `json:"minLinkSpeed,omitempty"`
+	// NUMA nodes of SR-IoV PF.
+	NumaNodes []int `json:"numaNodes,omitempty"`
+	// Firmware versions of SR-IoV PF, shell patterns are accepted, e.g. "22.3*".
+	// A PF already configured by the policy stays selected when its firmware is upgraded.
+	FirmwareVersions []string `json:"firmwareVersions,omitempty"`
+	// Names of the physical PCI slots of SR-IoV PF, shell patterns are accepted.
+	PhysicalSlots []string `json:"physicalSlots,omitempty"`
+	// PCI labels of SR-IoV PF assigned by the firmware, shell patterns are accepted, e.g. "NIC*".
+	PciLabels []string `json:"pciLabels,omitempty"`
 }
 
 // contains spec for the bridge
@@ -100,6 +261,48 @@ type OVSConfig struct {
 	Bridge OVSBridgeConfig `json:"bridge,omitempty"`
 	// contains settings for uplink (PF)
 	Uplink OVSUplinkConfig `json:"uplink,omitempty"`
+	// contains settings for the bond port, when set all PFs that match the policy on the node
+	// are added to a single bridge as members of the bond instead of one bridge per PF
+	Bond *OVSBondConfig `json:"bond,omitempty"`
+	// additional ports of the bridge, port names must be unique on the node.
+	// ports can be used only if the policy creates a single bridge on the node
+	Ports []OVSPortConfig `json:"ports,omitempty"`
+}
+
+// OVSPortConfig contains configuration for an additional port of the bridge
+type OVSPortConfig struct {
+	// name of the port and of its interface
+	Name string `json:"name"`
+	// type of the interface: patch to connect the bridge to another bridge, e.g. br-int or br-ex,
+	// or internal for host networking
+	// +kubebuilder:validation:Enum=patch;internal
+	Type string `json:"type"`
+	// name of the patch port on the other bridge, required for patch ports.
+	// the peer port must be created on the other bridge separately
+	Peer string `json:"peer,omitempty"`
+	// IP addresses in CIDR notation to assign to the internal port on the host
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+	// external_ids field in the Interface table in OVSDB
+	ExternalIDs map[string]string `json:"externalIDs,omitempty"`
+	// mtu_request field in the Interface table in OVSDB, internal ports only
+	MTURequest *int `json:"mtuRequest,omitempty"`
+}
+
+// OVSBondConfig contains bond settings for the uplinks (PFs) of the bridge
+type OVSBondConfig struct {
+	// name of the bond port in the bridge, generated from the bridge name if not set.
+	// must be unique on the node
+	Name string `json:"name,omitempty"`
+	// configure bond_mode field in the Port table in OVSDB
+	// +kubebuilder:validation:Enum=active-backup;balance-slb;balance-tcp
+	Mode string `json:"mode,omitempty"`
+	// configure lacp field in the Port table in OVSDB
+	// +kubebuilder:validation:Enum=active;passive;off
+	LACP string `json:"lacp,omitempty"`
+	// additional options to inject to other_config field in the Port table in OVSDB, e.g. lacp-time
+	OtherConfig map[string]string `json:"otherConfig,omitempty"`
+	// PCI addresses of the PFs to add to the bond, if not set all PFs that match the policy on the node are added
+	Members []string `json:"members,omitempty"`
 }
 
 // OVSBridgeConfig contains some options from the Bridge table in OVSDB
@@ -113,6 +316,32 @@ type OVSBridgeConfig struct {
 	// configure fail_mode field in the Bridge table in OVSDB (optional). 'secure' or 'standalone'.
 	// +kubebuilder:validation:Enum=secure;standalone
 	FailMode string `json:"failMode,omitempty"`
+	// QoS configuration for the uplink port of the bridge, applied to the bond port if the bond is configured
+	QoS *OVSQoSConfig `json:"qos,omitempty"`
+	// static OpenFlow rules to install on the bridge, in the ovs-ofctl add-flows format, e.g.
+	// "table=0,priority=100,in_port=pf0,actions=NORMAL". The cookie field must not be set,
+	// the operator marks the flows with its own cookie and manages only the flows with this cookie
+	Flows []string `json:"flows,omitempty"`
+}
+
+// OVSQoSConfig contains some options from the QoS table in OVSDB
+type OVSQoSConfig struct {
+	// type field in the QoS table in OVSDB
+	// +kubebuilder:validation:Enum=linux-htb;egress-policer
+	Type string `json:"type"`
+	// other_config field in the QoS table in OVSDB, e.g. max-rate for linux-htb or cir and cbs for egress-policer
+	OtherConfig map[string]string `json:"otherConfig,omitempty"`
+	// queues of the QoS, supported only for linux-htb
+	Queues []OVSQueueConfig `json:"queues,omitempty"`
+}
+
+// OVSQueueConfig contains some options from the Queue table in OVSDB
+type OVSQueueConfig struct {
+	// ID of the queue in the queues field of the QoS table, can be used in the set_queue action of the flows
+	// +kubebuilder:validation:Minimum=0
+	ID int `json:"id"`
+	// other_config field in the Queue table in OVSDB, e.g. min-rate, max-rate or priority
+	OtherConfig map[string]string `json:"otherConfig,omitempty"`
 }
 
 // OVSUplinkConfig contains PF interface configuration for the bridge
@@ -137,12 +366,57 @@ type OVSInterfaceConfig struct {
 
 // SriovNetworkNodePolicyStatus defines the observed state of SriovNetworkNodePolicy
 type SriovNetworkNodePolicyStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// number of nodes selected by the policy nodeSelector
+	MatchedNodes int `json:"matchedNodes,omitempty"`
+	// per node details for the nodes selected by the policy
+	Nodes []PolicyNodeStatus `json:"nodes,omitempty"`
+	// names of the selected nodes where the last sync failed,
+	// or where the plan failed for a policy in dry-run mode
+	FailedNodes []string `json:"failedNodes,omitempty"`
+	// conflicts with the other policies selecting the same PFs, the settings of the policy
+	// may be ignored on the nodes where they conflict with a policy of higher priority
+	Conflicts []PolicyConflict `json:"conflicts,omitempty"`
+	// Ready, Progressing and Degraded conditions aggregated from the SriovNetworkNodeState objects
+	// of the selected nodes
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// PolicyNodeStatus contains the state of the policy on a single node
+type PolicyNodeStatus struct {
+	// name of the node
+	Name string `json:"name"`
+	// pci addresses of the PFs on the node matched by the policy nicSelector
+	MatchedPFs []string `json:"matchedPFs,omitempty"`
+	// sync status reported by the config daemon on the node
+	SyncStatus string `json:"syncStatus,omitempty"`
+	// last sync error reported by the config daemon on the node
+	LastSyncError string `json:"lastSyncError,omitempty"`
+	// changes planned on the node for the dry-run policies selecting it,
+	// only reported when the policy is in dry-run mode
+	Plan *NodeStatePlan `json:"plan,omitempty"`
+}
+
+// PolicyConflict describes a conflict between the policy and another policy on the PFs they both select
+type PolicyConflict struct {
+	// name of the other policy
+	Policy string `json:"policy"`
+	// type of the conflict: VfRangeOverlap, ResourceNameCollision, MtuMismatch, LinkTypeMismatch or EswitchModeMismatch
+	Reason string `json:"reason"`
+	// description of the conflict
+	Message string `json:"message"`
+	// true when the other policy has the higher priority and its settings take precedence
+	Overridden bool `json:"overridden,omitempty"`
+	// names of the nodes where the policies conflict
+	Nodes []string `json:"nodes,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Matched Nodes",type=integer,JSONPath=`.status.matchedNodes`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // SriovNetworkNodePolicy is the Schema for the sriovnetworknodepolicies API
 type SriovNetworkNodePolicy struct {
@@ -41,16 +41,27 @@ type Interface struct {
 	EswitchMode       string    `json:"eSwitchMode,omitempty"`
 	VfGroups          []VfGroup `json:"vfGroups,omitempty"`
 	ExternallyManaged bool      `json:"externallyManaged,omitempty"`
+	// settings of the Intel NICs applied by the intel plugin
+	Intel *IntelConfig `json:"intel,omitempty"`
+	// settings of the Mellanox NICs applied by the mellanox plugin
+	Mellanox *MellanoxConfig `json:"mellanox,omitempty"`
+	// devlink parameters of the PF
+	DevlinkParams []DevlinkParam `json:"devlinkParams,omitempty"`
+	// ethtool settings of the PF
+	Ethtool *EthtoolSettings `json:"ethtool,omitempty"`
 }
 
 type VfGroup struct {
-	ResourceName string `json:"resourceName,omitempty"`
-	DeviceType   string `json:"deviceType,omitempty"`
-	VfRange      string `json:"vfRange,omitempty"`
-	PolicyName   string `json:"policyName,omitempty"`
-	Mtu          int    `json:"mtu,omitempty"`
-	IsRdma       bool   `json:"isRdma,omitempty"`
-	VdpaType     string `json:"vdpaType,omitempty"`
+	ResourceName string        `json:"resourceName,omitempty"`
+	DeviceType   string        `json:"deviceType,omitempty"`
+	VfRange      string        `json:"vfRange,omitempty"`
+	PolicyName   string        `json:"policyName,omitempty"`
+	Mtu          int           `json:"mtu,omitempty"`
+	IsRdma       bool          `json:"isRdma,omitempty"`
+	VdpaType     string        `json:"vdpaType,omitempty"`
+	VfAttributes *VfAttributes `json:"vfAttributes,omitempty"`
+	// ethtool settings of the VFs bound to a kernel driver
+	Ethtool *EthtoolSettings `json:"ethtool,omitempty"`
 }
 
 type InterfaceExt struct {
@@ -70,6 +81,14 @@ type InterfaceExt struct {
 	ExternallyManaged bool              `json:"externallyManaged,omitempty"`
 	TotalVfs          int               `json:"totalvfs,omitempty"`
 	VFs               []VirtualFunction `json:"Vfs,omitempty"`
+	// NUMA node of the PF, not set if the platform doesn't report it
+	NumaNode *int `json:"numaNode,omitempty"`
+	// firmware version of the PF reported by ethtool
+	FirmwareVersion string `json:"firmwareVersion,omitempty"`
+	// name of the physical PCI slot of the PF
+	PhysicalSlot string `json:"physicalSlot,omitempty"`
+	// label of the PF assigned by the firmware, e.g. the SMBIOS device name
+	PciLabel string `json:"pciLabel,omitempty"`
 }
 type InterfaceExts []InterfaceExt
 
@@ -82,6 +101,11 @@ type VirtualFunction struct {
 	Vendor          string `json:"vendor,omitempty"`
 	DeviceID        string `json:"deviceID,omitempty"`
 	Vlan            int    `json:"Vlan,omitempty"`
+	VlanQoS         int    `json:"vlanQoS,omitempty"`
+	SpoofChk        *bool  `json:"spoofChk,omitempty"`
+	Trust           *bool  `json:"trust,omitempty"`
+	MinTxRate       int    `json:"minTxRate,omitempty"`
+	MaxTxRate       int    `json:"maxTxRate,omitempty"`
 	Mtu             int    `json:"mtu,omitempty"`
 	VfID            int    `json:"vfID"`
 	VdpaType        string `json:"vdpaType,omitempty"`
@@ -101,8 +125,48 @@ type OVSConfigExt struct {
 	// bridge-level configuration for the bridge
 	Bridge OVSBridgeConfig `json:"bridge,omitempty"`
 	// uplink-level bridge configuration for each uplink(PF).
-	// currently must contain only one element
+	// must contain only one element if bond is not set
 	Uplinks []OVSUplinkConfigExt `json:"uplinks,omitempty"`
+	// bond configuration for the uplinks, all uplinks are members of the bond
+	Bond *OVSBondConfigExt `json:"bond,omitempty"`
+	// additional ports of the bridge
+	Ports []OVSPortConfig `json:"ports,omitempty"`
+	// observed state of the bridge, reported only in the status
+	State *OVSBridgeState `json:"state,omitempty"`
+}
+
+// OVSBridgeState contains the observed state of the OVS bridge
+type OVSBridgeState struct {
+	// hw-offload field from other_config of the Open_vSwitch table in OVSDB
+	HwOffload string `json:"hwOffload,omitempty"`
+	// number of the datapath flows of the bridge which are offloaded to the hardware, refreshed every 5 minutes
+	OffloadedFlows *int `json:"offloadedFlows,omitempty"`
+	// state of the uplink interfaces, including the interfaces which are in error state
+	Uplinks []OVSInterfaceState `json:"uplinks,omitempty"`
+}
+
+// OVSInterfaceState contains the observed state of the uplink interface from the Interface table in OVSDB
+type OVSInterfaceState struct {
+	// pci address of the PF
+	PciAddress string `json:"pciAddress"`
+	// name of the PF interface
+	Name string `json:"name,omitempty"`
+	// link_state field in the Interface table in OVSDB
+	LinkState string `json:"linkState,omitempty"`
+	// error field in the Interface table in OVSDB
+	Error string `json:"error,omitempty"`
+}
+
+// OVSBondConfigExt contains configuration for the concrete OVS bond port
+type OVSBondConfigExt struct {
+	// name of the bond port
+	Name string `json:"name"`
+	// bond_mode field in the Port table in OVSDB
+	Mode string `json:"mode,omitempty"`
+	// lacp field in the Port table in OVSDB
+	LACP string `json:"lacp,omitempty"`
+	// other_config field in the Port table in OVSDB
+	OtherConfig map[string]string `json:"otherConfig,omitempty"`
 }
 
 // OVSUplinkConfigExt contains configuration for the concrete OVS uplink(PF)
@@ -128,6 +192,99 @@ type SriovNetworkNodeStateStatus struct {
 	System        System        `json:"system,omitempty"`
 	SyncStatus    string        `json:"syncStatus,omitempty"`
 	LastSyncError string        `json:"lastSyncError,omitempty"`
+	// per interface result of the last configuration attempt
+	// +listType=map
+	// +listMapKey=pciAddress
+	InterfaceSyncResults []InterfaceSyncResult `json:"interfaceSyncResults,omitempty"`
+	// Ready, Progressing and Degraded conditions of the node configuration,
+	// and the Drain condition mirroring the drain state of the node
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// changes required on the node to apply the spec rendered with the dry-run policies
+	Plan *NodeStatePlan `json:"plan,omitempty"`
+	// set when the daemon re-applied the last known-good configuration because the current generation failed to sync
+	Rollback *NodeStateRollback `json:"rollback,omitempty"`
+}
+
+// NodeStateRollback describes the rollback of a failing generation to the last known-good configuration
+type NodeStateRollback struct {
+	// generation of the SriovNetworkNodeState that failed to sync
+	FailedGeneration int64 `json:"failedGeneration"`
+	// generation of the last successfully applied configuration the node was rolled back to
+	KnownGoodGeneration int64 `json:"knownGoodGeneration"`
+	// number of failed attempts to apply the failed generation
+	FailedAttempts int `json:"failedAttempts,omitempty"`
+	// error reported by the last failed attempt
+	Error string `json:"error,omitempty"`
+	// time of the rollback
+	Time metav1.Time `json:"time,omitempty"`
+}
+
+// NodeStatePlan contains the changes the config daemon would do on the node to apply the planned spec
+// published by the operator when dry-run policies select the node
+type NodeStatePlan struct {
+	// hash of the planned spec the plan was computed for
+	PlannedSpecHash string `json:"plannedSpecHash,omitempty"`
+	// generation of the SriovNetworkNodeState the plan was computed against
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// interfaces that would be added, updated or removed from the node spec
+	InterfaceChanges []InterfaceChange `json:"interfaceChanges,omitempty"`
+	// OVS bridges that would be added, updated or removed from the node spec
+	BridgeChanges []BridgeChange `json:"bridgeChanges,omitempty"`
+	// system settings that would change, in the "field: current -> planned" format
+	SystemChanges []string `json:"systemChanges,omitempty"`
+	// the node would be drained to apply the planned spec
+	DrainRequired bool `json:"drainRequired,omitempty"`
+	// the node would be rebooted to apply the planned spec
+	RebootRequired bool `json:"rebootRequired,omitempty"`
+	// kernel arguments that would be added to the node
+	KernelArgsToAdd []string `json:"kernelArgsToAdd,omitempty"`
+	// kernel arguments that would be removed from the node
+	KernelArgsToRemove []string `json:"kernelArgsToRemove,omitempty"`
+	// plugins that don't support planning, the changes they would do are not included in the plan
+	UnplannedPlugins []string `json:"unplannedPlugins,omitempty"`
+	// error reported while computing the plan
+	Error string `json:"error,omitempty"`
+}
+
+// InterfaceChange describes the difference between the current and the planned spec of a PF
+type InterfaceChange struct {
+	// pci address of the PF
+	PciAddress string `json:"pciAddress"`
+	// name of the PF interface
+	Name string `json:"name,omitempty"`
+	// +kubebuilder:validation:Enum=Add;Update;Remove
+	// change applied to the PF. Allowed value "Add", "Update", "Remove".
+	Action string `json:"action"`
+	// the changed fields in the "field: current -> planned" format
+	Changes []string `json:"changes,omitempty"`
+}
+
+// BridgeChange describes the change of an OVS bridge between the current and the planned spec
+type BridgeChange struct {
+	// name of the bridge
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=Add;Update;Remove
+	// change applied to the bridge. Allowed value "Add", "Update", "Remove".
+	Action string `json:"action"`
+	// the changed fields in the "field: current -> planned" format
+	Changes []string `json:"changes,omitempty"`
+}
+
+// InterfaceSyncResult contains the result of the last configuration attempt for a single PF
+type InterfaceSyncResult struct {
+	// pci address of the PF
+	PciAddress string `json:"pciAddress"`
+	// name of the PF interface
+	Name string `json:"name,omitempty"`
+	// +kubebuilder:validation:Enum=Succeeded;Failed;Pending
+	// phase of the interface configuration. Allowed value "Succeeded", "Failed", "Pending".
+	Phase string `json:"phase"`
+	// machine readable reason code for the phase
+	Reason string `json:"reason,omitempty"`
+	// human readable details, contains the error for failed interfaces
+	Message string `json:"message,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -135,6 +292,7 @@ type SriovNetworkNodeStateStatus struct {
 //+kubebuilder:printcolumn:name="Sync Status",type=string,JSONPath=`.status.syncStatus`
 //+kubebuilder:printcolumn:name="Desired Sync State",type=string,JSONPath=`.metadata.annotations.sriovnetwork\.openshift\.io/desired-state`
 //+kubebuilder:printcolumn:name="Current Sync State",type=string,JSONPath=`.metadata.annotations.sriovnetwork\.openshift\.io/current-state`
+//+kubebuilder:printcolumn:name="Override",type=string,JSONPath=`.metadata.annotations.sriovnetwork\.openshift\.io/applied-override`,priority=1
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // SriovNetworkNodeState is the Schema for the sriovnetworknodestates API
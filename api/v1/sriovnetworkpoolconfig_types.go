@@ -41,6 +41,37 @@ type SriovNetworkPoolConfigSpec struct {
 	// +kubebuilder:validation:Enum=shared;exclusive
 	// RDMA subsystem. Allowed value "shared", "exclusive".
 	RdmaMode string `json:"rdmaMode,omitempty"`
+
+	// maintenanceWindows defines the time windows where the drain and the reboot
+	// of the nodes in the pool can start. When empty, they can start at any time.
+	// Outside a window, the nodes with pending changes wait for the next window.
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// rollout stages the policy changes on the nodes of the pool, a few canary nodes
+	// are configured first and the changes are promoted to the rest of the pool once they are healthy.
+	// When not set, the policy changes are applied to all the nodes of the pool at once.
+	Rollout *RolloutConfig `json:"rollout,omitempty"`
+}
+
+// RolloutConfig defines the canary rollout of the policy changes in a pool
+type RolloutConfig struct {
+	// +kubebuilder:validation:Minimum=1
+	// number of nodes of the pool receiving the policy changes first
+	CanaryNodes int `json:"canaryNodes"`
+	// time the canary nodes must stay healthy after their sync succeeded before the changes
+	// are promoted to the rest of the pool, e.g. "30m"
+	SoakPeriod metav1.Duration `json:"soakPeriod,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring time window
+type MaintenanceWindow struct {
+	// start of the window in the cron format "minute hour day-of-month month day-of-week",
+	// e.g. "0 2 * * 6" for every Saturday at 02:00
+	Schedule string `json:"schedule"`
+	// duration of the window, e.g. "4h"
+	Duration metav1.Duration `json:"duration"`
+	// IANA name of the time zone of the schedule, e.g. "Europe/Paris". Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 type OvsHardwareOffloadConfig struct {
@@ -54,6 +85,44 @@ type OvsHardwareOffloadConfig struct {
 
 // SriovNetworkPoolConfigStatus defines the observed state of SriovNetworkPoolConfig
 type SriovNetworkPoolConfigStatus struct {
+	// status of the canary rollout of the policy changes, only reported when rollout is configured
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+	// Degraded condition of the pool, true while the rollout is halted by a failed node
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// RolloutStatus reports the progress of the rollout of a revision of the policies in a pool
+type RolloutStatus struct {
+	// hash of the policies and pool configuration being rolled out
+	Revision string `json:"revision,omitempty"`
+	// +kubebuilder:validation:Enum=Canary;Soaking;Promoted;Halted
+	// Canary: the changes are applied to the canary nodes only
+	// Soaking: the canary nodes are synced, waiting for the soak period
+	// Promoted: the changes are applied to all the nodes of the pool
+	// Halted: a node which received the changes failed, the rest of the pool keeps the previous configuration until
+	// the node recovers or the revision is promoted with the sriovnetwork.openshift.io/promote-rollout annotation
+	Phase string `json:"phase,omitempty"`
+	// nodes receiving the changes first
+	CanaryNodes []RolloutNode `json:"canaryNodes,omitempty"`
+	// nodes which received the changes after the promotion of the revision
+	PromotedNodes []RolloutNode `json:"promotedNodes,omitempty"`
+	// time when all the canary nodes were synced
+	CanarySyncedTime *metav1.Time `json:"canarySyncedTime,omitempty"`
+	// time of the last phase change
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// details about the current phase
+	Message string `json:"message,omitempty"`
+}
+
+// RolloutNode is a node which received the revision of the rollout
+type RolloutNode struct {
+	// name of the node
+	Name string `json:"name"`
+	// generation of the SriovNetworkNodeState rendered with the revision,
+	// the node is synced once the daemon reports it ready for this generation
+	Generation int64 `json:"generation,omitempty"`
 }
 
 //+kubebuilder:object:root=true
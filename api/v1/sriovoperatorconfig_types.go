@@ -53,6 +53,11 @@ type SriovOperatorConfigSpec struct {
 	LogLevel int `json:"logLevel,omitempty"`
 	// Flag to disable nodes drain during debugging
 	DisableDrain bool `json:"disableDrain,omitempty"`
+	// Number of consecutive failed attempts to apply a new SriovNetworkNodeState generation after which
+	// the config daemon re-applies the last successfully applied configuration of the node.
+	// Set to '0' to disable the automatic rollback.
+	// +kubebuilder:validation:Minimum=0
+	AutoRollbackAfterFailures int `json:"autoRollbackAfterFailures,omitempty"`
 	// Flag to enable OVS hardware offload. Set to 'true' to provision switchdev-configuration.service and enable OpenvSwitch hw-offload on nodes.
 	EnableOvsOffload bool `json:"enableOvsOffload,omitempty"`
 	// Flag to enable the sriov-network-config-daemon to use a systemd service to configure SR-IOV devices on boot
@@ -21,6 +21,7 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -46,6 +47,26 @@ func (in *Bridge) DeepCopy() *Bridge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeChange) DeepCopyInto(out *BridgeChange) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeChange.
+func (in *BridgeChange) DeepCopy() *BridgeChange {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bridges) DeepCopyInto(out *Bridges) {
 	*out = *in
@@ -89,86 +110,545 @@ func (in ByPriority) DeepCopy() ByPriority {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DevlinkParam) DeepCopyInto(out *DevlinkParam) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevlinkParam.
+func (in *DevlinkParam) DeepCopy() *DevlinkParam {
+	if in == nil {
+		return nil
+	}
+	out := new(DevlinkParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthtoolChannels) DeepCopyInto(out *EthtoolChannels) {
+	*out = *in
+	if in.Rx != nil {
+		in, out := &in.Rx, &out.Rx
+		*out = new(int)
+		**out = **in
+	}
+	if in.Tx != nil {
+		in, out := &in.Tx, &out.Tx
+		*out = new(int)
+		**out = **in
+	}
+	if in.Combined != nil {
+		in, out := &in.Combined, &out.Combined
+		*out = new(int)
+		**out = **in
+	}
+	if in.Other != nil {
+		in, out := &in.Other, &out.Other
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthtoolChannels.
+func (in *EthtoolChannels) DeepCopy() *EthtoolChannels {
+	if in == nil {
+		return nil
+	}
+	out := new(EthtoolChannels)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthtoolConfig) DeepCopyInto(out *EthtoolConfig) {
+	*out = *in
+	if in.PF != nil {
+		in, out := &in.PF, &out.PF
+		*out = new(EthtoolSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VFs != nil {
+		in, out := &in.VFs, &out.VFs
+		*out = new(EthtoolSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthtoolConfig.
+func (in *EthtoolConfig) DeepCopy() *EthtoolConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EthtoolConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthtoolRings) DeepCopyInto(out *EthtoolRings) {
+	*out = *in
+	if in.Rx != nil {
+		in, out := &in.Rx, &out.Rx
+		*out = new(int)
+		**out = **in
+	}
+	if in.Tx != nil {
+		in, out := &in.Tx, &out.Tx
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthtoolRings.
+func (in *EthtoolRings) DeepCopy() *EthtoolRings {
+	if in == nil {
+		return nil
+	}
+	out := new(EthtoolRings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EthtoolSettings) DeepCopyInto(out *EthtoolSettings) {
+	*out = *in
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Rings != nil {
+		in, out := &in.Rings, &out.Rings
+		*out = new(EthtoolRings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = new(EthtoolChannels)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EthtoolSettings.
+func (in *EthtoolSettings) DeepCopy() *EthtoolSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(EthtoolSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntelConfig) DeepCopyInto(out *IntelConfig) {
+	*out = *in
+	if in.FwLldp != nil {
+		in, out := &in.FwLldp, &out.FwLldp
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VfTruePromiscSupport != nil {
+		in, out := &in.VfTruePromiscSupport, &out.VfTruePromiscSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableRoce != nil {
+		in, out := &in.EnableRoce, &out.EnableRoce
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableIwarp != nil {
+		in, out := &in.EnableIwarp, &out.EnableIwarp
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TxSchedulingLayers != nil {
+		in, out := &in.TxSchedulingLayers, &out.TxSchedulingLayers
+		*out = new(int)
+		**out = **in
+	}
+	if in.MsixVecPerPfMax != nil {
+		in, out := &in.MsixVecPerPfMax, &out.MsixVecPerPfMax
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntelConfig.
+func (in *IntelConfig) DeepCopy() *IntelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IntelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
 	if in.VfGroups != nil {
 		in, out := &in.VfGroups, &out.VfGroups
 		*out = make([]VfGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Intel != nil {
+		in, out := &in.Intel, &out.Intel
+		*out = new(IntelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mellanox != nil {
+		in, out := &in.Mellanox, &out.Mellanox
+		*out = new(MellanoxConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DevlinkParams != nil {
+		in, out := &in.DevlinkParams, &out.DevlinkParams
+		*out = make([]DevlinkParam, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ethtool != nil {
+		in, out := &in.Ethtool, &out.Ethtool
+		*out = new(EthtoolSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interface.
+func (in *Interface) DeepCopy() *Interface {
+	if in == nil {
+		return nil
+	}
+	out := new(Interface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceChange) DeepCopyInto(out *InterfaceChange) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceChange.
+func (in *InterfaceChange) DeepCopy() *InterfaceChange {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceExt) DeepCopyInto(out *InterfaceExt) {
+	*out = *in
+	if in.VFs != nil {
+		in, out := &in.VFs, &out.VFs
+		*out = make([]VirtualFunction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NumaNode != nil {
+		in, out := &in.NumaNode, &out.NumaNode
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceExt.
+func (in *InterfaceExt) DeepCopy() *InterfaceExt {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceExt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in InterfaceExts) DeepCopyInto(out *InterfaceExts) {
+	{
+		in := &in
+		*out = make(InterfaceExts, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceExts.
+func (in InterfaceExts) DeepCopy() InterfaceExts {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceExts)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceOverride) DeepCopyInto(out *InterfaceOverride) {
+	*out = *in
+	if in.NumVfs != nil {
+		in, out := &in.NumVfs, &out.NumVfs
+		*out = new(int)
+		**out = **in
+	}
+	if in.Mtu != nil {
+		in, out := &in.Mtu, &out.Mtu
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceOverride.
+func (in *InterfaceOverride) DeepCopy() *InterfaceOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSyncResult) DeepCopyInto(out *InterfaceSyncResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSyncResult.
+func (in *InterfaceSyncResult) DeepCopy() *InterfaceSyncResult {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceSyncResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Interfaces) DeepCopyInto(out *Interfaces) {
+	{
+		in := &in
+		*out = make(Interfaces, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interfaces.
+func (in Interfaces) DeepCopy() Interfaces {
+	if in == nil {
+		return nil
+	}
+	out := new(Interfaces)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MellanoxConfig) DeepCopyInto(out *MellanoxConfig) {
+	*out = *in
+	if in.FirmwareParams != nil {
+		in, out := &in.FirmwareParams, &out.FirmwareParams
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MellanoxConfig.
+func (in *MellanoxConfig) DeepCopy() *MellanoxConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MellanoxConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkAttachmentDefinitionRef) DeepCopyInto(out *NetworkAttachmentDefinitionRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkAttachmentDefinitionRef.
+func (in *NetworkAttachmentDefinitionRef) DeepCopy() *NetworkAttachmentDefinitionRef {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkAttachmentDefinitionRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	if in.NetworkAttachmentDefinition != nil {
+		in, out := &in.NetworkAttachmentDefinition, &out.NetworkAttachmentDefinition
+		*out = new(NetworkAttachmentDefinitionRef)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *NodeStatePlan) DeepCopyInto(out *NodeStatePlan) {
+	*out = *in
+	if in.InterfaceChanges != nil {
+		in, out := &in.InterfaceChanges, &out.InterfaceChanges
+		*out = make([]InterfaceChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BridgeChanges != nil {
+		in, out := &in.BridgeChanges, &out.BridgeChanges
+		*out = make([]BridgeChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SystemChanges != nil {
+		in, out := &in.SystemChanges, &out.SystemChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KernelArgsToAdd != nil {
+		in, out := &in.KernelArgsToAdd, &out.KernelArgsToAdd
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KernelArgsToRemove != nil {
+		in, out := &in.KernelArgsToRemove, &out.KernelArgsToRemove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnplannedPlugins != nil {
+		in, out := &in.UnplannedPlugins, &out.UnplannedPlugins
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interface.
-func (in *Interface) DeepCopy() *Interface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatePlan.
+func (in *NodeStatePlan) DeepCopy() *NodeStatePlan {
 	if in == nil {
 		return nil
 	}
-	out := new(Interface)
+	out := new(NodeStatePlan)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceExt) DeepCopyInto(out *InterfaceExt) {
+func (in *NodeStateRollback) DeepCopyInto(out *NodeStateRollback) {
 	*out = *in
-	if in.VFs != nil {
-		in, out := &in.VFs, &out.VFs
-		*out = make([]VirtualFunction, len(*in))
-		copy(*out, *in)
-	}
+	in.Time.DeepCopyInto(&out.Time)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceExt.
-func (in *InterfaceExt) DeepCopy() *InterfaceExt {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStateRollback.
+func (in *NodeStateRollback) DeepCopy() *NodeStateRollback {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceExt)
+	out := new(NodeStateRollback)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in InterfaceExts) DeepCopyInto(out *InterfaceExts) {
-	{
-		in := &in
-		*out = make(InterfaceExts, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+func (in *OVSBondConfig) DeepCopyInto(out *OVSBondConfig) {
+	*out = *in
+	if in.OtherConfig != nil {
+		in, out := &in.OtherConfig, &out.OtherConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceExts.
-func (in InterfaceExts) DeepCopy() InterfaceExts {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSBondConfig.
+func (in *OVSBondConfig) DeepCopy() *OVSBondConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfaceExts)
+	out := new(OVSBondConfig)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in Interfaces) DeepCopyInto(out *Interfaces) {
-	{
-		in := &in
-		*out = make(Interfaces, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+func (in *OVSBondConfigExt) DeepCopyInto(out *OVSBondConfigExt) {
+	*out = *in
+	if in.OtherConfig != nil {
+		in, out := &in.OtherConfig, &out.OtherConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Interfaces.
-func (in Interfaces) DeepCopy() Interfaces {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSBondConfigExt.
+func (in *OVSBondConfigExt) DeepCopy() *OVSBondConfigExt {
 	if in == nil {
 		return nil
 	}
-	out := new(Interfaces)
+	out := new(OVSBondConfigExt)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -188,6 +668,16 @@ func (in *OVSBridgeConfig) DeepCopyInto(out *OVSBridgeConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.QoS != nil {
+		in, out := &in.QoS, &out.QoS
+		*out = new(OVSQoSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Flows != nil {
+		in, out := &in.Flows, &out.Flows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSBridgeConfig.
@@ -200,11 +690,48 @@ func (in *OVSBridgeConfig) DeepCopy() *OVSBridgeConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVSBridgeState) DeepCopyInto(out *OVSBridgeState) {
+	*out = *in
+	if in.OffloadedFlows != nil {
+		in, out := &in.OffloadedFlows, &out.OffloadedFlows
+		*out = new(int)
+		**out = **in
+	}
+	if in.Uplinks != nil {
+		in, out := &in.Uplinks, &out.Uplinks
+		*out = make([]OVSInterfaceState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSBridgeState.
+func (in *OVSBridgeState) DeepCopy() *OVSBridgeState {
+	if in == nil {
+		return nil
+	}
+	out := new(OVSBridgeState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OVSConfig) DeepCopyInto(out *OVSConfig) {
 	*out = *in
 	in.Bridge.DeepCopyInto(&out.Bridge)
 	in.Uplink.DeepCopyInto(&out.Uplink)
+	if in.Bond != nil {
+		in, out := &in.Bond, &out.Bond
+		*out = new(OVSBondConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]OVSPortConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSConfig.
@@ -228,6 +755,23 @@ func (in *OVSConfigExt) DeepCopyInto(out *OVSConfigExt) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Bond != nil {
+		in, out := &in.Bond, &out.Bond
+		*out = new(OVSBondConfigExt)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]OVSPortConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(OVSBridgeState)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSConfigExt.
@@ -281,13 +825,28 @@ func (in *OVSInterfaceConfig) DeepCopy() *OVSInterfaceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVSInterfaceState) DeepCopyInto(out *OVSInterfaceState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSInterfaceState.
+func (in *OVSInterfaceState) DeepCopy() *OVSInterfaceState {
+	if in == nil {
+		return nil
+	}
+	out := new(OVSInterfaceState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OVSNetwork) DeepCopyInto(out *OVSNetwork) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSNetwork.
@@ -341,110 +900,303 @@ func (in *OVSNetworkList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OVSNetworkSpec) DeepCopyInto(out *OVSNetworkSpec) {
-	*out = *in
-	if in.Trunk != nil {
-		in, out := &in.Trunk, &out.Trunk
-		*out = make([]*TrunkConfig, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(TrunkConfig)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+func (in *OVSNetworkSpec) DeepCopyInto(out *OVSNetworkSpec) {
+	*out = *in
+	if in.Trunk != nil {
+		in, out := &in.Trunk, &out.Trunk
+		*out = make([]*TrunkConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TrunkConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSNetworkSpec.
+func (in *OVSNetworkSpec) DeepCopy() *OVSNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OVSNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVSNetworkStatus) DeepCopyInto(out *OVSNetworkStatus) {
+	*out = *in
+	in.NetworkStatus.DeepCopyInto(&out.NetworkStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSNetworkStatus.
+func (in *OVSNetworkStatus) DeepCopy() *OVSNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OVSNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVSPortConfig) DeepCopyInto(out *OVSPortConfig) {
+	*out = *in
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExternalIDs != nil {
+		in, out := &in.ExternalIDs, &out.ExternalIDs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MTURequest != nil {
+		in, out := &in.MTURequest, &out.MTURequest
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSPortConfig.
+func (in *OVSPortConfig) DeepCopy() *OVSPortConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OVSPortConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVSQoSConfig) DeepCopyInto(out *OVSQoSConfig) {
+	*out = *in
+	if in.OtherConfig != nil {
+		in, out := &in.OtherConfig, &out.OtherConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = make([]OVSQueueConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSQoSConfig.
+func (in *OVSQoSConfig) DeepCopy() *OVSQoSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OVSQoSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVSQueueConfig) DeepCopyInto(out *OVSQueueConfig) {
+	*out = *in
+	if in.OtherConfig != nil {
+		in, out := &in.OtherConfig, &out.OtherConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSQueueConfig.
+func (in *OVSQueueConfig) DeepCopy() *OVSQueueConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OVSQueueConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVSUplinkConfig) DeepCopyInto(out *OVSUplinkConfig) {
+	*out = *in
+	in.Interface.DeepCopyInto(&out.Interface)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSUplinkConfig.
+func (in *OVSUplinkConfig) DeepCopy() *OVSUplinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OVSUplinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVSUplinkConfigExt) DeepCopyInto(out *OVSUplinkConfigExt) {
+	*out = *in
+	in.Interface.DeepCopyInto(&out.Interface)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSUplinkConfigExt.
+func (in *OVSUplinkConfigExt) DeepCopy() *OVSUplinkConfigExt {
+	if in == nil {
+		return nil
+	}
+	out := new(OVSUplinkConfigExt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OvsHardwareOffloadConfig) DeepCopyInto(out *OvsHardwareOffloadConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OvsHardwareOffloadConfig.
+func (in *OvsHardwareOffloadConfig) DeepCopy() *OvsHardwareOffloadConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OvsHardwareOffloadConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in PluginNameSlice) DeepCopyInto(out *PluginNameSlice) {
+	{
+		in := &in
+		*out = make(PluginNameSlice, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSNetworkSpec.
-func (in *OVSNetworkSpec) DeepCopy() *OVSNetworkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginNameSlice.
+func (in PluginNameSlice) DeepCopy() PluginNameSlice {
 	if in == nil {
 		return nil
 	}
-	out := new(OVSNetworkSpec)
+	out := new(PluginNameSlice)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OVSNetworkStatus) DeepCopyInto(out *OVSNetworkStatus) {
+func (in *PolicyConflict) DeepCopyInto(out *PolicyConflict) {
 	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSNetworkStatus.
-func (in *OVSNetworkStatus) DeepCopy() *OVSNetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyConflict.
+func (in *PolicyConflict) DeepCopy() *PolicyConflict {
 	if in == nil {
 		return nil
 	}
-	out := new(OVSNetworkStatus)
+	out := new(PolicyConflict)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OVSUplinkConfig) DeepCopyInto(out *OVSUplinkConfig) {
+func (in *PolicyNodeStatus) DeepCopyInto(out *PolicyNodeStatus) {
 	*out = *in
-	in.Interface.DeepCopyInto(&out.Interface)
+	if in.MatchedPFs != nil {
+		in, out := &in.MatchedPFs, &out.MatchedPFs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(NodeStatePlan)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSUplinkConfig.
-func (in *OVSUplinkConfig) DeepCopy() *OVSUplinkConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyNodeStatus.
+func (in *PolicyNodeStatus) DeepCopy() *PolicyNodeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OVSUplinkConfig)
+	out := new(PolicyNodeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OVSUplinkConfigExt) DeepCopyInto(out *OVSUplinkConfigExt) {
+func (in *RolloutConfig) DeepCopyInto(out *RolloutConfig) {
 	*out = *in
-	in.Interface.DeepCopyInto(&out.Interface)
+	out.SoakPeriod = in.SoakPeriod
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVSUplinkConfigExt.
-func (in *OVSUplinkConfigExt) DeepCopy() *OVSUplinkConfigExt {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutConfig.
+func (in *RolloutConfig) DeepCopy() *RolloutConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OVSUplinkConfigExt)
+	out := new(RolloutConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OvsHardwareOffloadConfig) DeepCopyInto(out *OvsHardwareOffloadConfig) {
+func (in *RolloutNode) DeepCopyInto(out *RolloutNode) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OvsHardwareOffloadConfig.
-func (in *OvsHardwareOffloadConfig) DeepCopy() *OvsHardwareOffloadConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutNode.
+func (in *RolloutNode) DeepCopy() *RolloutNode {
 	if in == nil {
 		return nil
 	}
-	out := new(OvsHardwareOffloadConfig)
+	out := new(RolloutNode)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in PluginNameSlice) DeepCopyInto(out *PluginNameSlice) {
-	{
-		in := &in
-		*out = make(PluginNameSlice, len(*in))
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.CanaryNodes != nil {
+		in, out := &in.CanaryNodes, &out.CanaryNodes
+		*out = make([]RolloutNode, len(*in))
+		copy(*out, *in)
+	}
+	if in.PromotedNodes != nil {
+		in, out := &in.PromotedNodes, &out.PromotedNodes
+		*out = make([]RolloutNode, len(*in))
 		copy(*out, *in)
 	}
+	if in.CanarySyncedTime != nil {
+		in, out := &in.CanarySyncedTime, &out.CanarySyncedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginNameSlice.
-func (in PluginNameSlice) DeepCopy() PluginNameSlice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PluginNameSlice)
+	out := new(RolloutStatus)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -453,7 +1205,7 @@ func (in *SriovIBNetwork) DeepCopyInto(out *SriovIBNetwork) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovIBNetwork.
@@ -524,6 +1276,7 @@ func (in *SriovIBNetworkSpec) DeepCopy() *SriovIBNetworkSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SriovIBNetworkStatus) DeepCopyInto(out *SriovIBNetworkStatus) {
 	*out = *in
+	in.NetworkStatus.DeepCopyInto(&out.NetworkStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovIBNetworkStatus.
@@ -542,7 +1295,7 @@ func (in *SriovNetwork) DeepCopyInto(out *SriovNetwork) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetwork.
@@ -608,6 +1361,31 @@ func (in *SriovNetworkNicSelector) DeepCopyInto(out *SriovNetworkNicSelector) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NumaNodes != nil {
+		in, out := &in.NumaNodes, &out.NumaNodes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.FirmwareVersions != nil {
+		in, out := &in.FirmwareVersions, &out.FirmwareVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PhysicalSlots != nil {
+		in, out := &in.PhysicalSlots, &out.PhysicalSlots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PciLabels != nil {
+		in, out := &in.PciLabels, &out.PciLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkNicSelector.
@@ -620,13 +1398,119 @@ func (in *SriovNetworkNicSelector) DeepCopy() *SriovNetworkNicSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SriovNetworkNodeOverride) DeepCopyInto(out *SriovNetworkNodeOverride) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkNodeOverride.
+func (in *SriovNetworkNodeOverride) DeepCopy() *SriovNetworkNodeOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(SriovNetworkNodeOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SriovNetworkNodeOverride) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SriovNetworkNodeOverrideList) DeepCopyInto(out *SriovNetworkNodeOverrideList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SriovNetworkNodeOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkNodeOverrideList.
+func (in *SriovNetworkNodeOverrideList) DeepCopy() *SriovNetworkNodeOverrideList {
+	if in == nil {
+		return nil
+	}
+	out := new(SriovNetworkNodeOverrideList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SriovNetworkNodeOverrideList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SriovNetworkNodeOverrideSpec) DeepCopyInto(out *SriovNetworkNodeOverrideSpec) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]InterfaceOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkNodeOverrideSpec.
+func (in *SriovNetworkNodeOverrideSpec) DeepCopy() *SriovNetworkNodeOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SriovNetworkNodeOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SriovNetworkNodeOverrideStatus) DeepCopyInto(out *SriovNetworkNodeOverrideStatus) {
+	*out = *in
+	if in.AppliedInterfaces != nil {
+		in, out := &in.AppliedInterfaces, &out.AppliedInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnmatchedInterfaces != nil {
+		in, out := &in.UnmatchedInterfaces, &out.UnmatchedInterfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkNodeOverrideStatus.
+func (in *SriovNetworkNodeOverrideStatus) DeepCopy() *SriovNetworkNodeOverrideStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SriovNetworkNodeOverrideStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SriovNetworkNodePolicy) DeepCopyInto(out *SriovNetworkNodePolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkNodePolicy.
@@ -689,8 +1573,38 @@ func (in *SriovNetworkNodePolicySpec) DeepCopyInto(out *SriovNetworkNodePolicySp
 			(*out)[key] = val
 		}
 	}
+	if in.NodeAffinity != nil {
+		in, out := &in.NodeAffinity, &out.NodeAffinity
+		*out = new(corev1.NodeSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	in.NicSelector.DeepCopyInto(&out.NicSelector)
 	in.Bridge.DeepCopyInto(&out.Bridge)
+	if in.VfAttributes != nil {
+		in, out := &in.VfAttributes, &out.VfAttributes
+		*out = new(VfAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Intel != nil {
+		in, out := &in.Intel, &out.Intel
+		*out = new(IntelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mellanox != nil {
+		in, out := &in.Mellanox, &out.Mellanox
+		*out = new(MellanoxConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DevlinkParams != nil {
+		in, out := &in.DevlinkParams, &out.DevlinkParams
+		*out = make([]DevlinkParam, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ethtool != nil {
+		in, out := &in.Ethtool, &out.Ethtool
+		*out = new(EthtoolConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkNodePolicySpec.
@@ -706,6 +1620,32 @@ func (in *SriovNetworkNodePolicySpec) DeepCopy() *SriovNetworkNodePolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SriovNetworkNodePolicyStatus) DeepCopyInto(out *SriovNetworkNodePolicyStatus) {
 	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]PolicyNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailedNodes != nil {
+		in, out := &in.FailedNodes, &out.FailedNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]PolicyConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkNodePolicyStatus.
@@ -813,6 +1753,28 @@ func (in *SriovNetworkNodeStateStatus) DeepCopyInto(out *SriovNetworkNodeStateSt
 	}
 	in.Bridges.DeepCopyInto(&out.Bridges)
 	out.System = in.System
+	if in.InterfaceSyncResults != nil {
+		in, out := &in.InterfaceSyncResults, &out.InterfaceSyncResults
+		*out = make([]InterfaceSyncResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(NodeStatePlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(NodeStateRollback)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkNodeStateStatus.
@@ -831,7 +1793,7 @@ func (in *SriovNetworkPoolConfig) DeepCopyInto(out *SriovNetworkPoolConfig) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkPoolConfig.
@@ -898,6 +1860,16 @@ func (in *SriovNetworkPoolConfigSpec) DeepCopyInto(out *SriovNetworkPoolConfigSp
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutConfig)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkPoolConfigSpec.
@@ -913,6 +1885,18 @@ func (in *SriovNetworkPoolConfigSpec) DeepCopy() *SriovNetworkPoolConfigSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SriovNetworkPoolConfigStatus) DeepCopyInto(out *SriovNetworkPoolConfigStatus) {
 	*out = *in
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkPoolConfigStatus.
@@ -953,6 +1937,7 @@ func (in *SriovNetworkSpec) DeepCopy() *SriovNetworkSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SriovNetworkStatus) DeepCopyInto(out *SriovNetworkStatus) {
 	*out = *in
+	in.NetworkStatus.DeepCopyInto(&out.NetworkStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SriovNetworkStatus.
@@ -1125,9 +2110,64 @@ func (in *TrunkConfig) DeepCopy() *TrunkConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VfAttributes) DeepCopyInto(out *VfAttributes) {
+	*out = *in
+	if in.Vlan != nil {
+		in, out := &in.Vlan, &out.Vlan
+		*out = new(int)
+		**out = **in
+	}
+	if in.VlanQoS != nil {
+		in, out := &in.VlanQoS, &out.VlanQoS
+		*out = new(int)
+		**out = **in
+	}
+	if in.SpoofChk != nil {
+		in, out := &in.SpoofChk, &out.SpoofChk
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Trust != nil {
+		in, out := &in.Trust, &out.Trust
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinTxRate != nil {
+		in, out := &in.MinTxRate, &out.MinTxRate
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxTxRate != nil {
+		in, out := &in.MaxTxRate, &out.MaxTxRate
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VfAttributes.
+func (in *VfAttributes) DeepCopy() *VfAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(VfAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VfGroup) DeepCopyInto(out *VfGroup) {
 	*out = *in
+	if in.VfAttributes != nil {
+		in, out := &in.VfAttributes, &out.VfAttributes
+		*out = new(VfAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ethtool != nil {
+		in, out := &in.Ethtool, &out.Ethtool
+		*out = new(EthtoolSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VfGroup.
@@ -1143,6 +2183,16 @@ func (in *VfGroup) DeepCopy() *VfGroup {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualFunction) DeepCopyInto(out *VirtualFunction) {
 	*out = *in
+	if in.SpoofChk != nil {
+		in, out := &in.SpoofChk, &out.SpoofChk
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Trust != nil {
+		in, out := &in.Trust, &out.Trust
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualFunction.
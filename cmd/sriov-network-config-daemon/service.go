@@ -239,7 +239,7 @@ func (s *ServiceConfig) callPlugin(phase string) error {
 	}
 
 	if err = configPlugin.Apply(); err != nil {
-		return fmt.Errorf("failed to apply configuration: %v", err)
+		return fmt.Errorf("failed to apply configuration: %w", err)
 	}
 	s.log.V(0).Info("plugin call succeed")
 	return nil
@@ -277,7 +277,7 @@ func (s *ServiceConfig) getNetworkNodeState(phase string) (*sriovv1.SriovNetwork
 
 func (s *ServiceConfig) updateSriovResultErr(phase string, origErr error) error {
 	s.log.Error(origErr, "service call failed")
-	err := s.updateResult(consts.SyncStatusFailed, fmt.Sprintf("%s: %v", phase, origErr))
+	err := s.updateResult(consts.SyncStatusFailed, fmt.Sprintf("%s: %v", phase, origErr), hosttypes.InterfaceSyncFailures(origErr))
 	if err != nil {
 		return err
 	}
@@ -290,13 +290,14 @@ func (s *ServiceConfig) updateSriovResultOk(phase string) error {
 	if phase == consts.PhasePre {
 		syncStatus = consts.SyncStatusInProgress
 	}
-	return s.updateResult(syncStatus, "")
+	return s.updateResult(syncStatus, "", nil)
 }
 
-func (s *ServiceConfig) updateResult(result, msg string) error {
+func (s *ServiceConfig) updateResult(result, msg string, failedInterfaces []sriovv1.InterfaceSyncResult) error {
 	sriovResult := &hosttypes.SriovResult{
-		SyncStatus:    result,
-		LastSyncError: msg,
+		SyncStatus:       result,
+		LastSyncError:    msg,
+		FailedInterfaces: failedInterfaces,
 	}
 	err := s.hostHelper.WriteSriovResult(sriovResult)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	ocpconfigapi "github.com/openshift/api/config/v1"
 	"github.com/spf13/cobra"
@@ -37,6 +38,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
@@ -88,6 +90,10 @@ var (
 		parallelNicConfig     bool
 		manageSoftwareBridges bool
 		ovsSocketPath         string
+		metricsBindAddress    string
+		metricsCertDir        string
+		vendorPluginsDir      string
+		vendorPluginsTimeout  time.Duration
 	}
 
 	scheme = runtime.NewScheme()
@@ -102,6 +108,10 @@ func init() {
 	startCmd.PersistentFlags().BoolVar(&startOpts.parallelNicConfig, "parallel-nic-config", false, "perform NIC configuration in parallel")
 	startCmd.PersistentFlags().BoolVar(&startOpts.manageSoftwareBridges, "manage-software-bridges", false, "enable management of software bridges")
 	startCmd.PersistentFlags().StringVar(&startOpts.ovsSocketPath, "ovs-socket-path", vars.OVSDBSocketPath, "path for OVSDB socket")
+	startCmd.PersistentFlags().StringVar(&startOpts.metricsBindAddress, "metrics-bind-address", "0", "the address the metrics endpoint binds to, \"0\" disables the metrics server")
+	startCmd.PersistentFlags().StringVar(&startOpts.metricsCertDir, "metrics-cert-dir", "", "the directory with the tls.crt and tls.key files of the metrics server, a self-signed certificate is used when empty")
+	startCmd.PersistentFlags().StringVar(&startOpts.vendorPluginsDir, "vendor-plugins-dir", vars.VendorPluginsDir, fmt.Sprintf("directory of the sockets of the external vendor plugins, e.g. %s, the external plugins are not loaded when empty", consts.Host+consts.VendorPluginsHostDir))
+	startCmd.PersistentFlags().DurationVar(&startOpts.vendorPluginsTimeout, "vendor-plugins-timeout", vars.VendorPluginsTimeout, "timeout of the calls to the external vendor plugins")
 
 	// Init Scheme
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
@@ -122,6 +132,8 @@ func configGlobalVariables() error {
 	vars.ParallelNicConfig = startOpts.parallelNicConfig
 	vars.ManageSoftwareBridges = startOpts.manageSoftwareBridges
 	vars.OVSDBSocketPath = startOpts.ovsSocketPath
+	vars.VendorPluginsDir = startOpts.vendorPluginsDir
+	vars.VendorPluginsTimeout = startOpts.vendorPluginsTimeout
 
 	if startOpts.nodeName == "" {
 		name, ok := os.LookupEnv("NODE_NAME")
@@ -292,6 +304,9 @@ func runStartCmd(cmd *cobra.Command, args []string) error {
 	// init disable drain
 	vars.DisableDrain = operatorConfig.Spec.DisableDrain
 
+	// init auto rollback
+	vars.AutoRollbackAfterFailures = operatorConfig.Spec.AutoRollbackAfterFailures
+
 	// Init manager
 	setupLog.V(0).Info("Starting SR-IOV Network Config Daemon")
 	nodeStateSelector, err := fields.ParseSelector(fmt.Sprintf("metadata.name=%s,metadata.namespace=%s", vars.NodeName, vars.Namespace))
@@ -306,8 +321,15 @@ func runStartCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	mgr, err := ctrl.NewManager(vars.Config, ctrl.Options{
-		Scheme:  vars.Scheme,
-		Metrics: server.Options{BindAddress: "0"}, // disable metrics server for now as the daemon runs with hostNetwork
+		Scheme: vars.Scheme,
+		// the metrics are served over https and only to clients authorized to get the /metrics endpoint,
+		// with a self-signed certificate when no certificate directory is provided
+		Metrics: server.Options{
+			BindAddress:    startOpts.metricsBindAddress,
+			SecureServing:  true,
+			FilterProvider: filters.WithAuthenticationAndAuthorization,
+			CertDir:        startOpts.metricsCertDir,
+		},
 		Cache: cache.Options{ // cache only the SriovNetworkNodeState with the node name
 			ByObject: map[runtimeclient.Object]cache.ByObject{
 				&sriovnetworkv1.SriovNetworkNodeState{}: {Field: nodeStateSelector},
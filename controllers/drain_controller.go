@@ -24,6 +24,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -146,6 +147,11 @@ func (dr *DrainReconcile) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		// we don't do anything
 		if nodeStateDrainAnnotationCurrent == constants.DrainIdle {
 			reqLogger.Info("node and nodeState are on idle nothing todo")
+			// the drain request was cleared before the node started to drain
+			if meta.IsStatusConditionTrue(nodeNetworkState.Status.Conditions, constants.ConditionDrain) {
+				dr.reportDrainState(ctx, node, nodeNetworkState, corev1.EventTypeNormal,
+					constants.ConditionReasonDrainIdle, "drain request cleared by the config daemon")
+			}
 			return reconcile.Result{}, nil
 		}
 
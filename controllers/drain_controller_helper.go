@@ -19,6 +19,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -42,10 +43,8 @@ func (dr *DrainReconcile) handleNodeIdleNodeStateDrainingOrCompleted(ctx context
 	completed, err := dr.drainer.CompleteDrainNode(ctx, node)
 	if err != nil {
 		reqLogger.Error(err, "failed to complete drain on node")
-		dr.recorder.Event(nodeNetworkState,
-			corev1.EventTypeWarning,
-			"DrainController",
-			"failed to drain node")
+		dr.reportDrainState(ctx, node, nodeNetworkState, corev1.EventTypeWarning,
+			constants.ConditionReasonDrainFailed, fmt.Sprintf("failed to complete the drain of the node: %v", err))
 		return ctrl.Result{}, err
 	}
 
@@ -68,10 +67,8 @@ func (dr *DrainReconcile) handleNodeIdleNodeStateDrainingOrCompleted(ctx context
 	}
 
 	reqLogger.Info("completed the un drain for node")
-	dr.recorder.Event(nodeNetworkState,
-		corev1.EventTypeWarning,
-		"DrainController",
-		"node un drain completed")
+	dr.reportDrainState(ctx, node, nodeNetworkState, corev1.EventTypeNormal,
+		constants.ConditionReasonDrainIdle, "node un drain completed")
 	return ctrl.Result{}, nil
 }
 
@@ -89,7 +86,7 @@ func (dr *DrainReconcile) handleNodeDrainOrReboot(ctx context.Context,
 
 	// we need to start the drain, but first we need to check that we can drain the node
 	if nodeStateDrainAnnotationCurrent == constants.DrainIdle {
-		result, err := dr.tryDrainNode(ctx, node)
+		result, err := dr.tryDrainNode(ctx, node, nodeNetworkState)
 		if err != nil {
 			reqLogger.Error(err, "failed to check if we can drain the node")
 			return ctrl.Result{}, err
@@ -99,6 +96,8 @@ func (dr *DrainReconcile) handleNodeDrainOrReboot(ctx context.Context,
 		if result != nil {
 			return *result, nil
 		}
+		dr.reportDrainState(ctx, node, nodeNetworkState, corev1.EventTypeNormal,
+			constants.ConditionReasonDraining, fmt.Sprintf("node drain started, the config daemon requested %s", nodeDrainAnnotation))
 	}
 
 	// Check if we are on a single node, and we require a reboot/full-drain we just return
@@ -121,10 +120,8 @@ func (dr *DrainReconcile) handleNodeDrainOrReboot(ctx context.Context,
 	drained, err := dr.drainer.DrainNode(ctx, node, fullNodeDrain, singleNode)
 	if err != nil {
 		reqLogger.Error(err, "error trying to drain the node")
-		dr.recorder.Event(nodeNetworkState,
-			corev1.EventTypeWarning,
-			"DrainController",
-			"failed to drain node")
+		dr.reportDrainState(ctx, node, nodeNetworkState, corev1.EventTypeWarning,
+			constants.ConditionReasonDrainFailed, fmt.Sprintf("failed to drain the node: %v", err))
 		return reconcile.Result{}, err
 	}
 
@@ -146,14 +143,31 @@ func (dr *DrainReconcile) handleNodeDrainOrReboot(ctx context.Context,
 	}
 
 	reqLogger.Info("node drained successfully")
-	dr.recorder.Event(nodeNetworkState,
-		corev1.EventTypeWarning,
-		"DrainController",
-		"node drain completed")
+	dr.reportDrainState(ctx, node, nodeNetworkState, corev1.EventTypeNormal,
+		constants.ConditionReasonDrainComplete, "node drain completed")
 	return ctrl.Result{}, nil
 }
 
-func (dr *DrainReconcile) tryDrainNode(ctx context.Context, node *corev1.Node) (*reconcile.Result, error) {
+// reportDrainState mirrors the drain state in the Drain condition of the nodeState and emits an event
+// on both the node and the nodeState when the state changed
+func (dr *DrainReconcile) reportDrainState(ctx context.Context,
+	node *corev1.Node,
+	nodeNetworkState *sriovnetworkv1.SriovNetworkNodeState,
+	eventType, reason, message string) {
+	reqLogger := ctx.Value(constants.LoggerContextKey).(logr.Logger).WithName("reportDrainState")
+	changed, err := utils.SetNodeStateDrainCondition(ctx, nodeNetworkState, reason, message, dr.Client)
+	if err != nil {
+		reqLogger.Error(err, "failed to update the drain condition", "reason", reason)
+		return
+	}
+	if !changed {
+		return
+	}
+	dr.recorder.Event(node, eventType, reason, message)
+	dr.recorder.Event(nodeNetworkState, eventType, reason, message)
+}
+
+func (dr *DrainReconcile) tryDrainNode(ctx context.Context, node *corev1.Node, nodeNetworkState *sriovnetworkv1.SriovNetworkNodeState) (*reconcile.Result, error) {
 	reqLogger := ctx.Value(constants.LoggerContextKey).(logr.Logger).WithName("tryDrainNode")
 
 	//critical section we need to check if we can start the draining
@@ -166,6 +180,31 @@ func (dr *DrainReconcile) tryDrainNode(ctx context.Context, node *corev1.Node) (
 		reqLogger.Error(err, "failed to find the pool for the requested node")
 		return nil, err
 	}
+	poolName := nodePool.Name
+	if poolName == "" {
+		poolName = "default"
+	}
+
+	// check if the pool allows disruptive work right now
+	inWindow, nextWindow, err := nodePool.InMaintenanceWindow(time.Now())
+	if err != nil {
+		reqLogger.Error(err, "failed to check the maintenance windows", "pool", nodePool.Name)
+		return nil, err
+	}
+	if !inWindow {
+		requeueAfter := constants.ResyncPeriod
+		if !nextWindow.IsZero() && time.Until(nextWindow) < requeueAfter {
+			requeueAfter = time.Until(nextWindow)
+		}
+		reqLogger.Info("outside of the pool maintenance windows re-enqueue the request", "pool", nodePool.Name, "nextWindow", nextWindow)
+		message := fmt.Sprintf("waiting for a maintenance window of pool %s", poolName)
+		if !nextWindow.IsZero() {
+			message = fmt.Sprintf("%s, next window opens at %s", message, nextWindow.UTC().Format(time.RFC3339))
+		}
+		dr.reportDrainState(ctx, node, nodeNetworkState, corev1.EventTypeNormal,
+			constants.ConditionReasonWaitingForMaintenanceWindow, message)
+		return &reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
 
 	// check how many nodes we can drain in parallel for the specific pool
 	maxUnv, err := nodePool.MaxUnavailable(len(nodeList))
@@ -206,6 +245,9 @@ func (dr *DrainReconcile) tryDrainNode(ctx context.Context, node *corev1.Node) (
 	} else if current >= maxUnv {
 		// the node requested to be drained, but we are at the limit so we re-enqueue the request
 		reqLogger.Info("MaxParallelNodeConfiguration limit reached for draining nodes re-enqueue the request")
+		dr.reportDrainState(ctx, node, nodeNetworkState, corev1.EventTypeNormal,
+			constants.ConditionReasonWaitingForMaxUnavailable,
+			fmt.Sprintf("waiting for a drain slot in pool %s, %d nodes are draining out of %d allowed", poolName, current, maxUnv))
 		// TODO: make this time configurable
 		return &reconcile.Result{RequeueAfter: constants.DrainControllerRequeueTime}, nil
 	}
@@ -26,6 +26,7 @@ import (
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -221,6 +222,26 @@ var _ = Describe("Drain Controller", Ordered, func() {
 			expectNodeIsSchedulable(node3)
 		})
 
+		It("should mirror the drain transitions in the nodeState drain condition", func(ctx context.Context) {
+			node1, nodeState1 := createNode(ctx, "node1", nil)
+			node2, nodeState2 := createNode(ctx, "node2", nil)
+
+			simulateDaemonSetAnnotation(node1, constants.DrainRequired)
+			expectNodeStateAnnotation(nodeState1, constants.DrainComplete)
+			expectNodeStateDrainCondition(nodeState1, metav1.ConditionTrue, constants.ConditionReasonDrainComplete)
+
+			// the default pool allows a single draining node
+			simulateDaemonSetAnnotation(node2, constants.DrainRequired)
+			expectNodeStateDrainCondition(nodeState2, metav1.ConditionTrue, constants.ConditionReasonWaitingForMaxUnavailable)
+
+			simulateDaemonSetAnnotation(node1, constants.DrainIdle)
+			expectNodeStateDrainCondition(nodeState1, metav1.ConditionFalse, constants.ConditionReasonDrainIdle)
+			expectNodeStateDrainCondition(nodeState2, metav1.ConditionTrue, constants.ConditionReasonDrainComplete)
+
+			simulateDaemonSetAnnotation(node2, constants.DrainIdle)
+			expectNodeStateDrainCondition(nodeState2, metav1.ConditionFalse, constants.ConditionReasonDrainIdle)
+		})
+
 		It("should drain nodes in parallel with a custom pool selector", func(ctx context.Context) {
 			node1, nodeState1 := createNode(ctx, "node1", nil)
 			node2, nodeState2 := createNode(ctx, "node2", nil)
@@ -380,6 +401,18 @@ func expectNodeStateAnnotation(nodeState *sriovnetworkv1.SriovNetworkNodeState,
 	}, "20s", "1s").Should(Succeed())
 }
 
+func expectNodeStateDrainCondition(nodeState *sriovnetworkv1.SriovNetworkNodeState, status metav1.ConditionStatus, reason string) {
+	EventuallyWithOffset(1, func(g Gomega) {
+		g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Namespace: nodeState.Namespace, Name: nodeState.Name}, nodeState)).
+			ToNot(HaveOccurred())
+
+		condition := meta.FindStatusCondition(nodeState.Status.Conditions, constants.ConditionDrain)
+		g.Expect(condition).ToNot(BeNil())
+		g.Expect(condition.Status).To(Equal(status))
+		g.Expect(condition.Reason).To(Equal(reason))
+	}, "20s", "1s").Should(Succeed())
+}
+
 func expectNumberOfDrainingNodes(numbOfDrain int, nodesState ...*sriovnetworkv1.SriovNetworkNodeState) {
 	EventuallyWithOffset(1, func(g Gomega) {
 		drainingNodes := 0
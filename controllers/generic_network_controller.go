@@ -18,12 +18,14 @@ package controllers
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 
 	netattdefv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	uns "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -49,6 +51,8 @@ type NetworkCRInstance interface {
 	RenderNetAttDef() (*uns.Unstructured, error)
 	// return name of the target namespace for the network
 	NetworkNamespace() string
+	// return status of the NetworkAttachmentDefinition rendered for the network
+	GetNetworkStatus() *sriovnetworkv1.NetworkStatus
 }
 
 // interface which controller should implement to be compatible with genericNetworkReconciler
@@ -109,7 +113,12 @@ func (r *genericNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			".metadata.namespace", instance.GetNamespace(),
 			".spec.networkNamespace", instance.NetworkNamespace(),
 		)
-		return reconcile.Result{}, nil
+		err = r.updateNetworkStatus(ctx, instance, &netAttDefResult{
+			reason:   consts.ConditionReasonInvalidNetworkNamespace,
+			message:  ".spec.networkNamespace can't be specified if the resource belongs to a namespace other than the operator's",
+			degraded: true,
+		})
+		return reconcile.Result{}, err
 	}
 
 	// examine DeletionTimestamp to determine if object is under deletion
@@ -126,21 +135,46 @@ func (r *genericNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		err = r.cleanResourcesAndFinalizers(ctx, instance)
 		return reconcile.Result{}, err
 	}
+	result, err := r.syncNetAttDef(ctx, instance)
+	if statusErr := r.updateNetworkStatus(ctx, instance, result); statusErr != nil {
+		reqLogger.Error(statusErr, "Couldn't update status")
+		if err == nil {
+			err = statusErr
+		}
+	}
+	return reconcile.Result{}, err
+}
+
+// netAttDefResult is the outcome of the NetworkAttachmentDefinition sync for the network object
+type netAttDefResult struct {
+	// rendered NetworkAttachmentDefinition, nil if rendering failed
+	netAttDef *netattdefv1.NetworkAttachmentDefinition
+	// reason and message explaining why the NetworkAttachmentDefinition is not ready, the reason is empty if it is ready
+	reason  string
+	message string
+	// true if the NetworkAttachmentDefinition can't be applied without a change from the user
+	degraded bool
+}
+
+// syncNetAttDef renders the NetworkAttachmentDefinition for the network object and creates or updates it
+func (r *genericNetworkReconciler) syncNetAttDef(ctx context.Context, instance NetworkCRInstance) (*netAttDefResult, error) {
+	reqLogger := log.FromContext(ctx).WithValues(r.controller.Name(), client.ObjectKeyFromObject(instance))
 	raw, err := instance.RenderNetAttDef()
 	if err != nil {
-		return reconcile.Result{}, err
+		return renderFailed(err), err
 	}
 	netAttDef := &netattdefv1.NetworkAttachmentDefinition{}
 	err = r.Scheme.Convert(raw, netAttDef, nil)
 	if err != nil {
-		return reconcile.Result{}, err
+		return renderFailed(err), err
 	}
 	// format CNI config json in CR for easier readability
 	netAttDef.Spec.Config, err = formatJSON(netAttDef.Spec.Config)
 	if err != nil {
 		reqLogger.Error(err, "Couldn't process rendered NetworkAttachmentDefinition config", "Namespace", netAttDef.Namespace, "Name", netAttDef.Name)
-		return reconcile.Result{}, err
+		return renderFailed(err), err
 	}
+	result := &netAttDefResult{netAttDef: netAttDef}
 	if lnns, ok := instance.GetAnnotations()[sriovnetworkv1.LASTNETWORKNAMESPACE]; ok && netAttDef.GetNamespace() != lnns {
 		err = r.Delete(ctx, &netattdefv1.NetworkAttachmentDefinition{
 			ObjectMeta: metav1.ObjectMeta{
@@ -150,14 +184,14 @@ func (r *genericNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		})
 		if err != nil {
 			reqLogger.Error(err, "Couldn't delete NetworkAttachmentDefinition CR", "Namespace", instance.GetName(), "Name", lnns)
-			return reconcile.Result{}, err
+			return result.applyFailed(err), err
 		}
 	}
 
 	if instance.GetNamespace() == netAttDef.Namespace {
 		// If the NetAttachDef is in the same namespace of the resource, then we can leverage the OwnerReference field for garbage collector
 		if err := controllerutil.SetOwnerReference(instance, netAttDef, r.Scheme); err != nil {
-			return reconcile.Result{}, err
+			return result.applyFailed(err), err
 		}
 	}
 
@@ -170,23 +204,25 @@ func (r *genericNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			err = r.Get(ctx, types.NamespacedName{Name: netAttDef.Namespace}, targetNamespace)
 			if errors.IsNotFound(err) {
 				reqLogger.Info("Target namespace doesn't exist, NetworkAttachmentDefinition will be created when namespace is available", "Namespace", netAttDef.Namespace, "Name", netAttDef.Name)
-				return reconcile.Result{}, nil
+				result.reason = consts.ConditionReasonNamespaceNotFound
+				result.message = fmt.Sprintf("target namespace %s doesn't exist, NetworkAttachmentDefinition will be created when the namespace is available", netAttDef.Namespace)
+				return result, nil
 			}
 
 			reqLogger.Info("NetworkAttachmentDefinition CR not exist, creating")
 			err = r.Create(ctx, netAttDef)
 			if err != nil {
 				reqLogger.Error(err, "Couldn't create NetworkAttachmentDefinition CR", "Namespace", netAttDef.Namespace, "Name", netAttDef.Name)
-				return reconcile.Result{}, err
+				return result.applyFailed(err), err
 			}
 
 			err = utils.AnnotateObject(ctx, instance, sriovnetworkv1.LASTNETWORKNAMESPACE, netAttDef.Namespace, r.Client)
 			if err != nil {
-				return reconcile.Result{}, err
+				return result.applyFailed(err), err
 			}
 		} else {
 			reqLogger.Error(err, "Couldn't get NetworkAttachmentDefinition CR", "Namespace", netAttDef.Namespace, "Name", netAttDef.Name)
-			return reconcile.Result{}, err
+			return result.applyFailed(err), err
 		}
 	} else {
 		reqLogger.Info("NetworkAttachmentDefinition CR already exist")
@@ -201,7 +237,9 @@ func (r *genericNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 				"Namespace", netAttDef.Namespace, "Name", netAttDef.Name,
 				"CurrentOwner", foundOwner, "ExpectedOwner", expectedOwner,
 			)
-			return reconcile.Result{}, nil
+			result.reason, result.degraded = consts.ConditionReasonNetAttDefConflict, true
+			result.message = fmt.Sprintf("NetworkAttachmentDefinition %s/%s already exists and belongs to %s", netAttDef.Namespace, netAttDef.Name, foundOwner)
+			return result, nil
 		}
 
 		if !equality.Semantic.DeepEqual(found.Spec, netAttDef.Spec) || !equality.Semantic.DeepEqual(found.GetAnnotations(), netAttDef.GetAnnotations()) {
@@ -211,12 +249,66 @@ func (r *genericNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			err = r.Update(ctx, netAttDef)
 			if err != nil {
 				reqLogger.Error(err, "Couldn't update NetworkAttachmentDefinition CR", "Namespace", netAttDef.Namespace, "Name", netAttDef.Name)
-				return reconcile.Result{}, err
+				return result.applyFailed(err), err
 			}
 		}
 	}
 
-	return ctrl.Result{}, nil
+	return result, nil
+}
+
+// renderFailed returns the result of the sync when the NetworkAttachmentDefinition can't be rendered
+func renderFailed(err error) *netAttDefResult {
+	return &netAttDefResult{reason: consts.ConditionReasonNetAttDefRenderFailed, message: err.Error(), degraded: true}
+}
+
+// applyFailed marks the result of the sync as failed to create, update or delete the NetworkAttachmentDefinition
+func (res *netAttDefResult) applyFailed(err error) *netAttDefResult {
+	res.reason, res.message, res.degraded = consts.ConditionReasonNetAttDefApplyFailed, err.Error(), true
+	return res
+}
+
+// updateNetworkStatus reports the result of the NetworkAttachmentDefinition sync in the status of the network object.
+// Conditions are preserved if they didn't change to keep the transition time.
+func (r *genericNetworkReconciler) updateNetworkStatus(ctx context.Context, instance NetworkCRInstance, result *netAttDefResult) error {
+	status := instance.GetNetworkStatus()
+	newStatus := status.DeepCopy()
+	newStatus.ObservedGeneration = instance.GetGeneration()
+	newStatus.NetworkAttachmentDefinition = nil
+	newStatus.ConfigHash = ""
+	if result.netAttDef != nil {
+		newStatus.NetworkAttachmentDefinition = &sriovnetworkv1.NetworkAttachmentDefinitionRef{
+			Namespace: result.netAttDef.Namespace,
+			Name:      result.netAttDef.Name,
+		}
+		newStatus.ConfigHash = fmt.Sprintf("%x", sha256.Sum256([]byte(result.netAttDef.Spec.Config)))
+	}
+
+	ready := metav1.Condition{Type: consts.ConditionReady, ObservedGeneration: instance.GetGeneration()}
+	degraded := metav1.Condition{Type: consts.ConditionDegraded, ObservedGeneration: instance.GetGeneration()}
+	switch {
+	case result.reason == "":
+		newStatus.LastError = ""
+		ready.Status, ready.Reason = metav1.ConditionTrue, consts.ConditionReasonNetAttDefReady
+		ready.Message = fmt.Sprintf("NetworkAttachmentDefinition %s/%s is up to date", result.netAttDef.Namespace, result.netAttDef.Name)
+		degraded.Status, degraded.Reason = metav1.ConditionFalse, consts.ConditionReasonNetAttDefReady
+	case result.degraded:
+		newStatus.LastError = result.message
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, result.reason, result.message
+		degraded.Status, degraded.Reason, degraded.Message = metav1.ConditionTrue, result.reason, result.message
+	default:
+		newStatus.LastError = ""
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, result.reason, result.message
+		degraded.Status, degraded.Reason = metav1.ConditionFalse, result.reason
+	}
+	meta.SetStatusCondition(&newStatus.Conditions, ready)
+	meta.SetStatusCondition(&newStatus.Conditions, degraded)
+
+	if equality.Semantic.DeepEqual(newStatus, status) {
+		return nil
+	}
+	*status = *newStatus
+	return r.Status().Update(ctx, instance)
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	netattdefv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -145,6 +146,15 @@ var _ = Describe("All Network Controllers", Ordered, func() {
 					g.Expect(netAttDef.Spec.Config).To(ContainSubstring(`"sriov"`))
 					g.Expect(netAttDef.Spec.Config).ToNot(ContainSubstring(`"ib-sriov"`))
 				}).WithPolling(30 * time.Millisecond).WithTimeout(300 * time.Millisecond).Should(Succeed())
+
+				Eventually(func(g Gomega) {
+					g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&cr2), &cr2)).To(Succeed())
+					degraded := meta.FindStatusCondition(cr2.Status.Conditions, consts.ConditionDegraded)
+					g.Expect(degraded).NotTo(BeNil())
+					g.Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+					g.Expect(degraded.Reason).To(Equal(consts.ConditionReasonNetAttDefConflict))
+					g.Expect(cr2.Status.LastError).To(ContainSubstring("already exists and belongs to"))
+				}, util.APITimeout, util.RetryInterval).Should(Succeed())
 			})
 
 			It("when using the same network type with the same name, in different namespaces", func() {
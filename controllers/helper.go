@@ -338,54 +338,18 @@ func findNodePoolConfig(ctx context.Context, node *corev1.Node, c k8sclient.Clie
 		return nil, nil, err
 	}
 
-	selectedNpcl := []*sriovnetworkv1.SriovNetworkPoolConfig{}
-	nodesInPools := map[string]interface{}{}
-
-	for _, npc := range npcl.Items {
-		// we skip hw offload objects
-		if npc.Spec.OvsHardwareOffloadConfig.Name != "" {
-			continue
-		}
-
-		if npc.Spec.NodeSelector == nil {
-			npc.Spec.NodeSelector = &metav1.LabelSelector{}
-		}
-
-		selector, err := metav1.LabelSelectorAsSelector(npc.Spec.NodeSelector)
-		if err != nil {
-			logger.Error(err, "failed to create label selector from nodeSelector", "nodeSelector", npc.Spec.NodeSelector)
-			return nil, nil, err
-		}
-
-		if selector.Matches(labels.Set(node.Labels)) {
-			selectedNpcl = append(selectedNpcl, npc.DeepCopy())
-		}
-
-		nodeList := &corev1.NodeList{}
-		err = c.List(ctx, nodeList, &k8sclient.ListOptions{LabelSelector: selector})
-		if err != nil {
-			logger.Error(err, "failed to list all the nodes matching the pool with label selector from nodeSelector",
-				"machineConfigPoolName", npc,
-				"nodeSelector", npc.Spec.NodeSelector)
-			return nil, nil, err
-		}
-
-		for _, nodeName := range nodeList.Items {
-			nodesInPools[nodeName.Name] = nil
-		}
-	}
-
-	if len(selectedNpcl) > 1 {
+	selected, err := sriovnetworkv1.FindNodePoolConfig(node, npcl.Items)
+	if err != nil {
 		// don't allow the node to be part of multiple pools
-		err = fmt.Errorf("node is part of more then one pool")
-		logger.Error(err, "multiple pools founded for a specific node", "numberOfPools", len(selectedNpcl), "pools", selectedNpcl)
+		logger.Error(err, "failed to find the pool of the node")
 		return nil, nil, err
-	} else if len(selectedNpcl) == 1 {
+	}
+	if selected != nil {
 		// found one pool for our node
-		logger.V(2).Info("found sriovNetworkPool", "pool", *selectedNpcl[0])
-		selector, err := metav1.LabelSelectorAsSelector(selectedNpcl[0].Spec.NodeSelector)
+		logger.V(2).Info("found sriovNetworkPool", "pool", *selected)
+		selector, err := selected.NodeLabelSelector()
 		if err != nil {
-			logger.Error(err, "failed to create label selector from nodeSelector", "nodeSelector", selectedNpcl[0].Spec.NodeSelector)
+			logger.Error(err, "failed to create label selector from nodeSelector", "nodeSelector", selected.Spec.NodeSelector)
 			return nil, nil, err
 		}
 
@@ -397,23 +361,38 @@ func findNodePoolConfig(ctx context.Context, node *corev1.Node, c k8sclient.Clie
 			return nil, nil, err
 		}
 
-		return selectedNpcl[0], nodeList.Items, nil
-	} else {
-		// in this case we get all the nodes and remove the ones that already part of any pool
-		logger.V(1).Info("node doesn't belong to any pool, using default drain configuration with MaxUnavailable of one", "pool", *defaultPoolConfig)
-		nodeList := &corev1.NodeList{}
-		err = c.List(ctx, nodeList)
-		if err != nil {
-			logger.Error(err, "failed to list all the nodes")
-			return nil, nil, err
-		}
+		return selected.DeepCopy(), nodeList.Items, nil
+	}
+
+	// in this case we get all the nodes and remove the ones that already part of any pool
+	logger.V(1).Info("node doesn't belong to any pool, using default drain configuration with MaxUnavailable of one", "pool", *defaultPoolConfig)
+	nodeList := &corev1.NodeList{}
+	err = c.List(ctx, nodeList)
+	if err != nil {
+		logger.Error(err, "failed to list all the nodes")
+		return nil, nil, err
+	}
 
-		defaultNodeLists := []corev1.Node{}
-		for _, nodeObj := range nodeList.Items {
-			if _, exist := nodesInPools[nodeObj.Name]; !exist {
-				defaultNodeLists = append(defaultNodeLists, nodeObj)
+	defaultNodeLists := []corev1.Node{}
+	for _, nodeObj := range nodeList.Items {
+		inPool := false
+		for i := range npcl.Items {
+			if npcl.Items[i].IsOvsHardwareOffloadPool() {
+				continue
 			}
+			selector, err := npcl.Items[i].NodeLabelSelector()
+			if err != nil {
+				logger.Error(err, "failed to create label selector from nodeSelector", "nodeSelector", npcl.Items[i].Spec.NodeSelector)
+				return nil, nil, err
+			}
+			if selector.Matches(labels.Set(nodeObj.Labels)) {
+				inPool = true
+				break
+			}
+		}
+		if !inPool {
+			defaultNodeLists = append(defaultNodeLists, nodeObj)
 		}
-		return defaultPoolConfig, defaultNodeLists, nil
 	}
+	return defaultPoolConfig, defaultNodeLists, nil
 }
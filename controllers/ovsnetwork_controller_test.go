@@ -19,11 +19,11 @@ package controllers
 import (
 	"context"
 	"sync"
-	"time"
 
 	netattdefv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -31,6 +31,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	v1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/test/util"
 )
 
@@ -108,6 +109,17 @@ var _ = Describe("OVSNetwork Controller", Ordered, func() {
 				g.Expect(netAttDef.GetAnnotations()["k8s.v1.cni.cncf.io/resourceName"]).To(ContainSubstring("test"))
 			}, util.APITimeout, util.RetryInterval).Should(Succeed())
 
+			By("Check OVSNetwork status reports the NetworkAttachmentDefinition")
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: netCR.Name, Namespace: netCR.Namespace}, netCR)).NotTo(HaveOccurred())
+				g.Expect(netCR.Status.ObservedGeneration).To(Equal(netCR.Generation))
+				g.Expect(netCR.Status.NetworkAttachmentDefinition).To(Equal(&v1.NetworkAttachmentDefinitionRef{Namespace: testNamespace, Name: "test"}))
+				g.Expect(netCR.Status.ConfigHash).To(HaveLen(64))
+				g.Expect(netCR.Status.LastError).To(BeEmpty())
+				g.Expect(meta.IsStatusConditionTrue(netCR.Status.Conditions, consts.ConditionReady)).To(BeTrue())
+				g.Expect(meta.IsStatusConditionFalse(netCR.Status.Conditions, consts.ConditionDegraded)).To(BeTrue())
+			}, util.APITimeout, util.RetryInterval).Should(Succeed())
+
 			By("Remove OVSNetwork CR")
 			Expect(k8sClient.Delete(ctx, netCR)).NotTo(HaveOccurred())
 
@@ -132,9 +144,15 @@ var _ = Describe("OVSNetwork Controller", Ordered, func() {
 					Namespace: testNamespace}, netAttDef)).NotTo(HaveOccurred())
 			}, util.APITimeout, util.RetryInterval).Should(Succeed())
 
+			var origHash string
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: netCR.Name,
+					Namespace: netCR.Namespace}, netCR)).NotTo(HaveOccurred())
+				g.Expect(netCR.Status.ConfigHash).NotTo(BeEmpty())
+				origHash = netCR.Status.ConfigHash
+			}, util.APITimeout, util.RetryInterval).Should(Succeed())
+
 			By("Update OVSNetwork CR")
-			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: netCR.Name,
-				Namespace: netCR.Namespace}, netCR)).NotTo(HaveOccurred())
 			netCR.Spec.Vlan = 200
 			Expect(k8sClient.Update(ctx, netCR)).NotTo(HaveOccurred())
 
@@ -145,6 +163,14 @@ var _ = Describe("OVSNetwork Controller", Ordered, func() {
 					Namespace: testNamespace}, netAttDef)).NotTo(HaveOccurred())
 				g.Expect(netAttDef.Spec.Config).To(ContainSubstring(`"vlan": 200`))
 			}, util.APITimeout, util.RetryInterval).Should(Succeed())
+
+			By("Check OVSNetwork status reports the hash of the updated config")
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: netCR.Name,
+					Namespace: netCR.Namespace}, netCR)).NotTo(HaveOccurred())
+				g.Expect(netCR.Status.ObservedGeneration).To(Equal(netCR.Generation))
+				g.Expect(netCR.Status.ConfigHash).NotTo(Equal(origHash))
+			}, util.APITimeout, util.RetryInterval).Should(Succeed())
 		})
 		It("re-create net-att-def", func() {
 			netCR := getOvsNetworkCR()
@@ -182,9 +208,15 @@ var _ = Describe("OVSNetwork Controller", Ordered, func() {
 			Expect(k8sClient.Create(ctx, netCR)).NotTo(HaveOccurred())
 			DeferCleanup(func() { removeOVSNetwork(ctx, netCR) })
 
-			// Sleep 3 seconds to be sure the Reconcile loop has been invoked. This can be improved by exposing some information (e.g. the error)
-			// in the SriovNetwork.Status field.
-			time.Sleep(3 * time.Second)
+			By("Check OVSNetwork status reports the missing namespace")
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: netCR.Name, Namespace: netCR.Namespace}, netCR)).NotTo(HaveOccurred())
+				ready := meta.FindStatusCondition(netCR.Status.Conditions, consts.ConditionReady)
+				g.Expect(ready).NotTo(BeNil())
+				g.Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(ready.Reason).To(Equal(consts.ConditionReasonNamespaceNotFound))
+				g.Expect(meta.IsStatusConditionFalse(netCR.Status.Conditions, consts.ConditionDegraded)).To(BeTrue())
+			}, util.APITimeout, util.RetryInterval).Should(Succeed())
 
 			By("Create Namespace")
 			nsObj := &corev1.Namespace{
@@ -200,6 +232,13 @@ var _ = Describe("OVSNetwork Controller", Ordered, func() {
 				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "test", Namespace: newNSName},
 					&netattdefv1.NetworkAttachmentDefinition{})).NotTo(HaveOccurred())
 			}, util.APITimeout, util.RetryInterval).Should(Succeed())
+
+			By("Check OVSNetwork is ready")
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: netCR.Name, Namespace: netCR.Namespace}, netCR)).NotTo(HaveOccurred())
+				g.Expect(meta.IsStatusConditionTrue(netCR.Status.Conditions, consts.ConditionReady)).To(BeTrue())
+				g.Expect(netCR.Status.NetworkAttachmentDefinition).To(Equal(&v1.NetworkAttachmentDefinitionRef{Namespace: newNSName, Name: "test"}))
+			}, util.APITimeout, util.RetryInterval).Should(Succeed())
 		})
 	})
 })
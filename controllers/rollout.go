@@ -0,0 +1,395 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	constants "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+)
+
+// poolRollout is the canary rollout of the policy changes in a SriovNetworkPoolConfig
+type poolRollout struct {
+	pool   *sriovnetworkv1.SriovNetworkPoolConfig
+	status *sriovnetworkv1.RolloutStatus
+}
+
+// poolRollouts holds the rollouts of the pools, indexed by the name of the nodes they manage
+type poolRollouts struct {
+	byNode map[string]*poolRollout
+	pools  []*poolRollout
+}
+
+// canaryIndex returns the index of the node in the canary nodes of the rollout, -1 if the node is not a canary
+func (ro *poolRollout) canaryIndex(nodeName string) int {
+	if ro.status == nil {
+		return -1
+	}
+	for i := range ro.status.CanaryNodes {
+		if ro.status.CanaryNodes[i].Name == nodeName {
+			return i
+		}
+	}
+	return -1
+}
+
+// setPhase moves the rollout to a new phase
+func (ro *poolRollout) setPhase(phase, message string, now time.Time) {
+	log.Log.WithName("poolRollout").Info("rollout phase changed", "pool", ro.pool.Name,
+		"revision", ro.status.Revision, "phase", phase, "message", message)
+	ro.status.Phase = phase
+	ro.status.Message = message
+	ro.status.LastTransitionTime = &metav1.Time{Time: now}
+}
+
+// start begins the rollout of a new revision on the canary nodes. When the revision didn't change
+// it only drops the canary and promoted nodes that are not part of the pool anymore.
+func (ro *poolRollout) start(revision string, nodes []string, now time.Time) {
+	if ro.status != nil && ro.status.Revision == revision {
+		leftPool := func(node sriovnetworkv1.RolloutNode) bool { return !slices.Contains(nodes, node.Name) }
+		ro.status.CanaryNodes = slices.DeleteFunc(ro.status.CanaryNodes, leftPool)
+		ro.status.PromotedNodes = slices.DeleteFunc(ro.status.PromotedNodes, leftPool)
+		if len(ro.status.CanaryNodes) > 0 || (ro.status.Phase != constants.RolloutPhaseCanary && ro.status.Phase != constants.RolloutPhaseSoaking) {
+			return
+		}
+		// all the canary nodes left the pool, restart the rollout on new ones
+	}
+
+	ro.status = &sriovnetworkv1.RolloutStatus{Revision: revision}
+	for _, name := range nodes[:min(ro.pool.Spec.Rollout.CanaryNodes, len(nodes))] {
+		ro.status.CanaryNodes = append(ro.status.CanaryNodes, sriovnetworkv1.RolloutNode{Name: name})
+	}
+	ro.setPhase(constants.RolloutPhaseCanary, fmt.Sprintf("rolling out revision %s to %d canary nodes", revision, len(ro.status.CanaryNodes)), now)
+}
+
+// evaluate moves the rollout forward according to the sync state of the canary nodes, and halts it when
+// a node which received the revision failed to sync it. It returns when the rollout must be evaluated again to end the soak period.
+func (ro *poolRollout) evaluate(nodeStates map[string]*sriovnetworkv1.SriovNetworkNodeState, now time.Time) time.Duration {
+	status := ro.status
+	if ro.pool.Annotations[constants.PoolPromoteRolloutAnnotation] == status.Revision {
+		if status.Phase != constants.RolloutPhasePromoted {
+			ro.setPhase(constants.RolloutPhasePromoted,
+				fmt.Sprintf("changes promoted to all the nodes of the pool by the %s annotation", constants.PoolPromoteRolloutAnnotation), now)
+		}
+		return 0
+	}
+	updatedNodes := slices.Concat(status.CanaryNodes, status.PromotedNodes)
+	if status.Phase == constants.RolloutPhaseHalted {
+		// the rollout resumes once all the nodes which received the revision recovered, e.g. after a retry of the daemon succeeded
+		for _, node := range updatedNodes {
+			if ns, ok := nodeStates[node.Name]; !ok || !isNodeSynced(ns, node.Generation) {
+				return 0
+			}
+		}
+		if len(status.PromotedNodes) > 0 {
+			ro.setPhase(constants.RolloutPhasePromoted, "nodes recovered, resuming the promotion", now)
+			return 0
+		}
+		ro.setPhase(constants.RolloutPhaseCanary, "canary nodes recovered, resuming the rollout", now)
+	}
+
+	for _, node := range updatedNodes {
+		if ns, ok := nodeStates[node.Name]; ok && hasFailedGeneration(ns, node.Generation) {
+			ro.setPhase(constants.RolloutPhaseHalted,
+				fmt.Sprintf("node %s failed to sync: %s", node.Name, ns.Status.LastSyncError), now)
+			return 0
+		}
+	}
+	if status.Phase == constants.RolloutPhasePromoted {
+		return 0
+	}
+
+	synced := true
+	for _, canary := range status.CanaryNodes {
+		if ns, ok := nodeStates[canary.Name]; !ok || !isNodeSynced(ns, canary.Generation) {
+			synced = false
+		}
+	}
+
+	if !synced {
+		if status.Phase == constants.RolloutPhaseSoaking {
+			// restart the soak period once the canary nodes are synced again
+			status.CanarySyncedTime = nil
+			ro.setPhase(constants.RolloutPhaseCanary, "canary nodes are not synced anymore", now)
+		}
+		return 0
+	}
+
+	soakPeriod := ro.pool.Spec.Rollout.SoakPeriod.Duration
+	if status.Phase == constants.RolloutPhaseCanary {
+		status.CanarySyncedTime = &metav1.Time{Time: now}
+		ro.setPhase(constants.RolloutPhaseSoaking, fmt.Sprintf("canary nodes synced, soaking for %s", soakPeriod), now)
+	}
+	if remaining := status.CanarySyncedTime.Add(soakPeriod).Sub(now); remaining > 0 {
+		return remaining
+	}
+	ro.setPhase(constants.RolloutPhasePromoted, "changes promoted to all the nodes of the pool", now)
+	return 0
+}
+
+// isNodeSynced returns true if the daemon reported a successful sync of the nodeState generation
+// rendered for the rollout
+func isNodeSynced(ns *sriovnetworkv1.SriovNetworkNodeState, generation int64) bool {
+	if generation == 0 || ns.Status.SyncStatus != constants.SyncStatusSucceeded {
+		return false
+	}
+	return meta.IsStatusConditionTrue(ns.Status.Conditions, constants.ConditionReady) && hasSyncedGeneration(ns, generation)
+}
+
+// hasFailedGeneration returns true if the daemon reported a failed sync of the nodeState generation
+// rendered for the rollout, a failure reported for a previous generation is ignored
+func hasFailedGeneration(ns *sriovnetworkv1.SriovNetworkNodeState, generation int64) bool {
+	if generation == 0 || ns.Status.SyncStatus != constants.SyncStatusFailed {
+		return false
+	}
+	return hasSyncedGeneration(ns, generation)
+}
+
+// isHeld returns true if the node must keep its current configuration because
+// the rollout of its pool didn't reach it yet, or because it is halted
+func (r *poolRollouts) isHeld(nodeName string) bool {
+	if r == nil {
+		return false
+	}
+	ro, ok := r.byNode[nodeName]
+	if !ok || ro.status.Phase == constants.RolloutPhasePromoted {
+		return false
+	}
+	return ro.canaryIndex(nodeName) < 0
+}
+
+// recordRendered records the generation of the nodeState rendered for a node which received the revision,
+// the node is synced once the daemon reports it ready for this generation. The canary nodes are recorded
+// from the start of the rollout, even while it is halted so it resumes once they recover, the other nodes
+// once the revision is promoted.
+func (r *poolRollouts) recordRendered(ns *sriovnetworkv1.SriovNetworkNodeState) {
+	if r == nil || ns == nil {
+		return
+	}
+	ro, ok := r.byNode[ns.Name]
+	if !ok {
+		return
+	}
+	if i := ro.canaryIndex(ns.Name); i >= 0 {
+		if ns.Generation > ro.status.CanaryNodes[i].Generation {
+			ro.status.CanaryNodes[i].Generation = ns.Generation
+		}
+		return
+	}
+	if ro.status.Phase != constants.RolloutPhasePromoted {
+		return
+	}
+	i := slices.IndexFunc(ro.status.PromotedNodes, func(node sriovnetworkv1.RolloutNode) bool { return node.Name == ns.Name })
+	if i < 0 {
+		ro.status.PromotedNodes = append(ro.status.PromotedNodes, sriovnetworkv1.RolloutNode{Name: ns.Name, Generation: ns.Generation})
+		return
+	}
+	if ns.Generation > ro.status.PromotedNodes[i].Generation {
+		ro.status.PromotedNodes[i].Generation = ns.Generation
+	}
+}
+
+// getPoolRollouts returns the rollouts of the pools with a rollout configuration, moved forward
+// according to the current sync state of the nodes. It also returns when the rollouts must be evaluated again.
+func (r *SriovNetworkNodePolicyReconciler) getPoolRollouts(ctx context.Context,
+	npl *sriovnetworkv1.SriovNetworkNodePolicyList, nl *corev1.NodeList) (*poolRollouts, time.Duration, error) {
+	logger := log.Log.WithName("getPoolRollouts")
+
+	npcl := &sriovnetworkv1.SriovNetworkPoolConfigList{}
+	if err := r.List(ctx, npcl, &client.ListOptions{Namespace: vars.Namespace}); err != nil {
+		logger.Error(err, "Fail to list SriovNetworkPoolConfig CRs")
+		return nil, 0, err
+	}
+	nsList := &sriovnetworkv1.SriovNetworkNodeStateList{}
+	if err := r.List(ctx, nsList, &client.ListOptions{Namespace: vars.Namespace}); err != nil {
+		logger.Error(err, "Fail to list SriovNetworkNodeState CRs")
+		return nil, 0, err
+	}
+	overrideList := &sriovnetworkv1.SriovNetworkNodeOverrideList{}
+	if err := r.List(ctx, overrideList, &client.ListOptions{Namespace: vars.Namespace}); err != nil {
+		logger.Error(err, "Fail to list SriovNetworkNodeOverride CRs")
+		return nil, 0, err
+	}
+	nodeStates := make(map[string]*sriovnetworkv1.SriovNetworkNodeState, len(nsList.Items))
+	for i := range nsList.Items {
+		nodeStates[nsList.Items[i].Name] = &nsList.Items[i]
+	}
+
+	// nodes selected by more than one pool are not managed by any rollout
+	nodePools := map[string][]*sriovnetworkv1.SriovNetworkPoolConfig{}
+	for i := range npcl.Items {
+		npc := &npcl.Items[i]
+		// we skip hw offload objects
+		if npc.IsOvsHardwareOffloadPool() {
+			continue
+		}
+		selector, err := npc.NodeLabelSelector()
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, node := range nl.Items {
+			if selector.Matches(labels.Set(node.Labels)) {
+				nodePools[node.Name] = append(nodePools[node.Name], npc)
+			}
+		}
+	}
+	poolNodes := map[string][]string{}
+	for _, node := range nl.Items {
+		if pools := nodePools[node.Name]; len(pools) == 1 {
+			poolNodes[pools[0].Name] = append(poolNodes[pools[0].Name], node.Name)
+		}
+	}
+
+	rollouts := &poolRollouts{byNode: map[string]*poolRollout{}}
+	now := time.Now()
+	var requeueAfter time.Duration
+	for i := range npcl.Items {
+		npc := &npcl.Items[i]
+		ro := &poolRollout{pool: npc}
+		rollouts.pools = append(rollouts.pools, ro)
+		// the status is reset when the rollout is removed from the pool
+		if npc.Spec.Rollout == nil || npc.IsOvsHardwareOffloadPool() {
+			continue
+		}
+
+		nodes := poolNodes[npc.Name]
+		sort.Strings(nodes)
+		revision, err := rolloutRevision(npl, npc, nl, nodes, overrideList.Items)
+		if err != nil {
+			return nil, 0, err
+		}
+		ro.status = npc.Status.Rollout.DeepCopy()
+		ro.start(revision, nodes, now)
+		if after := ro.evaluate(nodeStates, now); after > 0 && (requeueAfter == 0 || after < requeueAfter) {
+			requeueAfter = after
+		}
+		for _, name := range nodes {
+			rollouts.byNode[name] = ro
+		}
+	}
+	return rollouts, requeueAfter, nil
+}
+
+// rolloutRevision returns the hash of the configuration rolled out in the pool: the policies selecting
+// at least one node of the pool, the overrides of the nodes of the pool and the RDMA mode of the pool
+func rolloutRevision(npl *sriovnetworkv1.SriovNetworkNodePolicyList, npc *sriovnetworkv1.SriovNetworkPoolConfig,
+	nl *corev1.NodeList, nodes []string, overrides []sriovnetworkv1.SriovNetworkNodeOverride) (string, error) {
+	type policyRevision struct {
+		Name string                                    `json:"name"`
+		Spec sriovnetworkv1.SriovNetworkNodePolicySpec `json:"spec"`
+	}
+	type overrideRevision struct {
+		Name string                                      `json:"name"`
+		Spec sriovnetworkv1.SriovNetworkNodeOverrideSpec `json:"spec"`
+	}
+	revision := struct {
+		Policies  []policyRevision   `json:"policies"`
+		Overrides []overrideRevision `json:"overrides,omitempty"`
+		RdmaMode  string             `json:"rdmaMode"`
+	}{RdmaMode: npc.Spec.RdmaMode}
+
+	for i := range npl.Items {
+		p := &npl.Items[i]
+		// Note(adrianc): default policy is deprecated and ignored.
+		if p.Name == constants.DefaultPolicyName || p.IsDryRun() {
+			continue
+		}
+		for j := range nl.Items {
+			if slices.Contains(nodes, nl.Items[j].Name) && p.Selected(&nl.Items[j]) {
+				revision.Policies = append(revision.Policies, policyRevision{Name: p.Name, Spec: p.Spec})
+				break
+			}
+		}
+	}
+	sort.Slice(revision.Policies, func(i, j int) bool { return revision.Policies[i].Name < revision.Policies[j].Name })
+	// the override of a node is named after the node
+	for i := range overrides {
+		if slices.Contains(nodes, overrides[i].Name) {
+			revision.Overrides = append(revision.Overrides, overrideRevision{Name: overrides[i].Name, Spec: overrides[i].Spec})
+		}
+	}
+	sort.Slice(revision.Overrides, func(i, j int) bool { return revision.Overrides[i].Name < revision.Overrides[j].Name })
+
+	data, err := json.Marshal(revision)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// setRolloutConditions reports in the Degraded condition of the pool if its rollout is halted,
+// the condition is removed when the pool has no rollout
+func setRolloutConditions(status *sriovnetworkv1.SriovNetworkPoolConfigStatus, rollout *sriovnetworkv1.RolloutStatus, generation int64) {
+	if rollout == nil {
+		meta.RemoveStatusCondition(&status.Conditions, constants.ConditionDegraded)
+		return
+	}
+	condition := metav1.Condition{
+		Type:               constants.ConditionDegraded,
+		Status:             metav1.ConditionFalse,
+		Reason:             constants.ConditionReasonRolloutHealthy,
+		Message:            rollout.Message,
+		ObservedGeneration: generation,
+	}
+	if rollout.Phase == constants.RolloutPhaseHalted {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = constants.ConditionReasonRolloutHalted
+		condition.Message = fmt.Sprintf("rollout of revision %s is halted: %s", rollout.Revision, rollout.Message)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// syncPoolRolloutStatuses reports the rollouts in the status of the pools
+func (r *SriovNetworkNodePolicyReconciler) syncPoolRolloutStatuses(ctx context.Context, rollouts *poolRollouts) error {
+	logger := log.Log.WithName("syncPoolRolloutStatuses")
+	for _, ro := range rollouts.pools {
+		status := ro.pool.Status.DeepCopy()
+		status.Rollout = ro.status
+		setRolloutConditions(status, ro.status, ro.pool.Generation)
+		if equality.Semantic.DeepEqual(&ro.pool.Status, status) {
+			continue
+		}
+		ro.pool.Status = *status
+		if err := r.Status().Update(ctx, ro.pool); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			logger.Error(err, "Fail to update SriovNetworkPoolConfig status", "name", ro.pool.Name)
+			return err
+		}
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -29,16 +30,19 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -63,6 +67,8 @@ type SriovNetworkNodePolicyReconciler struct {
 //+kubebuilder:rbac:groups=sriovnetwork.openshift.io,resources=sriovnetworknodepolicies,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=sriovnetwork.openshift.io,resources=sriovnetworknodepolicies/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=sriovnetwork.openshift.io,resources=sriovnetworknodepolicies/finalizers,verbs=update
+//+kubebuilder:rbac:groups=sriovnetwork.openshift.io,resources=sriovnetworknodeoverrides,verbs=get;list;watch
+//+kubebuilder:rbac:groups=sriovnetwork.openshift.io,resources=sriovnetworknodeoverrides/status,verbs=get;update;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -127,18 +133,36 @@ func (r *SriovNetworkNodePolicyReconciler) Reconcile(ctx context.Context, req ct
 	// That is needed so when we create the node Affinity for the sriov-device plugin
 	// it will remain in the same order and not trigger a pod recreation
 	sort.Sort(sriovnetworkv1.ByPriority(policyList.Items))
+	// Compute the rollouts of the pools holding the policy changes on part of their nodes
+	rollouts, rolloutRequeueAfter, err := r.getPoolRollouts(ctx, policyList, nodeList)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
 	// Sync SriovNetworkNodeState objects
-	if err = r.syncAllSriovNetworkNodeStates(ctx, defaultOpConf, policyList, nodeList); err != nil {
+	renderedGenerations, err := r.syncAllSriovNetworkNodeStates(ctx, defaultOpConf, policyList, nodeList, rollouts)
+	if err != nil {
 		return reconcile.Result{}, err
 	}
 	// Sync Sriov device plugin ConfigMap object
-	if err = r.syncDevicePluginConfigMap(ctx, defaultOpConf, policyList, nodeList); err != nil {
+	if err = r.syncDevicePluginConfigMap(ctx, defaultOpConf, policyList, nodeList, rollouts); err != nil {
+		return reconcile.Result{}, err
+	}
+	// Sync SriovNetworkNodePolicy status
+	if err = r.syncAllPolicyStatuses(ctx, policyList, nodeList, rollouts, renderedGenerations); err != nil {
+		return reconcile.Result{}, err
+	}
+	// Sync SriovNetworkPoolConfig rollout status
+	if err = r.syncPoolRolloutStatuses(ctx, rollouts); err != nil {
 		return reconcile.Result{}, err
 	}
 
 	// All was successful. Request that this be re-triggered after ResyncPeriod,
-	// so we can reconcile state again.
-	return reconcile.Result{RequeueAfter: constants.ResyncPeriod}, nil
+	// or at the end of a rollout soak period, so we can reconcile state again.
+	requeueAfter := constants.ResyncPeriod
+	if rolloutRequeueAfter > 0 && rolloutRequeueAfter < requeueAfter {
+		requeueAfter = rolloutRequeueAfter
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -195,6 +219,28 @@ func (r *SriovNetworkNodePolicyReconciler) SetupWithManager(mgr ctrl.Manager) er
 		},
 	}
 
+	// update the policy status when the daemon reports a new sync result for a node
+	nodeStateEventHandler := handler.Funcs{
+		UpdateFunc: func(c context.Context, e event.TypedUpdateEvent[client.Object], w workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			oldState, ok := e.ObjectOld.(*sriovnetworkv1.SriovNetworkNodeState)
+			if !ok {
+				return
+			}
+			newState, ok := e.ObjectNew.(*sriovnetworkv1.SriovNetworkNodeState)
+			if !ok {
+				return
+			}
+			if oldState.Status.SyncStatus == newState.Status.SyncStatus &&
+				oldState.Status.LastSyncError == newState.Status.LastSyncError &&
+				equality.Semantic.DeepEqual(oldState.Status.Plan, newState.Status.Plan) {
+				return
+			}
+			log.Log.WithName("SriovNetworkNodePolicy").
+				Info("Enqueuing sync for sync status change", "resource", newState.GetName(), "syncStatus", newState.Status.SyncStatus)
+			qHandler(w)
+		},
+	}
+
 	// send initial sync event to trigger reconcile when controller is started
 	var eventChan = make(chan event.GenericEvent, 1)
 	eventChan <- event.GenericEvent{Object: &sriovnetworkv1.SriovNetworkNodePolicy{
@@ -204,22 +250,46 @@ func (r *SriovNetworkNodePolicyReconciler) SetupWithManager(mgr ctrl.Manager) er
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&sriovnetworkv1.SriovNetworkNodePolicy{}).
 		Watches(&corev1.Node{}, nodeEvenHandler).
-		Watches(&sriovnetworkv1.SriovNetworkNodePolicy{}, delayedEventHandler).
-		Watches(&sriovnetworkv1.SriovNetworkPoolConfig{}, delayedEventHandler).
+		// status updates don't change the generation, ignore them to avoid reconciling our own status changes.
+		// annotation changes are needed to react on the dry-run annotation
+		Watches(&sriovnetworkv1.SriovNetworkNodePolicy{}, delayedEventHandler, builder.WithPredicates(
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Watches(&sriovnetworkv1.SriovNetworkNodeState{}, nodeStateEventHandler).
+		// the rollout status of the pools is updated by this controller, ignore status changes.
+		// The annotations are watched to promote a rollout with the promote-rollout annotation.
+		Watches(&sriovnetworkv1.SriovNetworkPoolConfig{}, delayedEventHandler, builder.WithPredicates(
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		// the status of the overrides is updated by this controller, ignore status changes
+		Watches(&sriovnetworkv1.SriovNetworkNodeOverride{}, delayedEventHandler, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		WatchesRawSource(source.Channel(eventChan, &handler.EnqueueRequestForObject{})).
 		Complete(r)
 }
 
 func (r *SriovNetworkNodePolicyReconciler) syncDevicePluginConfigMap(ctx context.Context, dc *sriovnetworkv1.SriovOperatorConfig,
-	pl *sriovnetworkv1.SriovNetworkNodePolicyList, nl *corev1.NodeList) error {
+	pl *sriovnetworkv1.SriovNetworkNodePolicyList, nl *corev1.NodeList, rollouts *poolRollouts) error {
 	logger := log.Log.WithName("syncDevicePluginConfigMap")
 	logger.V(1).Info("Start to sync device plugin ConfigMap")
 
+	found := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: vars.Namespace, Name: constants.ConfigMapName}, found)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get ConfigMap: %v", err)
+	}
+	cmExists := err == nil
+
 	configData := make(map[string]string)
 	for _, node := range nl.Items {
-		data, err := r.renderDevicePluginConfigData(ctx, pl, &node)
-		if err != nil {
-			return err
+		var data dptypes.ResourceConfList
+		if current, ok := found.Data[node.Name]; ok && rollouts.isHeld(node.Name) {
+			// keep the resources of the current configuration until the pool rollout reaches the node
+			if err := json.Unmarshal([]byte(current), &data); err != nil {
+				return fmt.Errorf("failed to parse device plugin config of node %s: %v", node.Name, err)
+			}
+		} else {
+			data, err = r.renderDevicePluginConfigData(ctx, pl, &node)
+			if err != nil {
+				return err
+			}
 		}
 		config, err := json.Marshal(data)
 		if err != nil {
@@ -268,18 +338,12 @@ func (r *SriovNetworkNodePolicyReconciler) syncDevicePluginConfigMap(ctx context
 		return err
 	}
 
-	found := &corev1.ConfigMap{}
-	err := r.Get(ctx, types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name}, found)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			err = r.Create(ctx, cm)
-			if err != nil {
-				return fmt.Errorf("couldn't create ConfigMap: %v", err)
-			}
-			logger.V(1).Info("Created ConfigMap for", cm.Namespace, cm.Name)
-		} else {
-			return fmt.Errorf("failed to get ConfigMap: %v", err)
+	if !cmExists {
+		err = r.Create(ctx, cm)
+		if err != nil {
+			return fmt.Errorf("couldn't create ConfigMap: %v", err)
 		}
+		logger.V(1).Info("Created ConfigMap for", cm.Namespace, cm.Name)
 	} else {
 		logger.V(1).Info("ConfigMap already exists, updating")
 		err = r.Update(ctx, cm)
@@ -290,13 +354,25 @@ func (r *SriovNetworkNodePolicyReconciler) syncDevicePluginConfigMap(ctx context
 	return nil
 }
 
-func (r *SriovNetworkNodePolicyReconciler) syncAllSriovNetworkNodeStates(ctx context.Context, dc *sriovnetworkv1.SriovOperatorConfig, npl *sriovnetworkv1.SriovNetworkNodePolicyList, nl *corev1.NodeList) error {
+// syncAllSriovNetworkNodeStates renders the SriovNetworkNodeState objects of the nodes from the policies.
+// It returns the generation of the nodeState rendered for each node.
+func (r *SriovNetworkNodePolicyReconciler) syncAllSriovNetworkNodeStates(ctx context.Context, dc *sriovnetworkv1.SriovOperatorConfig, npl *sriovnetworkv1.SriovNetworkNodePolicyList, nl *corev1.NodeList, rollouts *poolRollouts) (map[string]int64, error) {
 	logger := log.Log.WithName("syncAllSriovNetworkNodeStates")
 	logger.V(1).Info("Start to sync all SriovNetworkNodeState custom resource")
 	found := &corev1.ConfigMap{}
 	if err := r.Get(ctx, types.NamespacedName{Namespace: vars.Namespace, Name: constants.ConfigMapName}, found); err != nil {
 		logger.V(1).Info("Fail to get", "ConfigMap", constants.ConfigMapName)
 	}
+	overrideList := &sriovnetworkv1.SriovNetworkNodeOverrideList{}
+	if err := r.List(ctx, overrideList, client.InNamespace(vars.Namespace)); err != nil {
+		logger.Error(err, "Fail to list SriovNetworkNodeOverride CRs")
+		return nil, err
+	}
+	overrides := map[string]*sriovnetworkv1.SriovNetworkNodeOverride{}
+	for i := range overrideList.Items {
+		overrides[overrideList.Items[i].Name] = &overrideList.Items[i]
+	}
+	renderedGenerations := make(map[string]int64, len(nl.Items))
 	for _, node := range nl.Items {
 		logger.V(1).Info("Sync SriovNetworkNodeState CR", "name", node.Name)
 		ns := &sriovnetworkv1.SriovNetworkNodeState{}
@@ -311,10 +387,13 @@ func (r *SriovNetworkNodePolicyReconciler) syncAllSriovNetworkNodeStates(ctx con
 		}
 		j, _ := json.Marshal(ns)
 		logger.V(2).Info("SriovNetworkNodeState CR", "content", j)
-		if err := r.syncSriovNetworkNodeState(ctx, dc, npl, ns, &node); err != nil {
+		synced, err := r.syncSriovNetworkNodeState(ctx, dc, npl, overrides[node.Name], ns, &node, rollouts.isHeld(node.Name))
+		if err != nil {
 			logger.Error(err, "Fail to sync", "SriovNetworkNodeState", ns.Name)
-			return err
+			return nil, err
 		}
+		rollouts.recordRendered(synced)
+		renderedGenerations[node.Name] = synced.Generation
 	}
 
 	logger.V(1).Info("Remove SriovNetworkNodeState custom resource for unselected node")
@@ -323,7 +402,7 @@ func (r *SriovNetworkNodePolicyReconciler) syncAllSriovNetworkNodeStates(ctx con
 	if err != nil {
 		if !errors.IsNotFound(err) {
 			logger.Error(err, "Fail to list SriovNetworkNodeState CRs")
-			return err
+			return nil, err
 		}
 	} else {
 		for _, ns := range nsList.Items {
@@ -340,15 +419,15 @@ func (r *SriovNetworkNodePolicyReconciler) syncAllSriovNetworkNodeStates(ctx con
 				err = utils.RemoveLabelFromNode(ctx, ns.Name, constants.SriovDevicePluginLabel, r.Client)
 				if err != nil && !errors.IsNotFound(err) {
 					logger.Error(err, "Fail to remove device plugin label from node", "node", ns.Name)
-					return err
+					return nil, err
 				}
 				if err := r.handleStaleNodeState(ctx, &ns); err != nil {
-					return err
+					return nil, err
 				}
 			}
 		}
 	}
-	return nil
+	return renderedGenerations, nil
 }
 
 // handleStaleNodeState handles stale SriovNetworkNodeState CR (the CR which no longer have a corresponding node with the daemon).
@@ -404,13 +483,15 @@ func (r *SriovNetworkNodePolicyReconciler) handleStaleNodeState(ctx context.Cont
 func (r *SriovNetworkNodePolicyReconciler) syncSriovNetworkNodeState(ctx context.Context,
 	dc *sriovnetworkv1.SriovOperatorConfig,
 	npl *sriovnetworkv1.SriovNetworkNodePolicyList,
+	override *sriovnetworkv1.SriovNetworkNodeOverride,
 	ns *sriovnetworkv1.SriovNetworkNodeState,
-	node *corev1.Node) error {
+	node *corev1.Node,
+	held bool) (*sriovnetworkv1.SriovNetworkNodeState, error) {
 	logger := log.Log.WithName("syncSriovNetworkNodeState")
 	logger.V(1).Info("Start to sync SriovNetworkNodeState", "Name", ns.Name)
 
 	if err := controllerutil.SetControllerReference(dc, ns, r.Scheme); err != nil {
-		return err
+		return nil, err
 	}
 	found := &sriovnetworkv1.SriovNetworkNodeState{}
 	err := r.Get(ctx, types.NamespacedName{Namespace: ns.Namespace, Name: ns.Name}, found)
@@ -419,78 +500,361 @@ func (r *SriovNetworkNodePolicyReconciler) syncSriovNetworkNodeState(ctx context
 		if errors.IsNotFound(err) {
 			err = r.Create(ctx, ns)
 			if err != nil {
-				return fmt.Errorf("couldn't create SriovNetworkNodeState: %v", err)
+				return nil, fmt.Errorf("couldn't create SriovNetworkNodeState: %v", err)
 			}
 			logger.Info("Created SriovNetworkNodeState for", ns.Namespace, ns.Name)
-		} else {
-			return fmt.Errorf("failed to get SriovNetworkNodeState: %v", err)
+			return ns, nil
 		}
-	} else {
-		keepUntilAnnotationUpdated := found.ResetKeepUntilTime()
-
-		if len(found.Status.Interfaces) == 0 {
-			logger.Info("SriovNetworkNodeState Status Interfaces are empty. Skip update of policies in spec",
-				"namespace", ns.Namespace, "name", ns.Name)
-			if keepUntilAnnotationUpdated {
-				if err := r.Update(ctx, found); err != nil {
-					return fmt.Errorf("couldn't update SriovNetworkNodeState: %v", err)
-				}
+		return nil, fmt.Errorf("failed to get SriovNetworkNodeState: %v", err)
+	}
+
+	keepUntilAnnotationUpdated := found.ResetKeepUntilTime()
+
+	if len(found.Status.Interfaces) == 0 {
+		logger.Info("SriovNetworkNodeState Status Interfaces are empty. Skip update of policies in spec",
+			"namespace", ns.Namespace, "name", ns.Name)
+		if keepUntilAnnotationUpdated {
+			if err := r.Update(ctx, found); err != nil {
+				return nil, fmt.Errorf("couldn't update SriovNetworkNodeState: %v", err)
 			}
-			return nil
 		}
+		return found, nil
+	}
 
-		logger.V(1).Info("SriovNetworkNodeState already exists, updating")
-		newVersion := found.DeepCopy()
-		newVersion.Spec = ns.Spec
-		newVersion.OwnerReferences = ns.OwnerReferences
-
-		// Previous Policy Priority(ppp) records the priority of previous evaluated policy in node policy list.
-		// Since node policy list is already sorted with priority number, comparing current priority with ppp shall
-		// be sufficient.
-		// ppp is set to 100 as initial value to avoid matching with the first policy in policy list, although
-		// it should not matter since the flag used in p.Apply() will only be applied when VF partition is detected.
-		ppp := 100
-		for _, p := range npl.Items {
-			// Note(adrianc): default policy is deprecated and ignored.
-			if p.Name == constants.DefaultPolicyName {
-				continue
+	logger.V(1).Info("SriovNetworkNodeState already exists, updating")
+	newVersion := found.DeepCopy()
+	newVersion.Spec = ns.Spec
+	newVersion.OwnerReferences = ns.OwnerReferences
+
+	if err := r.applyPolicies(npl, node, newVersion, found, false); err != nil {
+		return nil, err
+	}
+	// the override of the node is merged last, it takes precedence over the policies
+	appliedOverride, unmatchedOverride := applyNodeOverride(override, newVersion)
+	// the override applied to the live spec, its status is reported once it reaches the node
+	liveOverride := override
+	if held && !equality.Semantic.DeepEqual(newVersion.Spec, found.Spec) {
+		// the node keeps its current configuration until the rollout of its pool reaches it
+		logger.Info("policy changes held by the pool rollout", "name", ns.Name)
+		newVersion.Spec = found.Spec
+		newVersion.SetAppliedOverride(found.GetAppliedOverride())
+		liveOverride = nil
+	}
+
+	// publish the spec rendered with the dry-run policies so the daemon can report the changes they would do
+	var plannedSpec *sriovnetworkv1.SriovNetworkNodeStateSpec
+	if hasDryRunPolicy(npl, node) {
+		planned := found.DeepCopy()
+		planned.Spec = ns.Spec
+		if err := r.applyPolicies(npl, node, planned, found, true); err != nil {
+			return nil, err
+		}
+		applyNodeOverride(override, planned)
+		plannedSpec = &planned.Spec
+	}
+	plannedSpecUpdated, err := newVersion.SetPlannedSpec(plannedSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note(adrianc): we check same ownerReferences since SriovNetworkNodeState
+	// was owned by a default SriovNetworkNodePolicy. if we encounter a descripancy
+	// we need to update.
+	if !keepUntilAnnotationUpdated && !plannedSpecUpdated &&
+		found.GetAppliedOverride() == newVersion.GetAppliedOverride() &&
+		equality.Semantic.DeepEqual(newVersion.OwnerReferences, found.OwnerReferences) &&
+		equality.Semantic.DeepEqual(newVersion.Spec, found.Spec) {
+		logger.V(1).Info("SriovNetworkNodeState did not change, not updating")
+		return found, r.syncNodeOverrideStatus(ctx, liveOverride, appliedOverride, unmatchedOverride)
+	}
+	err = r.Update(ctx, newVersion)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't update SriovNetworkNodeState: %v", err)
+	}
+	return newVersion, r.syncNodeOverrideStatus(ctx, liveOverride, appliedOverride, unmatchedOverride)
+}
+
+// applyNodeOverride applies the override of the node to the nodeState spec and records it in the applied-override annotation.
+// Returns the PFs changed by the override and the overrides that don't match a PF configured by the policies.
+func applyNodeOverride(override *sriovnetworkv1.SriovNetworkNodeOverride, nodeState *sriovnetworkv1.SriovNetworkNodeState) ([]string, []string) {
+	if override == nil {
+		nodeState.SetAppliedOverride("")
+		return nil, nil
+	}
+	log.Log.WithName("applyNodeOverride").Info("apply", "override", override.Name, "node", nodeState.Name)
+	applied, unmatched := override.Apply(nodeState)
+	nodeState.SetAppliedOverride(override.Name)
+	return applied, unmatched
+}
+
+// syncNodeOverrideStatus reports the result of the application of the override to the SriovNetworkNodeState in its status
+func (r *SriovNetworkNodePolicyReconciler) syncNodeOverrideStatus(ctx context.Context,
+	override *sriovnetworkv1.SriovNetworkNodeOverride, applied, unmatched []string) error {
+	if override == nil {
+		return nil
+	}
+	status := sriovnetworkv1.SriovNetworkNodeOverrideStatus{
+		ObservedGeneration:  override.Generation,
+		AppliedInterfaces:   applied,
+		UnmatchedInterfaces: unmatched,
+	}
+	if equality.Semantic.DeepEqual(override.Status, status) {
+		return nil
+	}
+	if len(unmatched) > 0 {
+		log.Log.WithName("syncNodeOverrideStatus").Info("overrides don't match a PF configured by the policies",
+			"override", override.Name, "interfaces", unmatched)
+	}
+	override.Status = status
+	if err := r.Status().Update(ctx, override); err != nil {
+		return fmt.Errorf("couldn't update SriovNetworkNodeOverride status: %v", err)
+	}
+	return nil
+}
+
+// applyPolicies applies the policies selecting the node to the nodeState spec.
+// The PFs configured by a policy in the current spec stay selected by the policy if only their link or firmware changed.
+// Policies in dry-run mode are skipped unless includeDryRun is true.
+func (r *SriovNetworkNodePolicyReconciler) applyPolicies(npl *sriovnetworkv1.SriovNetworkNodePolicyList,
+	node *corev1.Node, nodeState, current *sriovnetworkv1.SriovNetworkNodeState, includeDryRun bool) error {
+	logger := log.Log.WithName("applyPolicies")
+	// Previous Policy Priority(ppp) records the priority of previous evaluated policy in node policy list.
+	// Since node policy list is already sorted with priority number, comparing current priority with ppp shall
+	// be sufficient.
+	// ppp is set to 100 as initial value to avoid matching with the first policy in policy list, although
+	// it should not matter since the flag used in p.Apply() will only be applied when VF partition is detected.
+	ppp := 100
+	for _, p := range npl.Items {
+		// Note(adrianc): default policy is deprecated and ignored.
+		if p.Name == constants.DefaultPolicyName {
+			continue
+		}
+		if p.IsDryRun() && !includeDryRun {
+			continue
+		}
+		if p.Selected(node) {
+			logger.Info("apply", "policy", p.Name, "node", node.Name, "dryRun", p.IsDryRun())
+			// Merging only for policies with the same priority (ppp == p.Spec.Priority)
+			// This boolean flag controls merging of PF configuration (e.g. mtu, numvfs etc)
+			// when VF partition is configured.
+			err := p.ApplyKeepingPfs(nodeState, ppp == p.Spec.Priority, configuredPfAddresses(&p, current))
+			if err != nil {
+				return err
 			}
-			if p.Selected(node) {
-				logger.Info("apply", "policy", p.Name, "node", node.Name)
-				// Merging only for policies with the same priority (ppp == p.Spec.Priority)
-				// This boolean flag controls merging of PF configuration (e.g. mtu, numvfs etc)
-				// when VF partition is configured.
-				err = p.Apply(newVersion, ppp == p.Spec.Priority)
+			if r.FeatureGate.IsEnabled(constants.ManageSoftwareBridgesFeatureGate) {
+				err = p.ApplyBridgeConfig(nodeState)
 				if err != nil {
 					return err
 				}
-				if r.FeatureGate.IsEnabled(constants.ManageSoftwareBridgesFeatureGate) {
-					err = p.ApplyBridgeConfig(newVersion)
-					if err != nil {
-						return err
-					}
-				}
-				// record the evaluated policy priority for next loop
-				ppp = p.Spec.Priority
 			}
+			// record the evaluated policy priority for next loop
+			ppp = p.Spec.Priority
 		}
+	}
+	return nil
+}
 
-		// Note(adrianc): we check same ownerReferences since SriovNetworkNodeState
-		// was owned by a default SriovNetworkNodePolicy. if we encounter a descripancy
-		// we need to update.
-		if !keepUntilAnnotationUpdated && equality.Semantic.DeepEqual(newVersion.OwnerReferences, found.OwnerReferences) &&
-			equality.Semantic.DeepEqual(newVersion.Spec, found.Spec) {
-			logger.V(1).Info("SriovNetworkNodeState did not change, not updating")
-			return nil
+// hasDryRunPolicy returns true if a policy in dry-run mode selects the node
+func hasDryRunPolicy(npl *sriovnetworkv1.SriovNetworkNodePolicyList, node *corev1.Node) bool {
+	for i := range npl.Items {
+		if npl.Items[i].IsDryRun() && npl.Items[i].Selected(node) {
+			return true
 		}
-		err = r.Update(ctx, newVersion)
-		if err != nil {
-			return fmt.Errorf("couldn't update SriovNetworkNodeState: %v", err)
+	}
+	return false
+}
+
+// syncAllPolicyStatuses aggregates the sync state reported in the SriovNetworkNodeState objects
+// of the selected nodes into the status of every SriovNetworkNodePolicy.
+// renderedGenerations holds the generation of the nodeStates rendered from the current policies.
+func (r *SriovNetworkNodePolicyReconciler) syncAllPolicyStatuses(ctx context.Context, npl *sriovnetworkv1.SriovNetworkNodePolicyList,
+	nl *corev1.NodeList, rollouts *poolRollouts, renderedGenerations map[string]int64) error {
+	logger := log.Log.WithName("syncAllPolicyStatuses")
+	logger.V(1).Info("Start to sync SriovNetworkNodePolicy status")
+
+	nsList := &sriovnetworkv1.SriovNetworkNodeStateList{}
+	if err := r.List(ctx, nsList, &client.ListOptions{Namespace: vars.Namespace}); err != nil {
+		logger.Error(err, "Fail to list SriovNetworkNodeState CRs")
+		return err
+	}
+	nodeStates := make(map[string]*sriovnetworkv1.SriovNetworkNodeState, len(nsList.Items))
+	for i := range nsList.Items {
+		nodeStates[nsList.Items[i].Name] = &nsList.Items[i]
+	}
+
+	for i := range npl.Items {
+		p := &npl.Items[i]
+		// Note(adrianc): default policy is deprecated and ignored.
+		if p.Name == constants.DefaultPolicyName {
+			continue
+		}
+		newStatus := renderPolicyStatus(p, npl, nl, nodeStates, rollouts, renderedGenerations)
+		if equality.Semantic.DeepEqual(newStatus, p.Status) {
+			continue
+		}
+		if len(newStatus.Conflicts) > 0 && !equality.Semantic.DeepEqual(newStatus.Conflicts, p.Status.Conflicts) {
+			logger.Info("SriovNetworkNodePolicy conflicts with other policies", "name", p.Name, "conflicts", newStatus.Conflicts)
+		}
+		p.Status = newStatus
+		if err := r.Status().Update(ctx, p); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			logger.Error(err, "Fail to update SriovNetworkNodePolicy status", "name", p.Name)
+			return err
 		}
 	}
 	return nil
 }
 
+// renderPolicyStatus returns the status of the policy computed from the states of the nodes it selects.
+// A node is reported synced or failed only once the daemon reported the sync of the nodeState
+// generation rendered from the current policies, older results are reported as in progress.
+// Conditions from the current policy status are preserved if they didn't change to keep the transition time.
+// Nodes where a pool rollout holds the policy changes are reported in progress.
+// The conflicts with the other policies are computed on the PFs reported in the states of the nodes.
+func renderPolicyStatus(p *sriovnetworkv1.SriovNetworkNodePolicy, npl *sriovnetworkv1.SriovNetworkNodePolicyList, nl *corev1.NodeList,
+	nodeStates map[string]*sriovnetworkv1.SriovNetworkNodeState, rollouts *poolRollouts,
+	renderedGenerations map[string]int64) sriovnetworkv1.SriovNetworkNodePolicyStatus {
+	status := sriovnetworkv1.SriovNetworkNodePolicyStatus{
+		Conditions: slices.Clone(p.Status.Conditions),
+	}
+	var inProgressNodes []string
+	for i := range nl.Items {
+		node := &nl.Items[i]
+		if !p.Selected(node) {
+			continue
+		}
+		status.MatchedNodes++
+		nodeStatus := sriovnetworkv1.PolicyNodeStatus{Name: node.Name}
+		ns, ok := nodeStates[node.Name]
+		if !ok {
+			inProgressNodes = append(inProgressNodes, node.Name)
+			status.Nodes = append(status.Nodes, nodeStatus)
+			continue
+		}
+		if !p.Spec.NicSelector.IsEmpty() {
+			for j := range ns.Status.Interfaces {
+				if p.Spec.NicSelector.Selected(&ns.Status.Interfaces[j]) {
+					nodeStatus.MatchedPFs = append(nodeStatus.MatchedPFs, ns.Status.Interfaces[j].PciAddress)
+				}
+			}
+		}
+		nodeStatus.SyncStatus = ns.Status.SyncStatus
+		nodeStatus.LastSyncError = ns.Status.LastSyncError
+		status.Conflicts = sriovnetworkv1.MergePolicyConflicts(status.Conflicts, p.GetConflicts(npl.Items, node, ns)...)
+		if p.IsDryRun() {
+			// only report plans computed for the latest planned spec
+			if ns.IsPlanCurrent() {
+				nodeStatus.Plan = ns.Status.Plan.DeepCopy()
+			}
+			switch {
+			case nodeStatus.Plan == nil:
+				inProgressNodes = append(inProgressNodes, node.Name)
+			case nodeStatus.Plan.Error != "":
+				status.FailedNodes = append(status.FailedNodes, node.Name)
+			}
+			status.Nodes = append(status.Nodes, nodeStatus)
+			continue
+		}
+		switch {
+		case rollouts.isHeld(node.Name):
+			inProgressNodes = append(inProgressNodes, node.Name)
+		case !hasSyncedGeneration(ns, max(ns.Generation, renderedGenerations[node.Name])):
+			// the reported sync status is for a spec rendered before the current policies
+			inProgressNodes = append(inProgressNodes, node.Name)
+		case ns.Status.SyncStatus == constants.SyncStatusSucceeded:
+		case ns.Status.SyncStatus == constants.SyncStatusFailed:
+			status.FailedNodes = append(status.FailedNodes, node.Name)
+		default:
+			inProgressNodes = append(inProgressNodes, node.Name)
+		}
+		status.Nodes = append(status.Nodes, nodeStatus)
+	}
+	sort.Slice(status.Nodes, func(i, j int) bool { return status.Nodes[i].Name < status.Nodes[j].Name })
+	sort.Strings(status.FailedNodes)
+	sort.Strings(inProgressNodes)
+
+	if p.IsDryRun() {
+		setDryRunPolicyConditions(p, &status, inProgressNodes)
+		return status
+	}
+
+	ready := metav1.Condition{Type: constants.ConditionReady, ObservedGeneration: p.Generation}
+	progressing := metav1.Condition{Type: constants.ConditionProgressing, ObservedGeneration: p.Generation}
+	degraded := metav1.Condition{Type: constants.ConditionDegraded, ObservedGeneration: p.Generation}
+
+	switch {
+	case status.MatchedNodes == 0:
+		ready.Status, ready.Reason = metav1.ConditionFalse, constants.ConditionReasonNoMatchingNodes
+		ready.Message = "policy doesn't select any node"
+	case len(status.FailedNodes) > 0:
+		ready.Status, ready.Reason = metav1.ConditionFalse, constants.ConditionReasonSyncFailed
+		ready.Message = fmt.Sprintf("sync failed on %d of %d nodes", len(status.FailedNodes), status.MatchedNodes)
+	case len(inProgressNodes) > 0:
+		ready.Status, ready.Reason = metav1.ConditionFalse, constants.ConditionReasonSyncInProgress
+		ready.Message = fmt.Sprintf("sync in progress on %d of %d nodes", len(inProgressNodes), status.MatchedNodes)
+	default:
+		ready.Status, ready.Reason = metav1.ConditionTrue, constants.ConditionReasonSyncSucceeded
+		ready.Message = fmt.Sprintf("policy applied on %d nodes", status.MatchedNodes)
+	}
+
+	if len(inProgressNodes) > 0 {
+		progressing.Status, progressing.Reason = metav1.ConditionTrue, constants.ConditionReasonSyncInProgress
+		progressing.Message = "sync in progress on nodes: " + strings.Join(inProgressNodes, ", ")
+	} else {
+		progressing.Status, progressing.Reason = metav1.ConditionFalse, constants.ConditionReasonSyncSucceeded
+	}
+
+	if len(status.FailedNodes) > 0 {
+		degraded.Status, degraded.Reason = metav1.ConditionTrue, constants.ConditionReasonSyncFailed
+		degraded.Message = "sync failed on nodes: " + strings.Join(status.FailedNodes, ", ")
+	} else {
+		degraded.Status, degraded.Reason = metav1.ConditionFalse, constants.ConditionReasonSyncSucceeded
+	}
+
+	meta.SetStatusCondition(&status.Conditions, ready)
+	meta.SetStatusCondition(&status.Conditions, progressing)
+	meta.SetStatusCondition(&status.Conditions, degraded)
+	return status
+}
+
+// hasSyncedGeneration returns true if the sync status reported by the daemon is for the given
+// generation of the nodeState or a later one
+func hasSyncedGeneration(ns *sriovnetworkv1.SriovNetworkNodeState, generation int64) bool {
+	ready := meta.FindStatusCondition(ns.Status.Conditions, constants.ConditionReady)
+	return ready != nil && ready.ObservedGeneration >= generation
+}
+
+// setDryRunPolicyConditions sets the conditions of a policy in dry-run mode, the policy is never ready
+// and it is progressing until all the selected nodes reported the plan for the latest planned spec
+func setDryRunPolicyConditions(p *sriovnetworkv1.SriovNetworkNodePolicy, status *sriovnetworkv1.SriovNetworkNodePolicyStatus, inProgressNodes []string) {
+	ready := metav1.Condition{Type: constants.ConditionReady, ObservedGeneration: p.Generation,
+		Status: metav1.ConditionFalse, Reason: constants.ConditionReasonDryRun,
+		Message: fmt.Sprintf("policy is in dry-run mode, planned changes are reported for %d of %d nodes",
+			status.MatchedNodes-len(inProgressNodes), status.MatchedNodes)}
+	progressing := metav1.Condition{Type: constants.ConditionProgressing, ObservedGeneration: p.Generation}
+	degraded := metav1.Condition{Type: constants.ConditionDegraded, ObservedGeneration: p.Generation}
+
+	if len(inProgressNodes) > 0 {
+		progressing.Status, progressing.Reason = metav1.ConditionTrue, constants.ConditionReasonPlanInProgress
+		progressing.Message = "waiting for the plan from nodes: " + strings.Join(inProgressNodes, ", ")
+	} else {
+		progressing.Status, progressing.Reason = metav1.ConditionFalse, constants.ConditionReasonPlanReady
+	}
+
+	if len(status.FailedNodes) > 0 {
+		degraded.Status, degraded.Reason = metav1.ConditionTrue, constants.ConditionReasonPlanFailed
+		degraded.Message = "plan failed on nodes: " + strings.Join(status.FailedNodes, ", ")
+	} else {
+		degraded.Status, degraded.Reason = metav1.ConditionFalse, constants.ConditionReasonPlanReady
+	}
+
+	meta.SetStatusCondition(&status.Conditions, ready)
+	meta.SetStatusCondition(&status.Conditions, progressing)
+	meta.SetStatusCondition(&status.Conditions, degraded)
+}
+
 func (r *SriovNetworkNodePolicyReconciler) renderDevicePluginConfigData(ctx context.Context, pl *sriovnetworkv1.SriovNetworkNodePolicyList, node *corev1.Node) (dptypes.ResourceConfList, error) {
 	logger := log.Log.WithName("renderDevicePluginConfigData")
 	logger.V(1).Info("Start to render device plugin config data", "node", node.Name)
@@ -501,6 +865,11 @@ func (r *SriovNetworkNodePolicyReconciler) renderDevicePluginConfigData(ctx cont
 			continue
 		}
 
+		// dry-run policies don't expose resources
+		if p.IsDryRun() {
+			continue
+		}
+
 		// render node specific data for device plugin config
 		if !p.Selected(node) {
 			continue
@@ -512,6 +881,17 @@ func (r *SriovNetworkNodePolicyReconciler) renderDevicePluginConfigData(ctx cont
 			return rcl, err
 		}
 
+		// the device plugin selects the VFs of the PFs matched on the node, nothing is exposed if none matched
+		if p.Spec.NicSelector.HasPfStatusFields() && len(selectedPfAddresses(&p, nodeState)) == 0 {
+			logger.V(1).Info("no PF of the node is selected by the policy", "policy", p.Name, "node", node.Name)
+			continue
+		}
+		// the override of the node can exclude the PFs of the policy or remove the VFs of the policy from them
+		if nodeState.GetAppliedOverride() != "" && len(configuredPfAddresses(&p, nodeState)) == 0 {
+			logger.V(1).Info("no PF of the node is configured by the policy after the override", "policy", p.Name, "node", node.Name)
+			continue
+		}
+
 		found, i := resourceNameInList(p.Spec.ResourceName, &rcl)
 
 		if found {
@@ -532,6 +912,47 @@ func (r *SriovNetworkNodePolicyReconciler) renderDevicePluginConfigData(ctx cont
 	return rcl, nil
 }
 
+// configuredPfAddresses returns the PCI addresses of the PFs with VFs of the policy in the rendered spec of the node state
+func configuredPfAddresses(p *sriovnetworkv1.SriovNetworkNodePolicy, nodeState *sriovnetworkv1.SriovNetworkNodeState) []string {
+	addresses := []string{}
+	for _, iface := range nodeState.Spec.Interfaces {
+		if slices.ContainsFunc(iface.VfGroups, func(g sriovnetworkv1.VfGroup) bool { return g.PolicyName == p.Name }) {
+			addresses = append(addresses, iface.PciAddress)
+		}
+	}
+	return addresses
+}
+
+// policyRootDevices returns the PFs of the node the device plugin selects the VFs of the policy on, empty if not restricted.
+// When an override is applied to the node, the PFs configured by the policy in the rendered spec are used instead of the
+// nicSelector, the override can exclude PFs or truncate the VFs of the policy.
+func policyRootDevices(p *sriovnetworkv1.SriovNetworkNodePolicy, nodeState *sriovnetworkv1.SriovNetworkNodeState) []string {
+	if nodeState.GetAppliedOverride() != "" {
+		return configuredPfAddresses(p, nodeState)
+	}
+	rootDevices := slices.Clone(p.Spec.NicSelector.RootDevices)
+	if p.Spec.NicSelector.HasPfStatusFields() {
+		rootDevices = sriovnetworkv1.UniqueAppend(rootDevices, selectedPfAddresses(p, nodeState)...)
+	}
+	return rootDevices
+}
+
+// selectedPfAddresses returns the PCI addresses of the PFs of the node state selected by the nicSelector of the policy
+// or kept configured by the policy after their link or firmware changed,
+// the device plugin can't select the VFs on the driver, link speed, NUMA node, firmware or slot of their PF
+func selectedPfAddresses(p *sriovnetworkv1.SriovNetworkNodePolicy, nodeState *sriovnetworkv1.SriovNetworkNodeState) []string {
+	addresses := []string{}
+	configured := configuredPfAddresses(p, nodeState)
+	for i := range nodeState.Status.Interfaces {
+		iface := &nodeState.Status.Interfaces[i]
+		if p.Spec.NicSelector.Selected(iface) ||
+			(slices.Contains(configured, iface.PciAddress) && p.Spec.NicSelector.SelectedIgnoringVolatileFields(iface)) {
+			addresses = append(addresses, iface.PciAddress)
+		}
+	}
+	return addresses
+}
+
 func resourceNameInList(name string, rcl *dptypes.ResourceConfList) (bool, int) {
 	for i, rc := range rcl.ResourceList {
 		if rc.ResourceName == name {
@@ -581,8 +1002,8 @@ func createDevicePluginResource(
 			netDeviceSelectors.LinkTypes = sriovnetworkv1.UniqueAppend(netDeviceSelectors.LinkTypes, linkType)
 		}
 	}
-	if len(p.Spec.NicSelector.RootDevices) > 0 {
-		netDeviceSelectors.RootDevices = append(netDeviceSelectors.RootDevices, p.Spec.NicSelector.RootDevices...)
+	if rootDevices := policyRootDevices(p, nodeState); len(rootDevices) > 0 {
+		netDeviceSelectors.RootDevices = sriovnetworkv1.UniqueAppend(netDeviceSelectors.RootDevices, rootDevices...)
 	}
 
 	// Enable the selection of devices using NetFilter
@@ -651,8 +1072,8 @@ func updateDevicePluginResource(
 			}
 		}
 	}
-	if len(p.Spec.NicSelector.RootDevices) > 0 {
-		netDeviceSelectors.RootDevices = sriovnetworkv1.UniqueAppend(netDeviceSelectors.RootDevices, p.Spec.NicSelector.RootDevices...)
+	if rootDevices := policyRootDevices(p, nodeState); len(rootDevices) > 0 {
+		netDeviceSelectors.RootDevices = sriovnetworkv1.UniqueAppend(netDeviceSelectors.RootDevices, rootDevices...)
 	}
 
 	// Enable the selection of devices using NetFilter
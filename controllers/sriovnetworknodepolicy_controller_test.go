@@ -31,10 +31,12 @@ import (
 	dptypes "github.com/k8snetworkplumbingwg/sriov-network-device-plugin/pkg/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -156,6 +158,128 @@ func TestRenderDevicePluginConfigData(t *testing.T) {
 	}
 }
 
+func TestRenderDevicePluginConfigDataWithOverride(t *testing.T) {
+	g := NewGomegaWithT(t)
+	policy := sriovnetworkv1.SriovNetworkNodePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1"},
+		Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+			ResourceName: "resourceName",
+			NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "15b3", DeviceID: "101d"},
+			NumVfs:       8,
+		},
+	}
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+	// the override excluded the PF 0000:d8:00.1 and truncated the VFs of the policy on 0000:d8:00.2
+	nodeState := sriovnetworkv1.SriovNetworkNodeState{
+		ObjectMeta: metav1.ObjectMeta{Name: node.Name, Namespace: vars.Namespace},
+		Spec: sriovnetworkv1.SriovNetworkNodeStateSpec{Interfaces: sriovnetworkv1.Interfaces{
+			{PciAddress: "0000:d8:00.0", NumVfs: 8, VfGroups: []sriovnetworkv1.VfGroup{{PolicyName: "p1", VfRange: "0-7"}}},
+			{PciAddress: "0000:d8:00.2", NumVfs: 2, VfGroups: []sriovnetworkv1.VfGroup{{PolicyName: "other", VfRange: "0-1"}}},
+		}},
+	}
+	nodeState.SetAppliedOverride("node1")
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(sriovnetworkv1.AddToScheme(scheme))
+	reconciler := SriovNetworkNodePolicyReconciler{
+		FeatureGate: featuregate.New(),
+		Client:      fake.NewClientBuilder().WithScheme(scheme).WithObjects(&nodeState).Build(),
+	}
+	policyList := sriovnetworkv1.SriovNetworkNodePolicyList{Items: []sriovnetworkv1.SriovNetworkNodePolicy{policy}}
+
+	resourceList, err := reconciler.renderDevicePluginConfigData(context.TODO(), &policyList, &node)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resourceList.ResourceList).To(HaveLen(1))
+	selectors := dptypes.NetDeviceSelectors{}
+	g.Expect(json.Unmarshal(*resourceList.ResourceList[0].Selectors, &selectors)).To(Succeed())
+	g.Expect(selectors.RootDevices).To(Equal([]string{"0000:d8:00.0"}))
+
+	// nothing is exposed when the override removed all the PFs of the policy
+	nodeState.Spec.Interfaces = nodeState.Spec.Interfaces[1:]
+	g.Expect(reconciler.Client.Update(context.TODO(), &nodeState)).To(Succeed())
+	resourceList, err = reconciler.renderDevicePluginConfigData(context.TODO(), &policyList, &node)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resourceList.ResourceList).To(BeEmpty())
+}
+
+func TestApplyPoliciesKeepsConfiguredPfOnLinkDown(t *testing.T) {
+	g := NewGomegaWithT(t)
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"sriov": "true"}}}
+	npl := &sriovnetworkv1.SriovNetworkNodePolicyList{Items: []sriovnetworkv1.SriovNetworkNodePolicy{{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: vars.Namespace},
+		Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+			NodeSelector: map[string]string{"sriov": "true"},
+			NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "8086", MinLinkSpeed: 25000},
+			NumVfs:       4,
+			ResourceName: "resourceName",
+		},
+	}}}
+	current := &sriovnetworkv1.SriovNetworkNodeState{
+		ObjectMeta: metav1.ObjectMeta{Name: node.Name, Namespace: vars.Namespace},
+		Status: sriovnetworkv1.SriovNetworkNodeStateStatus{Interfaces: sriovnetworkv1.InterfaceExts{
+			{Name: "ens1f0", Vendor: "8086", PciAddress: "0000:31:00.0", TotalVfs: 64, LinkSpeed: "25000 Mb/s"},
+			{Name: "ens1f1", Vendor: "8086", PciAddress: "0000:31:00.1", TotalVfs: 64, LinkSpeed: "-1 Mb/s"},
+		}},
+	}
+	r := &SriovNetworkNodePolicyReconciler{FeatureGate: featuregate.New()}
+	g.Expect(r.applyPolicies(npl, node, current, current.DeepCopy(), false)).To(Succeed())
+	g.Expect(current.Spec.Interfaces).To(HaveLen(1))
+	g.Expect(current.Spec.Interfaces[0].PciAddress).To(Equal("0000:31:00.0"))
+
+	// the link of the configured PF goes down, the PF keeps its VFs
+	rendered := current.DeepCopy()
+	rendered.Spec = sriovnetworkv1.SriovNetworkNodeStateSpec{}
+	rendered.Status.Interfaces[0].LinkSpeed = "-1 Mb/s"
+	g.Expect(r.applyPolicies(npl, node, rendered, current, false)).To(Succeed())
+	g.Expect(rendered.Spec.Interfaces).To(Equal(current.Spec.Interfaces))
+
+	// the device plugin keeps exposing the VFs of the PF
+	scheme := runtime.NewScheme()
+	utilruntime.Must(sriovnetworkv1.AddToScheme(scheme))
+	r.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(rendered).Build()
+	resourceList, err := r.renderDevicePluginConfigData(context.TODO(), npl, node)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resourceList.ResourceList).To(HaveLen(1))
+	selectors := dptypes.NetDeviceSelectors{}
+	g.Expect(json.Unmarshal(*resourceList.ResourceList[0].Selectors, &selectors)).To(Succeed())
+	g.Expect(selectors.RootDevices).To(Equal([]string{"0000:31:00.0"}))
+}
+
+func TestRolloutRevisionIncludesOverrides(t *testing.T) {
+	g := NewGomegaWithT(t)
+	npl := &sriovnetworkv1.SriovNetworkNodePolicyList{}
+	npc := &sriovnetworkv1.SriovNetworkPoolConfig{}
+	nl := &corev1.NodeList{Items: []corev1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "node1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "node2"}},
+	}}
+	nodes := []string{"node1"}
+
+	base, err := rolloutRevision(npl, npc, nl, nodes, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	overrides := []sriovnetworkv1.SriovNetworkNodeOverride{{
+		ObjectMeta: metav1.ObjectMeta{Name: "node2"},
+		Spec: sriovnetworkv1.SriovNetworkNodeOverrideSpec{Interfaces: []sriovnetworkv1.InterfaceOverride{
+			{PciAddress: "0000:d8:00.0", Exclude: true}}},
+	}}
+	// the overrides of the nodes out of the pool don't change the revision
+	revision, err := rolloutRevision(npl, npc, nl, nodes, overrides)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(revision).To(Equal(base))
+
+	overrides[0].Name = "node1"
+	revision, err = rolloutRevision(npl, npc, nl, nodes, overrides)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(revision).ToNot(Equal(base))
+
+	overrides[0].Spec.Interfaces[0].Exclude = false
+	overrides[0].Spec.Interfaces[0].NumVfs = ptr.To(4)
+	changed, err := rolloutRevision(npl, npc, nl, nodes, overrides)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(changed).ToNot(Equal(revision))
+}
+
 var _ = Describe("SriovnetworkNodePolicy controller", Ordered, func() {
 	var cancel context.CancelFunc
 	var ctx context.Context
@@ -689,5 +813,750 @@ var _ = Describe("SriovNetworkNodePolicyReconciler", Ordered, func() {
 			Expect(selectors).To(HaveKeyWithValue("resvfiopci", `{"vendors":["8086"],"pfNames":["ens0#0-9"],"IsRdma":false,"NeedVhostNet":false}`))
 			Expect(selectors).To(HaveKeyWithValue("resnetdevice", `{"vendors":["8086"],"pfNames":["ens0#10-19"],"IsRdma":false,"NeedVhostNet":false}`))
 		})
+
+		It("should render the PFs matched on the node as root devices when the nicSelector uses the PF status", func() {
+			node1 := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"node-role.kubernetes.io/worker": ""}}}
+			objs := []k8sclient.Object{
+				node1,
+				&sriovnetworkv1.SriovNetworkNodeState{ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace}, Status: sriovnetworkv1.SriovNetworkNodeStateStatus{
+					Interfaces: sriovnetworkv1.InterfaceExts{
+						{Driver: "ice", DeviceID: "159b", Vendor: "8086", PciAddress: "0000:31:00.0", Name: "ens0", LinkSpeed: "100000 Mb/s", NumaNode: ptr.To(0)},
+						{Driver: "ice", DeviceID: "159b", Vendor: "8086", PciAddress: "0000:31:00.1", Name: "ens1", LinkSpeed: "25000 Mb/s", NumaNode: ptr.To(0)},
+						{Driver: "ice", DeviceID: "159b", Vendor: "8086", PciAddress: "0000:b1:00.0", Name: "ens2", LinkSpeed: "100000 Mb/s", NumaNode: ptr.To(1)},
+					},
+				}},
+			}
+
+			r := &SriovNetworkNodePolicyReconciler{Client: fake.NewClientBuilder().WithObjects(objs...).Build()}
+
+			pl := &sriovnetworkv1.SriovNetworkNodePolicyList{
+				Items: []sriovnetworkv1.SriovNetworkNodePolicy{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "fast-numa0"},
+						Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+							ResourceName: "fastnuma0",
+							NicSelector: sriovnetworkv1.SriovNetworkNicSelector{
+								Vendor:       "8086",
+								MinLinkSpeed: 100000,
+								NumaNodes:    []int{0},
+							},
+							NumVfs:       8,
+							NodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "mellanox"},
+						Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+							ResourceName: "mellanox",
+							NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Drivers: []string{"mlx5_core"}},
+							NumVfs:       8,
+							NodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""},
+						},
+					},
+				},
+			}
+			rcl, err := r.renderDevicePluginConfigData(context.Background(), pl, node1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rcl.ResourceList).To(HaveLen(1))
+			Expect(rcl.ResourceList[0].ResourceName).To(Equal("fastnuma0"))
+			Expect(string(*rcl.ResourceList[0].Selectors)).To(Equal(`{"vendors":["8086"],"rootDevices":["0000:31:00.0"],"IsRdma":false,"NeedVhostNet":false}`))
+		})
+
+		It("should render device plugin config data only for the policies whose nodeAffinity selects the node", func() {
+			node1 := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{
+				"node-role.kubernetes.io/worker": "", "example.com/no-sriov": ""}}}
+			objs := []k8sclient.Object{
+				node1,
+				&sriovnetworkv1.SriovNetworkNodeState{ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace}, Status: sriovnetworkv1.SriovNetworkNodeStateStatus{
+					Interfaces: sriovnetworkv1.InterfaceExts{
+						{Driver: "ice", DeviceID: "159b", Vendor: "8086", PciAddress: "0000:31:00.0", Name: "ens0"},
+					},
+				}},
+			}
+
+			r := &SriovNetworkNodePolicyReconciler{Client: fake.NewClientBuilder().WithObjects(objs...).Build()}
+
+			newPolicy := func(name string, affinity *corev1.NodeSelector) sriovnetworkv1.SriovNetworkNodePolicy {
+				return sriovnetworkv1.SriovNetworkNodePolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+						ResourceName: name,
+						NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "8086"},
+						NumVfs:       8,
+						NodeSelector: map[string]string{},
+						NodeAffinity: affinity,
+					},
+				}
+			}
+			pl := &sriovnetworkv1.SriovNetworkNodePolicyList{
+				Items: []sriovnetworkv1.SriovNetworkNodePolicy{
+					newPolicy("excluded", &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{Key: "node-role.kubernetes.io/worker", Operator: corev1.NodeSelectorOpExists},
+							{Key: "example.com/no-sriov", Operator: corev1.NodeSelectorOpDoesNotExist},
+						},
+					}}}),
+					newPolicy("byname", &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchFields: []corev1.NodeSelectorRequirement{
+							{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"node1"}},
+						},
+					}}}),
+				},
+			}
+			rcl, err := r.renderDevicePluginConfigData(context.Background(), pl, node1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rcl.ResourceList).To(HaveLen(1))
+			Expect(rcl.ResourceList[0].ResourceName).To(Equal("byname"))
+		})
+	})
+
+	Context("syncAllPolicyStatuses", func() {
+		var (
+			ctx                 context.Context
+			nodeList            *corev1.NodeList
+			renderedGenerations map[string]int64
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			renderedGenerations = nil
+			nodeList = &corev1.NodeList{Items: []corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"sriov": "true"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "node2", Labels: map[string]string{"sriov": "true"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "node3"}},
+			}}
+		})
+
+		newNodeState := func(name, syncStatus, lastSyncError string) *sriovnetworkv1.SriovNetworkNodeState {
+			ready := metav1.ConditionFalse
+			if syncStatus == consts.SyncStatusSucceeded {
+				ready = metav1.ConditionTrue
+			}
+			return &sriovnetworkv1.SriovNetworkNodeState{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, Generation: 1},
+				Status: sriovnetworkv1.SriovNetworkNodeStateStatus{
+					Conditions: []metav1.Condition{{Type: consts.ConditionReady, Status: ready, ObservedGeneration: 1,
+						Reason: consts.ConditionReasonSyncSucceeded, LastTransitionTime: metav1.Now()}},
+					Interfaces: sriovnetworkv1.InterfaceExts{
+						{Name: "ens1f0", Vendor: "8086", PciAddress: "0000:31:00.0"},
+						{Name: "ens1f1", Vendor: "8086", PciAddress: "0000:31:00.1"},
+						{Name: "ens2f0", Vendor: "15b3", PciAddress: "0000:ca:00.0"},
+					},
+					SyncStatus:    syncStatus,
+					LastSyncError: lastSyncError,
+				},
+			}
+		}
+
+		runSync := func(objs ...k8sclient.Object) *sriovnetworkv1.SriovNetworkNodePolicy {
+			policy := &sriovnetworkv1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: testNamespace, Generation: 2},
+				Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+					NodeSelector: map[string]string{"sriov": "true"},
+					NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "8086"},
+					NumVfs:       4,
+					ResourceName: "intel",
+				},
+			}
+			objs = append(objs, policy)
+			scheme := runtime.NewScheme()
+			utilruntime.Must(sriovnetworkv1.AddToScheme(scheme))
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objs...).
+				WithStatusSubresource(&sriovnetworkv1.SriovNetworkNodePolicy{}).
+				Build()
+			r := &SriovNetworkNodePolicyReconciler{Client: c}
+			pl := &sriovnetworkv1.SriovNetworkNodePolicyList{}
+			Expect(c.List(ctx, pl)).To(Succeed())
+			Expect(r.syncAllPolicyStatuses(ctx, pl, nodeList, nil, renderedGenerations)).To(Succeed())
+			updated := &sriovnetworkv1.SriovNetworkNodePolicy{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: policy.Name, Namespace: policy.Namespace}, updated)).To(Succeed())
+			return updated
+		}
+
+		It("should report Ready when all the selected nodes are synced", func() {
+			p := runSync(
+				newNodeState("node1", consts.SyncStatusSucceeded, ""),
+				newNodeState("node2", consts.SyncStatusSucceeded, ""),
+				newNodeState("node3", consts.SyncStatusFailed, "not selected"))
+			Expect(p.Status.MatchedNodes).To(Equal(2))
+			Expect(p.Status.FailedNodes).To(BeEmpty())
+			Expect(p.Status.Nodes).To(Equal([]sriovnetworkv1.PolicyNodeStatus{
+				{Name: "node1", MatchedPFs: []string{"0000:31:00.0", "0000:31:00.1"}, SyncStatus: consts.SyncStatusSucceeded},
+				{Name: "node2", MatchedPFs: []string{"0000:31:00.0", "0000:31:00.1"}, SyncStatus: consts.SyncStatusSucceeded},
+			}))
+			Expect(meta.IsStatusConditionTrue(p.Status.Conditions, consts.ConditionReady)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(p.Status.Conditions, consts.ConditionProgressing)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(p.Status.Conditions, consts.ConditionDegraded)).To(BeTrue())
+			Expect(meta.FindStatusCondition(p.Status.Conditions, consts.ConditionReady).ObservedGeneration).To(Equal(int64(2)))
+		})
+
+		It("should report Degraded and the failed nodes when sync failed", func() {
+			p := runSync(
+				newNodeState("node1", consts.SyncStatusFailed, "failed to configure PF"),
+				newNodeState("node2", consts.SyncStatusInProgress, ""))
+			Expect(p.Status.MatchedNodes).To(Equal(2))
+			Expect(p.Status.FailedNodes).To(Equal([]string{"node1"}))
+			Expect(p.Status.Nodes[0].LastSyncError).To(Equal("failed to configure PF"))
+			Expect(meta.IsStatusConditionFalse(p.Status.Conditions, consts.ConditionReady)).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(p.Status.Conditions, consts.ConditionProgressing)).To(BeTrue())
+			degraded := meta.FindStatusCondition(p.Status.Conditions, consts.ConditionDegraded)
+			Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+			Expect(degraded.Reason).To(Equal(consts.ConditionReasonSyncFailed))
+			Expect(degraded.Message).To(ContainSubstring("node1"))
+		})
+
+		It("should report Progressing until the nodes synced the generation rendered from the policy", func() {
+			// the policy change was rendered in the nodeState generation 2 of node1, the daemon
+			// only reported the sync of generation 1
+			renderedGenerations = map[string]int64{"node1": 2, "node2": 1}
+			p := runSync(
+				newNodeState("node1", consts.SyncStatusSucceeded, ""),
+				newNodeState("node2", consts.SyncStatusSucceeded, ""))
+			ready := meta.FindStatusCondition(p.Status.Conditions, consts.ConditionReady)
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal(consts.ConditionReasonSyncInProgress))
+			progressing := meta.FindStatusCondition(p.Status.Conditions, consts.ConditionProgressing)
+			Expect(progressing.Status).To(Equal(metav1.ConditionTrue))
+			Expect(progressing.Message).To(Equal("sync in progress on nodes: node1"))
+
+			// a failure reported for the previous generation is not reported either
+			p = runSync(
+				newNodeState("node1", consts.SyncStatusFailed, "failed to configure PF"),
+				newNodeState("node2", consts.SyncStatusSucceeded, ""))
+			Expect(p.Status.FailedNodes).To(BeEmpty())
+			Expect(meta.IsStatusConditionFalse(p.Status.Conditions, consts.ConditionDegraded)).To(BeTrue())
+		})
+
+		It("should report Progressing for selected nodes without a node state", func() {
+			p := runSync(newNodeState("node1", consts.SyncStatusSucceeded, ""))
+			Expect(p.Status.MatchedNodes).To(Equal(2))
+			Expect(p.Status.Nodes[1]).To(Equal(sriovnetworkv1.PolicyNodeStatus{Name: "node2"}))
+			Expect(meta.IsStatusConditionTrue(p.Status.Conditions, consts.ConditionProgressing)).To(BeTrue())
+			Expect(meta.FindStatusCondition(p.Status.Conditions, consts.ConditionReady).Reason).To(Equal(consts.ConditionReasonSyncInProgress))
+		})
+
+		It("should report the conflicts with the other policies", func() {
+			p2 := &sriovnetworkv1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p2", Namespace: testNamespace},
+				Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+					NodeSelector: map[string]string{"sriov": "true"},
+					NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{PfNames: []string{"ens1f0#2-5"}},
+					NumVfs:       8,
+					ResourceName: "intel_switchdev",
+					EswitchMode:  sriovnetworkv1.ESwithModeSwitchDev,
+					Priority:     10,
+				},
+			}
+			p := runSync(p2,
+				newNodeState("node1", consts.SyncStatusSucceeded, ""),
+				newNodeState("node2", consts.SyncStatusSucceeded, ""))
+			Expect(p.Status.Conflicts).To(HaveLen(2))
+			Expect(p.Status.Conflicts[0].Policy).To(Equal("p2"))
+			Expect(p.Status.Conflicts[0].Reason).To(Equal(consts.PolicyConflictEswitchMode))
+			Expect(p.Status.Conflicts[0].Nodes).To(Equal([]string{"node1", "node2"}))
+			Expect(p.Status.Conflicts[0].Overridden).To(BeFalse())
+			Expect(p.Status.Conflicts[1].Reason).To(Equal(consts.PolicyConflictVfRange))
+			Expect(p.Status.Conflicts[1].Message).To(Equal("VF range 0-3 of PF ens1f0 overlaps VF range 2-5 of policy p2"))
+			Expect(meta.IsStatusConditionTrue(p.Status.Conditions, consts.ConditionReady)).To(BeTrue())
+		})
+
+		It("should report not Ready when no node is selected", func() {
+			nodeList.Items = nodeList.Items[2:]
+			p := runSync()
+			Expect(p.Status.MatchedNodes).To(Equal(0))
+			Expect(meta.FindStatusCondition(p.Status.Conditions, consts.ConditionReady).Reason).To(Equal(consts.ConditionReasonNoMatchingNodes))
+		})
+
+		It("should report the node plans for a dry-run policy", func() {
+			policy := &sriovnetworkv1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: testNamespace, Generation: 1,
+					Annotations: map[string]string{consts.PolicyDryRunAnnotation: "true"}},
+				Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+					NodeSelector: map[string]string{"sriov": "true"},
+					NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "8086"},
+					NumVfs:       4,
+					ResourceName: "intel",
+				},
+			}
+			withPlan := func(ns *sriovnetworkv1.SriovNetworkNodeState, plan *sriovnetworkv1.NodeStatePlan) *sriovnetworkv1.SriovNetworkNodeState {
+				_, err := ns.SetPlannedSpec(&sriovnetworkv1.SriovNetworkNodeStateSpec{})
+				Expect(err).ToNot(HaveOccurred())
+				_, hash, err := ns.GetPlannedSpec()
+				Expect(err).ToNot(HaveOccurred())
+				plan.PlannedSpecHash = hash
+				ns.Status.Plan = plan
+				return ns
+			}
+
+			node1 := withPlan(newNodeState("node1", consts.SyncStatusSucceeded, ""),
+				&sriovnetworkv1.NodeStatePlan{DrainRequired: true, RebootRequired: true,
+					KernelArgsToAdd: []string{consts.KernelArgIntelIommu}})
+			// a plan computed for a previous planned spec is not reported
+			node2 := withPlan(newNodeState("node2", consts.SyncStatusSucceeded, ""), &sriovnetworkv1.NodeStatePlan{})
+			node2.Status.Plan.PlannedSpecHash = "stale"
+
+			status := renderPolicyStatus(policy, &sriovnetworkv1.SriovNetworkNodePolicyList{}, nodeList, map[string]*sriovnetworkv1.SriovNetworkNodeState{
+				"node1": node1, "node2": node2}, nil, nil)
+			Expect(status.MatchedNodes).To(Equal(2))
+			Expect(status.Nodes[0].Plan).To(Equal(node1.Status.Plan))
+			Expect(status.Nodes[1].Plan).To(BeNil())
+			ready := meta.FindStatusCondition(status.Conditions, consts.ConditionReady)
+			Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+			Expect(ready.Reason).To(Equal(consts.ConditionReasonDryRun))
+			progressing := meta.FindStatusCondition(status.Conditions, consts.ConditionProgressing)
+			Expect(progressing.Reason).To(Equal(consts.ConditionReasonPlanInProgress))
+			Expect(progressing.Message).To(ContainSubstring("node2"))
+
+			node2.Status.Plan = nil
+			withPlan(node2, &sriovnetworkv1.NodeStatePlan{Error: "mellanox device detected when in lockdown mode"})
+			status = renderPolicyStatus(policy, &sriovnetworkv1.SriovNetworkNodePolicyList{}, nodeList, map[string]*sriovnetworkv1.SriovNetworkNodeState{
+				"node1": node1, "node2": node2}, nil, nil)
+			Expect(status.FailedNodes).To(Equal([]string{"node2"}))
+			Expect(meta.IsStatusConditionFalse(status.Conditions, consts.ConditionProgressing)).To(BeTrue())
+			Expect(meta.FindStatusCondition(status.Conditions, consts.ConditionDegraded).Reason).To(Equal(consts.ConditionReasonPlanFailed))
+		})
+	})
+
+	Context("applyPolicies", func() {
+		It("should apply dry-run policies only to the planned spec", func() {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"sriov": "true"}}}
+			nodeState := &sriovnetworkv1.SriovNetworkNodeState{
+				ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace},
+				Status: sriovnetworkv1.SriovNetworkNodeStateStatus{
+					Interfaces: sriovnetworkv1.InterfaceExts{
+						{Name: "ens1f0", Vendor: "8086", DeviceID: "158b", PciAddress: "0000:31:00.0", TotalVfs: 64},
+						{Name: "ens2f0", Vendor: "15b3", DeviceID: "101d", PciAddress: "0000:ca:00.0", TotalVfs: 64},
+					},
+				},
+			}
+			npl := &sriovnetworkv1.SriovNetworkNodePolicyList{Items: []sriovnetworkv1.SriovNetworkNodePolicy{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "intel", Namespace: testNamespace},
+					Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+						NodeSelector: map[string]string{"sriov": "true"},
+						NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "8086"},
+						NumVfs:       4,
+						ResourceName: "intel",
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "mlx", Namespace: testNamespace,
+						Annotations: map[string]string{consts.PolicyDryRunAnnotation: "true"}},
+					Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+						NodeSelector: map[string]string{"sriov": "true"},
+						NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "15b3"},
+						NumVfs:       8,
+						ResourceName: "mlx",
+					},
+				},
+			}}
+			r := &SriovNetworkNodePolicyReconciler{FeatureGate: featuregate.New()}
+			Expect(hasDryRunPolicy(npl, node)).To(BeTrue())
+
+			applied := nodeState.DeepCopy()
+			Expect(r.applyPolicies(npl, node, applied, nodeState, false)).To(Succeed())
+			Expect(applied.Spec.Interfaces).To(HaveLen(1))
+			Expect(applied.Spec.Interfaces[0].PciAddress).To(Equal("0000:31:00.0"))
+
+			planned := nodeState.DeepCopy()
+			Expect(r.applyPolicies(npl, node, planned, nodeState, true)).To(Succeed())
+			Expect(planned.Spec.Interfaces).To(HaveLen(2))
+
+			changes := sriovnetworkv1.DiffInterfaces(applied.Spec.Interfaces, planned.Spec.Interfaces)
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].PciAddress).To(Equal("0000:ca:00.0"))
+			Expect(changes[0].Action).To(Equal(consts.InterfaceChangeAdd))
+		})
+	})
+
+	Context("pool rollouts", func() {
+		var (
+			ctx      context.Context
+			nodeList *corev1.NodeList
+			policy   *sriovnetworkv1.SriovNetworkNodePolicy
+			pool     *sriovnetworkv1.SriovNetworkPoolConfig
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			nodeList = &corev1.NodeList{Items: []corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"sriov": "true"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "node2", Labels: map[string]string{"sriov": "true"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "node3", Labels: map[string]string{"sriov": "true"}}},
+			}}
+			policy = &sriovnetworkv1.SriovNetworkNodePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: testNamespace},
+				Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+					NodeSelector: map[string]string{"sriov": "true"},
+					NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "8086"},
+					NumVfs:       4,
+					ResourceName: "intel",
+				},
+			}
+			pool = &sriovnetworkv1.SriovNetworkPoolConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: testNamespace},
+				Spec: sriovnetworkv1.SriovNetworkPoolConfigSpec{
+					NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"sriov": "true"}},
+					Rollout: &sriovnetworkv1.RolloutConfig{
+						CanaryNodes: 1,
+						SoakPeriod:  metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+		})
+
+		newNodeState := func(name string, generation int64, syncStatus string) *sriovnetworkv1.SriovNetworkNodeState {
+			ns := &sriovnetworkv1.SriovNetworkNodeState{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, Generation: generation},
+				Status: sriovnetworkv1.SriovNetworkNodeStateStatus{
+					Interfaces: sriovnetworkv1.InterfaceExts{
+						{Name: "ens1f0", Vendor: "8086", DeviceID: "158b", PciAddress: "0000:31:00.0", TotalVfs: 64},
+					},
+					SyncStatus: syncStatus,
+				},
+			}
+			switch syncStatus {
+			case consts.SyncStatusSucceeded:
+				meta.SetStatusCondition(&ns.Status.Conditions, metav1.Condition{Type: consts.ConditionReady,
+					Status: metav1.ConditionTrue, Reason: consts.ConditionReasonSyncSucceeded, ObservedGeneration: generation})
+			case consts.SyncStatusFailed:
+				meta.SetStatusCondition(&ns.Status.Conditions, metav1.Condition{Type: consts.ConditionReady,
+					Status: metav1.ConditionFalse, Reason: consts.ConditionReasonSyncFailed, ObservedGeneration: generation})
+			}
+			return ns
+		}
+
+		newReconciler := func(objs ...k8sclient.Object) *SriovNetworkNodePolicyReconciler {
+			scheme := runtime.NewScheme()
+			utilruntime.Must(sriovnetworkv1.AddToScheme(scheme))
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objs...).
+				WithStatusSubresource(&sriovnetworkv1.SriovNetworkPoolConfig{}).
+				Build()
+			return &SriovNetworkNodePolicyReconciler{Client: c, Scheme: scheme, FeatureGate: featuregate.New()}
+		}
+
+		policyList := func() *sriovnetworkv1.SriovNetworkNodePolicyList {
+			return &sriovnetworkv1.SriovNetworkNodePolicyList{Items: []sriovnetworkv1.SriovNetworkNodePolicy{*policy}}
+		}
+
+		It("should render the policies only on the canary nodes", func() {
+			r := newReconciler(pool,
+				newNodeState("node1", 1, consts.SyncStatusSucceeded),
+				newNodeState("node2", 1, consts.SyncStatusSucceeded),
+				newNodeState("node3", 1, consts.SyncStatusSucceeded))
+			dc := &sriovnetworkv1.SriovOperatorConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.DefaultConfigName, Namespace: testNamespace}}
+
+			rollouts, requeueAfter, err := r.getPoolRollouts(ctx, policyList(), nodeList)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requeueAfter).To(BeZero())
+			Expect(rollouts.isHeld("node1")).To(BeFalse())
+			Expect(rollouts.isHeld("node2")).To(BeTrue())
+			Expect(rollouts.isHeld("node3")).To(BeTrue())
+
+			for i := range nodeList.Items {
+				node := &nodeList.Items[i]
+				ns := &sriovnetworkv1.SriovNetworkNodeState{ObjectMeta: metav1.ObjectMeta{Name: node.Name, Namespace: testNamespace}}
+				synced, err := r.syncSriovNetworkNodeState(ctx, dc, policyList(), nil, ns, node, rollouts.isHeld(node.Name))
+				Expect(err).ToNot(HaveOccurred())
+				synced.Generation = 2
+				rollouts.recordRendered(synced)
+			}
+			Expect(r.syncPoolRolloutStatuses(ctx, rollouts)).To(Succeed())
+
+			for name, numInterfaces := range map[string]int{"node1": 1, "node2": 0, "node3": 0} {
+				ns := &sriovnetworkv1.SriovNetworkNodeState{}
+				Expect(r.Get(ctx, types.NamespacedName{Name: name, Namespace: testNamespace}, ns)).To(Succeed())
+				Expect(ns.Spec.Interfaces).To(HaveLen(numInterfaces), name)
+			}
+
+			updated := &sriovnetworkv1.SriovNetworkPoolConfig{}
+			Expect(r.Get(ctx, types.NamespacedName{Name: pool.Name, Namespace: testNamespace}, updated)).To(Succeed())
+			Expect(updated.Status.Rollout).ToNot(BeNil())
+			Expect(updated.Status.Rollout.Phase).To(Equal(consts.RolloutPhaseCanary))
+			Expect(updated.Status.Rollout.Revision).ToNot(BeEmpty())
+			Expect(updated.Status.Rollout.CanaryNodes).To(Equal([]sriovnetworkv1.RolloutNode{{Name: "node1", Generation: 2}}))
+		})
+
+		It("should soak and promote the changes once the canary nodes are synced", func() {
+			ro := &poolRollout{pool: pool}
+			now := time.Now()
+			ro.start("rev1", []string{"node1", "node2", "node3"}, now)
+			ro.status.CanaryNodes[0].Generation = 2
+
+			// the daemon didn't report the sync of the rendered generation yet
+			nodeStates := map[string]*sriovnetworkv1.SriovNetworkNodeState{"node1": newNodeState("node1", 1, consts.SyncStatusSucceeded)}
+			Expect(ro.evaluate(nodeStates, now)).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseCanary))
+
+			nodeStates["node1"] = newNodeState("node1", 2, consts.SyncStatusSucceeded)
+			Expect(ro.evaluate(nodeStates, now)).To(Equal(time.Hour))
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseSoaking))
+			Expect(ro.status.CanarySyncedTime).ToNot(BeNil())
+
+			// the soak period restarts when the canary is not synced anymore
+			nodeStates["node1"] = newNodeState("node1", 2, consts.SyncStatusInProgress)
+			Expect(ro.evaluate(nodeStates, now.Add(time.Minute))).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseCanary))
+			Expect(ro.status.CanarySyncedTime).To(BeNil())
+
+			nodeStates["node1"] = newNodeState("node1", 2, consts.SyncStatusSucceeded)
+			Expect(ro.evaluate(nodeStates, now.Add(2*time.Minute))).To(Equal(time.Hour))
+			Expect(ro.evaluate(nodeStates, now.Add(2*time.Minute+time.Hour))).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhasePromoted))
+
+			rollouts := &poolRollouts{byNode: map[string]*poolRollout{"node1": ro, "node2": ro}}
+			Expect(rollouts.isHeld("node2")).To(BeFalse())
+
+			// a new revision starts a new rollout
+			ro.start("rev2", []string{"node1", "node2", "node3"}, now)
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseCanary))
+			Expect(rollouts.isHeld("node2")).To(BeTrue())
+		})
+
+		It("should halt the rollout when a canary node fails", func() {
+			ro := &poolRollout{pool: pool}
+			now := time.Now()
+			ro.start("rev1", []string{"node1", "node2"}, now)
+			ro.status.CanaryNodes[0].Generation = 2
+
+			failed := newNodeState("node1", 2, consts.SyncStatusFailed)
+			failed.Status.LastSyncError = "failed to configure PF"
+			Expect(ro.evaluate(map[string]*sriovnetworkv1.SriovNetworkNodeState{"node1": failed}, now)).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseHalted))
+			Expect(ro.status.Message).To(ContainSubstring("failed to configure PF"))
+
+			ro.start("rev1", []string{"node1", "node2"}, now)
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseHalted))
+			rollouts := &poolRollouts{byNode: map[string]*poolRollout{"node1": ro, "node2": ro}}
+			Expect(rollouts.isHeld("node2")).To(BeTrue())
+
+			status := &sriovnetworkv1.SriovNetworkPoolConfigStatus{}
+			setRolloutConditions(status, ro.status, 1)
+			degraded := meta.FindStatusCondition(status.Conditions, consts.ConditionDegraded)
+			Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+			Expect(degraded.Reason).To(Equal(consts.ConditionReasonRolloutHalted))
+			Expect(degraded.Message).To(ContainSubstring("failed to configure PF"))
+
+			// the rollout resumes once the canary node recovered
+			recovered := map[string]*sriovnetworkv1.SriovNetworkNodeState{"node1": newNodeState("node1", 2, consts.SyncStatusSucceeded)}
+			Expect(ro.evaluate(recovered, now)).To(Equal(time.Hour))
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseSoaking))
+			setRolloutConditions(status, ro.status, 1)
+			Expect(meta.IsStatusConditionFalse(status.Conditions, consts.ConditionDegraded)).To(BeTrue())
+
+			// the condition is removed with the rollout
+			setRolloutConditions(status, nil, 1)
+			Expect(status.Conditions).To(BeEmpty())
+		})
+
+		It("should ignore a failure of the canary node reported for a previous generation", func() {
+			ro := &poolRollout{pool: pool}
+			now := time.Now()
+			ro.start("rev1", []string{"node1", "node2"}, now)
+			ro.status.CanaryNodes[0].Generation = 3
+
+			failed := newNodeState("node1", 3, consts.SyncStatusFailed)
+			meta.FindStatusCondition(failed.Status.Conditions, consts.ConditionReady).ObservedGeneration = 2
+			Expect(ro.evaluate(map[string]*sriovnetworkv1.SriovNetworkNodeState{"node1": failed}, now)).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseCanary))
+
+			failed = newNodeState("node1", 3, consts.SyncStatusFailed)
+			Expect(ro.evaluate(map[string]*sriovnetworkv1.SriovNetworkNodeState{"node1": failed}, now)).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseHalted))
+		})
+
+		It("should halt the rollout when a node fails after the promotion", func() {
+			ro := &poolRollout{pool: pool}
+			now := time.Now()
+			ro.start("rev1", []string{"node1", "node2", "node3"}, now)
+			ro.status.CanaryNodes[0].Generation = 2
+			nodeStates := map[string]*sriovnetworkv1.SriovNetworkNodeState{"node1": newNodeState("node1", 2, consts.SyncStatusSucceeded)}
+			Expect(ro.evaluate(nodeStates, now)).To(Equal(time.Hour))
+			Expect(ro.evaluate(nodeStates, now.Add(time.Hour))).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhasePromoted))
+
+			rollouts := &poolRollouts{byNode: map[string]*poolRollout{"node1": ro, "node2": ro, "node3": ro}}
+			rollouts.recordRendered(newNodeState("node1", 2, consts.SyncStatusSucceeded))
+			rollouts.recordRendered(newNodeState("node2", 5, consts.SyncStatusInProgress))
+			Expect(ro.status.CanaryNodes).To(Equal([]sriovnetworkv1.RolloutNode{{Name: "node1", Generation: 2}}))
+			Expect(ro.status.PromotedNodes).To(Equal([]sriovnetworkv1.RolloutNode{{Name: "node2", Generation: 5}}))
+
+			failed := newNodeState("node2", 5, consts.SyncStatusFailed)
+			failed.Status.LastSyncError = "failed to configure PF"
+			nodeStates["node2"] = failed
+			Expect(ro.evaluate(nodeStates, now.Add(time.Hour))).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseHalted))
+			Expect(ro.status.Message).To(ContainSubstring("node node2 failed to sync: failed to configure PF"))
+			// the node which didn't receive the revision yet keeps its configuration
+			Expect(rollouts.isHeld("node3")).To(BeTrue())
+
+			// the promotion resumes once the node recovered
+			nodeStates["node2"] = newNodeState("node2", 5, consts.SyncStatusSucceeded)
+			Expect(ro.evaluate(nodeStates, now.Add(time.Hour))).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhasePromoted))
+			Expect(rollouts.isHeld("node3")).To(BeFalse())
+
+			// the nodes leaving the pool are dropped
+			ro.start("rev1", []string{"node1", "node3"}, now)
+			Expect(ro.status.PromotedNodes).To(BeEmpty())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhasePromoted))
+		})
+
+		It("should promote a halted rollout with the promote-rollout annotation", func() {
+			ro := &poolRollout{pool: pool.DeepCopy()}
+			now := time.Now()
+			ro.start("rev1", []string{"node1", "node2"}, now)
+			ro.status.CanaryNodes[0].Generation = 2
+			failed := map[string]*sriovnetworkv1.SriovNetworkNodeState{"node1": newNodeState("node1", 2, consts.SyncStatusFailed)}
+			Expect(ro.evaluate(failed, now)).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseHalted))
+
+			// the annotation of another revision is ignored
+			ro.pool.Annotations = map[string]string{consts.PoolPromoteRolloutAnnotation: "rev0"}
+			Expect(ro.evaluate(failed, now)).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseHalted))
+
+			ro.pool.Annotations[consts.PoolPromoteRolloutAnnotation] = "rev1"
+			Expect(ro.evaluate(failed, now)).To(BeZero())
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhasePromoted))
+			rollouts := &poolRollouts{byNode: map[string]*poolRollout{"node1": ro, "node2": ro}}
+			Expect(rollouts.isHeld("node2")).To(BeFalse())
+		})
+
+		It("should replace canary nodes leaving the pool", func() {
+			ro := &poolRollout{pool: pool}
+			now := time.Now()
+			ro.start("rev1", []string{"node1", "node2"}, now)
+			Expect(ro.status.CanaryNodes).To(Equal([]sriovnetworkv1.RolloutNode{{Name: "node1"}}))
+
+			ro.start("rev1", []string{"node2", "node3"}, now)
+			Expect(ro.status.CanaryNodes).To(Equal([]sriovnetworkv1.RolloutNode{{Name: "node2"}}))
+			Expect(ro.status.Phase).To(Equal(consts.RolloutPhaseCanary))
+		})
+	})
+
+	Context("node overrides", func() {
+		It("should merge the override of the node last and record it in the node state", func() {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			utilruntime.Must(sriovnetworkv1.AddToScheme(scheme))
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"sriov": "true"}}}
+			override := &sriovnetworkv1.SriovNetworkNodeOverride{
+				ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace, Generation: 1},
+				Spec: sriovnetworkv1.SriovNetworkNodeOverrideSpec{Interfaces: []sriovnetworkv1.InterfaceOverride{
+					{Name: "ens1f0", NumVfs: ptr.To(2), Mtu: ptr.To(1500)},
+					{Name: "ens2f0", Exclude: true},
+				}},
+			}
+			ns := &sriovnetworkv1.SriovNetworkNodeState{
+				ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace},
+				Status: sriovnetworkv1.SriovNetworkNodeStateStatus{Interfaces: sriovnetworkv1.InterfaceExts{
+					{Name: "ens1f0", Vendor: "8086", DeviceID: "158b", PciAddress: "0000:31:00.0", TotalVfs: 64},
+				}},
+			}
+			policyList := &sriovnetworkv1.SriovNetworkNodePolicyList{Items: []sriovnetworkv1.SriovNetworkNodePolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: testNamespace},
+				Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+					NodeSelector: map[string]string{"sriov": "true"},
+					NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "8086"},
+					NumVfs:       4,
+					Mtu:          9000,
+					ResourceName: "intel",
+				},
+			}}}
+			r := &SriovNetworkNodePolicyReconciler{Client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(ns, override).
+				WithStatusSubresource(&sriovnetworkv1.SriovNetworkNodeOverride{}).
+				Build(), Scheme: scheme, FeatureGate: featuregate.New()}
+			dc := &sriovnetworkv1.SriovOperatorConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.DefaultConfigName, Namespace: testNamespace}}
+
+			synced, err := r.syncSriovNetworkNodeState(ctx, dc, policyList, override,
+				&sriovnetworkv1.SriovNetworkNodeState{ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace}}, node, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(synced.GetAppliedOverride()).To(Equal("node1"))
+			Expect(synced.Spec.Interfaces).To(HaveLen(1))
+			Expect(synced.Spec.Interfaces[0].NumVfs).To(Equal(2))
+			Expect(synced.Spec.Interfaces[0].Mtu).To(Equal(1500))
+			Expect(synced.Spec.Interfaces[0].VfGroups[0].VfRange).To(Equal("0-1"))
+
+			updated := &sriovnetworkv1.SriovNetworkNodeOverride{}
+			Expect(r.Get(ctx, types.NamespacedName{Name: "node1", Namespace: testNamespace}, updated)).To(Succeed())
+			Expect(updated.Status.ObservedGeneration).To(Equal(int64(1)))
+			Expect(updated.Status.AppliedInterfaces).To(Equal([]string{"0000:31:00.0"}))
+			Expect(updated.Status.UnmatchedInterfaces).To(Equal([]string{"ens2f0"}))
+
+			// the annotation is removed with the override
+			synced, err = r.syncSriovNetworkNodeState(ctx, dc, policyList, nil,
+				&sriovnetworkv1.SriovNetworkNodeState{ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace}}, node, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(synced.GetAppliedOverride()).To(BeEmpty())
+			Expect(synced.Spec.Interfaces[0].NumVfs).To(Equal(4))
+		})
+
+		It("should keep the override in the planned spec of a node held by the rollout", func() {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			utilruntime.Must(sriovnetworkv1.AddToScheme(scheme))
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{"sriov": "true"}}}
+			override := &sriovnetworkv1.SriovNetworkNodeOverride{
+				ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace, Generation: 1},
+				Spec: sriovnetworkv1.SriovNetworkNodeOverrideSpec{Interfaces: []sriovnetworkv1.InterfaceOverride{
+					{Name: "ens1f0", NumVfs: ptr.To(2)},
+				}},
+			}
+			ns := &sriovnetworkv1.SriovNetworkNodeState{
+				ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace},
+				Status: sriovnetworkv1.SriovNetworkNodeStateStatus{Interfaces: sriovnetworkv1.InterfaceExts{
+					{Name: "ens1f0", Vendor: "8086", DeviceID: "158b", PciAddress: "0000:31:00.0", TotalVfs: 64},
+					{Name: "ens2f0", Vendor: "15b3", DeviceID: "101d", PciAddress: "0000:32:00.0", TotalVfs: 64},
+				}},
+			}
+			policyList := &sriovnetworkv1.SriovNetworkNodePolicyList{Items: []sriovnetworkv1.SriovNetworkNodePolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: testNamespace},
+				Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+					NodeSelector: map[string]string{"sriov": "true"},
+					NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "8086"},
+					NumVfs:       4,
+					ResourceName: "intel",
+				},
+			}, {
+				ObjectMeta: metav1.ObjectMeta{Name: "p2", Namespace: testNamespace,
+					Annotations: map[string]string{consts.PolicyDryRunAnnotation: "true"}},
+				Spec: sriovnetworkv1.SriovNetworkNodePolicySpec{
+					NodeSelector: map[string]string{"sriov": "true"},
+					NicSelector:  sriovnetworkv1.SriovNetworkNicSelector{Vendor: "15b3"},
+					NumVfs:       4,
+					ResourceName: "mellanox",
+				},
+			}}}
+			r := &SriovNetworkNodePolicyReconciler{Client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(ns, override).
+				WithStatusSubresource(&sriovnetworkv1.SriovNetworkNodeOverride{}).
+				Build(), Scheme: scheme, FeatureGate: featuregate.New()}
+			dc := &sriovnetworkv1.SriovOperatorConfig{ObjectMeta: metav1.ObjectMeta{Name: consts.DefaultConfigName, Namespace: testNamespace}}
+
+			synced, err := r.syncSriovNetworkNodeState(ctx, dc, policyList, override,
+				&sriovnetworkv1.SriovNetworkNodeState{ObjectMeta: metav1.ObjectMeta{Name: "node1", Namespace: testNamespace}}, node, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(synced.GetAppliedOverride()).To(BeEmpty())
+			Expect(synced.Spec.Interfaces).To(BeEmpty())
+
+			planned, _, err := synced.GetPlannedSpec()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(planned.Interfaces).To(HaveLen(2))
+			Expect(planned.Interfaces[0].NumVfs).To(Equal(2))
+			Expect(planned.Interfaces[1].NumVfs).To(Equal(4))
+
+			// the status of the override is reported once it reaches the node
+			updated := &sriovnetworkv1.SriovNetworkNodeOverride{}
+			Expect(r.Get(ctx, types.NamespacedName{Name: "node1", Namespace: testNamespace}, updated)).To(Succeed())
+			Expect(updated.Status.ObservedGeneration).To(BeZero())
+		})
 	})
 })
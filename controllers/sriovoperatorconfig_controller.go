@@ -146,6 +146,10 @@ func (r *SriovOperatorConfigReconciler) Reconcile(ctx context.Context, req ctrl.
 		return reconcile.Result{}, err
 	}
 
+	if err = r.syncConfigDaemonMetrics(ctx, defaultConfig); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// For Openshift we need to create the systemd files using a machine config
 	if r.Orchestrator.ClusterType() == consts.ClusterTypeOpenshift {
 		// TODO: add support for hypershift as today there is no MCO on hypershift clusters
@@ -205,6 +209,11 @@ func (r *SriovOperatorConfigReconciler) syncConfigDaemonSet(ctx context.Context,
 	}
 	data.Data["ParallelNicConfig"] = r.FeatureGate.IsEnabled(consts.ParallelNicConfigFeatureGate)
 	data.Data["ManageSoftwareBridges"] = r.FeatureGate.IsEnabled(consts.ManageSoftwareBridgesFeatureGate)
+	data.Data["ConfigDaemonMetrics"] = r.FeatureGate.IsEnabled(consts.ConfigDaemonMetricsFeatureGate)
+	data.Data["ConfigDaemonMetricsPort"] = consts.ConfigDaemonMetricsPort
+	if r.FeatureGate.IsEnabled(consts.ExternalVendorPluginsFeatureGate) {
+		data.Data["VendorPluginsDir"] = consts.Host + consts.VendorPluginsHostDir
+	}
 
 	envCniBinPath := os.Getenv("SRIOV_CNI_BIN_PATH")
 	if envCniBinPath == "" {
@@ -294,6 +303,40 @@ func (r *SriovOperatorConfigReconciler) syncMetricsExporter(ctx context.Context,
 	return nil
 }
 
+// syncConfigDaemonMetrics syncs the service exposing the metrics of the config daemons,
+// and the ServiceMonitor scraping them when the prometheus operator is installed
+func (r *SriovOperatorConfigReconciler) syncConfigDaemonMetrics(ctx context.Context, dc *sriovnetworkv1.SriovOperatorConfig) error {
+	logger := log.Log.WithName("syncConfigDaemonMetrics")
+	logger.V(1).Info("Start to sync config daemon metrics")
+
+	data := render.MakeRenderData()
+	data.Data["Namespace"] = vars.Namespace
+	data.Data["ConfigDaemonMetricsPort"] = consts.ConfigDaemonMetricsPort
+	data.Data["IsOpenshift"] = r.Orchestrator.ClusterType() == consts.ClusterTypeOpenshift
+	data.Data["IsPrometheusOperatorInstalled"] = strings.ToLower(os.Getenv("METRICS_EXPORTER_PROMETHEUS_OPERATOR_ENABLED")) == trueString
+	data.Data["PrometheusOperatorServiceAccount"] = os.Getenv("METRICS_EXPORTER_PROMETHEUS_OPERATOR_SERVICE_ACCOUNT")
+	data.Data["PrometheusOperatorNamespace"] = os.Getenv("METRICS_EXPORTER_PROMETHEUS_OPERATOR_NAMESPACE")
+
+	objs, err := render.RenderDir(consts.ConfigDaemonMetricsPath, &data)
+	if err != nil {
+		logger.Error(err, "Fail to render config daemon metrics manifests")
+		return err
+	}
+
+	if r.FeatureGate.IsEnabled(consts.ConfigDaemonMetricsFeatureGate) {
+		for _, obj := range objs {
+			err = r.syncK8sResource(ctx, dc, obj)
+			if err != nil {
+				logger.Error(err, "Couldn't sync config daemon metrics objects")
+				return err
+			}
+		}
+		return nil
+	}
+
+	return r.deleteK8sResources(ctx, objs)
+}
+
 func (r *SriovOperatorConfigReconciler) syncWebhookObjs(ctx context.Context, dc *sriovnetworkv1.SriovOperatorConfig) error {
 	logger := log.Log.WithName("syncWebhookObjs")
 	logger.V(1).Info("Start to sync webhook objects")
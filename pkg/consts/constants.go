@@ -38,11 +38,15 @@ const (
 	DaemonRequeueTime          = 30 * time.Second
 	DrainControllerRequeueTime = 5 * time.Second
 
+	VendorPluginsDefaultTimeout = 5 * time.Minute
+	VendorPluginsHostDir        = "/var/lib/sriov/vendor-plugins"
+
 	DefaultConfigName                  = "default"
 	ConfigDaemonPath                   = "./bindata/manifests/daemon"
 	InjectorWebHookPath                = "./bindata/manifests/webhook"
 	OperatorWebHookPath                = "./bindata/manifests/operator-webhook"
 	MetricsExporterPath                = "./bindata/manifests/metrics-exporter"
+	ConfigDaemonMetricsPath            = "./bindata/manifests/daemon-metrics"
 	SystemdServiceOcpPath              = "./bindata/manifests/sriov-config-service/openshift"
 	SystemdServiceOcpMachineConfigName = "sriov-config-service"
 	ServiceCAConfigMapAnnotation       = "service.beta.openshift.io/inject-cabundle"
@@ -84,6 +88,9 @@ const (
 	SriovSwitchDevConfPath     = SriovConfBasePath + "/sriov_config.json"
 	SriovHostSwitchDevConfPath = Host + SriovSwitchDevConfPath
 	ManagedOVSBridgesPath      = SriovConfBasePath + "/managed-ovs-bridges.json"
+	OVSFlowsDirPath            = SriovConfBasePath + "/ovs-flows"
+	KnownGoodNodeStatePath     = SriovConfBasePath + "/known-good-node-state.json"
+	SyncFailuresPath           = SriovConfBasePath + "/sync-failures.json"
 
 	MachineConfigPoolPausedAnnotation       = "sriovnetwork.openshift.io/state"
 	MachineConfigPoolPausedAnnotationIdle   = "Idle"
@@ -104,9 +111,91 @@ const (
 	Draining                           = "Draining"
 	DrainComplete                      = "DrainComplete"
 
-	SyncStatusSucceeded  = "Succeeded"
-	SyncStatusFailed     = "Failed"
-	SyncStatusInProgress = "InProgress"
+	SyncStatusSucceeded                   = "Succeeded"
+	SyncStatusFailed                      = "Failed"
+	SyncStatusInProgress                  = "InProgress"
+	SyncStatusWaitingForMaintenanceWindow = "WaitingForMaintenanceWindow"
+	SyncStatusPaused                      = "Paused"
+
+	// ConditionReady, ConditionProgressing and ConditionDegraded are the standard condition types
+	// reported in the status of the operator's custom resources
+	ConditionReady       = "Ready"
+	ConditionProgressing = "Progressing"
+	ConditionDegraded    = "Degraded"
+
+	ConditionReasonNoMatchingNodes = "NoMatchingNodes"
+	ConditionReasonSyncSucceeded   = "SyncSucceeded"
+	ConditionReasonSyncInProgress  = "SyncInProgress"
+	ConditionReasonSyncFailed      = "SyncFailed"
+	ConditionReasonInterfaceFailed = "InterfaceSyncFailed"
+	ConditionReasonDryRun          = "DryRun"
+	ConditionReasonPlanInProgress  = "PlanInProgress"
+	ConditionReasonPlanReady       = "PlanReady"
+	ConditionReasonPlanFailed      = "PlanFailed"
+
+	ConditionReasonWaitingForMaintenanceWindow = "WaitingForMaintenanceWindow"
+	ConditionReasonPaused                      = "Paused"
+	ConditionReasonRolledBack                  = "RolledBack"
+	ConditionReasonRolloutHalted               = "RolloutHalted"
+	ConditionReasonRolloutHealthy              = "RolloutHealthy"
+
+	// reasons of the conditions reported in the SriovNetwork, SriovIBNetwork and OVSNetwork status
+	ConditionReasonNetAttDefReady          = "NetworkAttachmentDefinitionReady"
+	ConditionReasonNetAttDefRenderFailed   = "RenderFailed"
+	ConditionReasonNetAttDefApplyFailed    = "ApplyFailed"
+	ConditionReasonNetAttDefConflict       = "NetworkAttachmentDefinitionConflict"
+	ConditionReasonNamespaceNotFound       = "TargetNamespaceNotFound"
+	ConditionReasonInvalidNetworkNamespace = "InvalidNetworkNamespace"
+
+	// ConditionDrain mirrors the drain state of the node in the SriovNetworkNodeState status
+	ConditionDrain = "Drain"
+
+	ConditionReasonDrainIdle                = "Idle"
+	ConditionReasonDrainRequired            = "DrainRequired"
+	ConditionReasonRebootRequired           = "RebootRequired"
+	ConditionReasonDraining                 = "Draining"
+	ConditionReasonDrainComplete            = "DrainComplete"
+	ConditionReasonDrainFailed              = "DrainFailed"
+	ConditionReasonWaitingForMaxUnavailable = "WaitingForMaxUnavailable"
+
+	// reasons of the conflicts between policies reported in the SriovNetworkNodePolicy status
+	PolicyConflictVfRange      = "VfRangeOverlap"
+	PolicyConflictResourceName = "ResourceNameCollision"
+	PolicyConflictMtu          = "MtuMismatch"
+	PolicyConflictLinkType     = "LinkTypeMismatch"
+	PolicyConflictEswitchMode  = "EswitchModeMismatch"
+
+	// types of the additional ports of the managed OVS bridges
+	OVSPortTypePatch    = "patch"
+	OVSPortTypeInternal = "internal"
+	// cookie of the OpenFlow rules installed by the operator on the managed OVS bridges,
+	// only flows with this cookie are updated or removed by the operator
+	OVSManagedFlowsCookie = "0x5352494f56"
+
+	RolloutPhaseCanary   = "Canary"
+	RolloutPhaseSoaking  = "Soaking"
+	RolloutPhasePromoted = "Promoted"
+	RolloutPhaseHalted   = "Halted"
+
+	InterfaceChangeAdd    = "Add"
+	InterfaceChangeUpdate = "Update"
+	InterfaceChangeRemove = "Remove"
+
+	InterfaceSyncPhaseSucceeded = "Succeeded"
+	InterfaceSyncPhaseFailed    = "Failed"
+	InterfaceSyncPhasePending   = "Pending"
+
+	// reason codes reported in the per interface sync results of the SriovNetworkNodeState
+	InterfaceSyncReasonConfigured           = "Configured"
+	InterfaceSyncReasonNotApplied           = "NotApplied"
+	InterfaceSyncReasonApplyFailed          = "ApplyFailed"
+	InterfaceSyncReasonPFConfigFailed       = "PFConfigFailed"
+	InterfaceSyncReasonVFConfigFailed       = "VFConfigFailed"
+	InterfaceSyncReasonExternallyManagedPF  = "ExternallyManagedPFMismatch"
+	InterfaceSyncReasonLinkSetupFailed      = "LinkSetupFailed"
+	InterfaceSyncReasonResetFailed          = "ResetFailed"
+	InterfaceSyncReasonFirmwareConfigFailed = "FirmwareConfigFailed"
+	InterfaceSyncReasonFirmwareResetFailed  = "FirmwareResetFailed"
 
 	DrainDeleted = "Deleted"
 	DrainDelete  = "delete"
@@ -121,6 +210,21 @@ const (
 
 	OwnerRefAnnotation = "sriovnetwork.openshift.io/owner-ref"
 
+	// PolicyDryRunAnnotation marks a SriovNetworkNodePolicy as dry-run when set to "true".
+	// The policy is not applied to the nodes, the changes it would do are reported in its status instead.
+	PolicyDryRunAnnotation = "sriovnetwork.openshift.io/dry-run"
+	// NodeStatePlannedSpecAnnotation contains the SriovNetworkNodeState spec rendered with the dry-run policies.
+	// The config daemon evaluates it and reports the result in the plan field of the status.
+	NodeStatePlannedSpecAnnotation = "sriovnetwork.openshift.io/planned-spec"
+	// NodeStatePausedAnnotation pauses the configuration of the node when set to "true" on the SriovNetworkNodeState.
+	// The config daemon keeps reporting the status but doesn't apply, drain or reboot the node.
+	NodeStatePausedAnnotation = "sriovnetwork.openshift.io/paused"
+	// PoolPromoteRolloutAnnotation promotes the rollout of a SriovNetworkPoolConfig to all the nodes of the pool
+	// when set to the revision reported in the rollout status, e.g. to resume a rollout halted by a failed canary node.
+	PoolPromoteRolloutAnnotation = "sriovnetwork.openshift.io/promote-rollout"
+	// NodeStateAppliedOverrideAnnotation contains the name of the SriovNetworkNodeOverride applied to the SriovNetworkNodeState spec.
+	NodeStateAppliedOverrideAnnotation = "sriovnetwork.openshift.io/applied-override"
+
 	// NodeStateKeepUntilAnnotation contains name of the "keep until time" annotation for SriovNetworkNodeState object.
 	// The "keep until time" specifies the earliest time at which the state object can be removed
 	// if the daemon's pod is not found on the node.
@@ -136,6 +240,7 @@ const (
 	SysBusPciDevices      = SysBus + "/pci/devices"
 	SysBusPciDrivers      = SysBus + "/pci/drivers"
 	SysBusPciDriversProbe = SysBus + "/pci/drivers_probe"
+	SysBusPciSlots        = SysBus + "/pci/slots"
 	SysClassNet           = "/sys/class/net"
 	ProcKernelCmdLine     = "/proc/cmdline"
 	NetClass              = 0x02
@@ -143,6 +248,16 @@ const (
 	BusPci                = "pci"
 	BusVdpa               = "vdpa"
 
+	DevlinkParamCmodeRuntime    = "runtime"
+	DevlinkParamCmodeDriverinit = "driverinit"
+	DevlinkParamCmodePermanent  = "permanent"
+
+	// devlink parameter of the ice driver set from the MSI-X vectors of the Intel settings of the PF
+	DevlinkParamIntelMsixVecPerPfMax = "msix_vec_per_pf_max"
+
+	BlueFieldModeDPU = "dpu"
+	BlueFieldModeNIC = "nic"
+
 	UdevFolder          = "/etc/udev"
 	HostUdevFolder      = Host + UdevFolder
 	UdevRulesFolder     = UdevFolder + "/rules.d"
@@ -197,6 +312,15 @@ const (
 	// MellanoxFirmwareResetFeatureGate: enables the firmware reset via mstfwreset before a reboot
 	MellanoxFirmwareResetFeatureGate = "mellanoxFirmwareReset"
 
+	// ConfigDaemonMetricsFeatureGate: expose the prometheus metrics of the config-daemon on ConfigDaemonMetricsPort
+	ConfigDaemonMetricsFeatureGate = "configDaemonMetrics"
+
+	// ExternalVendorPluginsFeatureGate: load the external vendor plugins listening on the sockets of VendorPluginsHostDir
+	ExternalVendorPluginsFeatureGate = "externalVendorPlugins"
+
+	// ConfigDaemonMetricsPort is the host port the config-daemon serves its metrics on when enabled
+	ConfigDaemonMetricsPort = 9112
+
 	// The path to the file on the host filesystem that contains the IB GUID distribution for IB VFs
 	InfinibandGUIDConfigFilePath = SriovConfBasePath + "/infiniband/guids"
 )
@@ -64,6 +64,11 @@ func (oc *OperatorConfigNodeReconcile) Reconcile(ctx context.Context, req ctrl.R
 		log.Log.Info("Set Disable Drain", "value", vars.DisableDrain)
 	}
 
+	if vars.AutoRollbackAfterFailures != operatorConfig.Spec.AutoRollbackAfterFailures {
+		vars.AutoRollbackAfterFailures = operatorConfig.Spec.AutoRollbackAfterFailures
+		log.Log.Info("Set auto rollback after failures", "value", vars.AutoRollbackAfterFailures)
+	}
+
 	if !equality.Semantic.DeepEqual(oc.latestFeatureGates, operatorConfig.Spec.FeatureGates) {
 		vars.FeatureGate.Init(operatorConfig.Spec.FeatureGates)
 		oc.latestFeatureGates = operatorConfig.Spec.FeatureGates
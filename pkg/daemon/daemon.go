@@ -19,6 +19,7 @@ package daemon
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -57,8 +58,12 @@ type NodeReconciler struct {
 
 	additionalPlugins []plugin.VendorPlugin
 	mainPlugin        plugin.VendorPlugin
+	disabledPlugins   []string
 
 	lastAppliedGeneration int64
+
+	// time of the drain request of the node, used to report the drain wait time
+	drainRequestedTime time.Time
 }
 
 // New creates a new instance of NodeReconciler.
@@ -142,16 +147,19 @@ func (dn *NodeReconciler) Init(disabledPlugins []string) error {
 // 2. Checks if the object has the required drain controller annotations for the current generation.
 // 3. Updates the nodeState Status object with the existing network state (interfaces, bridges, and RDMA status).
 // 4. If running in systemd mode, checks the sriov result from the config-daemon that runs in systemd.
-// 5. Compares the latest generation with the last applied generation to determine if a refresh on NICs is needed.
-// 6. Checks for drift between the host state and the nodeState status.
-// 7. Updates the sync state of the nodeState object as per the current requirements.
-// 8. Determines if a drain is required based on the current state of the nodeState.
-// 9. Handles the drain if necessary, ensuring that it does not conflict with other drain requests.
-// 10. Applies the changes to the nodeState if there are no issues and updates the sync status accordingly.
-// 11. If a reboot is required after applying the changes, returns a result to trigger a reboot.
+// 5. If the nodeState is paused, only publishes the status with the Paused sync status.
+// 6. If the generation was rolled back, replaces the spec with the last known-good spec.
+// 7. Compares the latest generation with the last applied generation to determine if a refresh on NICs is needed.
+// 8. Checks for drift between the host state and the nodeState status.
+// 9. Updates the sync state of the nodeState object as per the current requirements.
+// 10. Determines if a drain is required based on the current state of the nodeState.
+// 11. Handles the drain if necessary, ensuring that it does not conflict with other drain requests.
+// 12. Applies the changes to the nodeState if there are no issues and updates the sync status accordingly.
+// 13. If a reboot is required after applying the changes, returns a result to trigger a reboot.
 //
 // Returns a Result indicating whether or not the controller should requeue the request for further processing.
 func (dn *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	defer observeReconcile(time.Now())
 	reqLogger := log.FromContext(ctx).WithName("Reconcile")
 	// Get the latest NodeState
 	desiredNodeState := &sriovnetworkv1.SriovNetworkNodeState{}
@@ -209,8 +217,28 @@ func (dn *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, nil
 	}
 
+	// the external vendor plugins started after the daemon get the current spec with a full sync
+	if dn.loadNewExternalPlugins() {
+		dn.lastAppliedGeneration = 0
+	}
+
+	// evaluate the spec rendered by the operator with the dry-run policies
+	dn.updatePlan(desiredNodeState)
+
+	// a paused node only reports its status
+	if desiredNodeState.IsPaused() {
+		return dn.reconcilePaused(ctx, current, desiredNodeState)
+	}
+
+	// a generation that failed to sync too many times is replaced by the last known-good configuration
+	if err = dn.applyRollbackSpec(desiredNodeState); err != nil {
+		reqLogger.Error(err, "failed to apply the known-good spec")
+		return ctrl.Result{}, err
+	}
+
 	// if we are on the latest generation make a refresh on the nics
-	if dn.lastAppliedGeneration == latest {
+	// a node that was paused goes through a full sync to apply the changes skipped during the pause
+	if dn.lastAppliedGeneration == latest && desiredNodeState.Status.SyncStatus != consts.SyncStatusPaused {
 		isDrifted, err := dn.checkHostStateDrift(ctx, desiredNodeState)
 		if err != nil {
 			reqLogger.Error(err, "failed to refresh host state")
@@ -234,16 +262,9 @@ func (dn *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		}
 	}
 
-	// set sync state to inProgress, but we don't clear the failed status
-	err = dn.updateSyncState(ctx, desiredNodeState, consts.SyncStatusInProgress, desiredNodeState.Status.LastSyncError)
+	reqReboot, reqDrain, drainReasons, err := dn.checkOnNodeStateChange(desiredNodeState)
 	if err != nil {
-		reqLogger.Error(err, "failed to update sync status to inProgress")
-		return ctrl.Result{}, err
-	}
-
-	reqReboot, reqDrain, err := dn.checkOnNodeStateChange(desiredNodeState)
-	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, dn.reportSyncFailure(ctx, desiredNodeState, err)
 	}
 
 	if vars.UsingSystemdMode {
@@ -255,6 +276,12 @@ func (dn *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 			reqLogger.Error(err, "failed to write systemd config file")
 			return ctrl.Result{}, err
 		}
+		if systemdConfModified {
+			drainReasons = append(drainReasons, "systemd configuration file changed")
+		}
+		if !sriovResultExists {
+			drainReasons = append(drainReasons, "systemd result file not found")
+		}
 		reqDrain = reqDrain || systemdConfModified || !sriovResultExists
 		// require reboot if drain needed for systemd mode
 		reqReboot = reqReboot || reqDrain
@@ -263,10 +290,31 @@ func (dn *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	reqLogger.V(0).Info("aggregated daemon node state requirement",
 		"drain-required", reqDrain, "reboot-required", reqReboot, "disable-drain", vars.DisableDrain)
 
+	// disruptive work can only start inside a maintenance window of the node pool
+	if (reqDrain || reqReboot) &&
+		utils.ObjectHasAnnotation(desiredNodeState, consts.NodeStateDrainAnnotationCurrent, consts.DrainIdle) {
+		inWindow, requeueAfter, err := dn.checkMaintenanceWindow(ctx, desiredNodeState)
+		if err != nil {
+			reqLogger.Error(err, "failed to check maintenance windows")
+			return ctrl.Result{}, err
+		}
+		if !inWindow {
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+	}
+
+	// set sync state to inProgress, but we don't clear the failed status
+	// this is done after the maintenance window check so a node waiting for a window gets a single status update
+	err = dn.updateSyncState(ctx, desiredNodeState, consts.SyncStatusInProgress, desiredNodeState.Status.LastSyncError)
+	if err != nil {
+		reqLogger.Error(err, "failed to update sync status to inProgress")
+		return ctrl.Result{}, err
+	}
+
 	// handle drain only if the plugins request drain, or we are already in a draining request state
 	if reqDrain ||
 		!utils.ObjectHasAnnotation(desiredNodeState, consts.NodeStateDrainAnnotationCurrent, consts.DrainIdle) {
-		drainInProcess, err := dn.handleDrain(ctx, desiredNodeState, reqReboot)
+		drainInProcess, err := dn.handleDrain(ctx, desiredNodeState, reqReboot, strings.Join(drainReasons, ", "))
 		if err != nil {
 			reqLogger.Error(err, "failed to handle drain")
 			return ctrl.Result{}, err
@@ -289,28 +337,57 @@ func (dn *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	return ctrl.Result{}, nil
 }
 
+// reconcilePaused publishes the host status of a paused node without applying the desired configuration.
+// A pending drain or reboot request is reset to idle, so the drain controller uncordons the node and releases its
+// drain slot, the request is sent again once the pause is removed.
+func (dn *NodeReconciler) reconcilePaused(ctx context.Context, current, desiredNodeState *sriovnetworkv1.SriovNetworkNodeState) (ctrl.Result, error) {
+	reqLogger := log.FromContext(ctx).WithName("reconcilePaused")
+	if !utils.ObjectHasAnnotation(desiredNodeState, consts.NodeStateDrainAnnotation, consts.DrainIdle) {
+		reqLogger.Info("node configuration is paused, resetting the drain request to idle")
+		if err := dn.annotate(ctx, desiredNodeState, consts.DrainIdle); err != nil {
+			reqLogger.Error(err, "failed to request annotation update to idle")
+			return ctrl.Result{}, err
+		}
+		dn.drainRequestedTime = time.Time{}
+		dn.eventRecorder.SendNodeEvent(ctx, "DrainRequestCleared", "node configuration is paused, the node can return to idle")
+	}
+	if desiredNodeState.Status.SyncStatus != consts.SyncStatusPaused || dn.shouldUpdateStatus(current, desiredNodeState) {
+		reqLogger.Info("node configuration is paused, updating nodeState status")
+		err := dn.updateSyncState(ctx, desiredNodeState, consts.SyncStatusPaused, desiredNodeState.Status.LastSyncError)
+		if err != nil {
+			reqLogger.Error(err, "failed to update nodeState status")
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: consts.DaemonRequeueTime}, nil
+}
+
 // checkOnNodeStateChange checks the state change required for the node based on the desired SriovNetworkNodeState.
 // The function iterates over all loaded plugins and calls their OnNodeStateChange method with the desired state.
-// It returns two boolean values indicating whether a reboot or drain operation is required.
-func (dn *NodeReconciler) checkOnNodeStateChange(desiredNodeState *sriovnetworkv1.SriovNetworkNodeState) (bool, bool, error) {
+// It returns two boolean values indicating whether a reboot or drain operation is required,
+// and the plugins that requested it.
+func (dn *NodeReconciler) checkOnNodeStateChange(desiredNodeState *sriovnetworkv1.SriovNetworkNodeState) (bool, bool, []string, error) {
 	funcLog := log.Log.WithName("checkOnNodeStateChange")
 	// Check the main plugin for changes
 	reqDrain, reqReboot, err := dn.mainPlugin.OnNodeStateChange(desiredNodeState)
 	if err != nil {
-		funcLog.Error(err, "OnNodeStateChange plugin error", "mainPluginName", dn.mainPlugin.Name)
-		return false, false, err
+		funcLog.Error(err, "OnNodeStateChange plugin error", "mainPluginName", dn.mainPlugin.Name())
+		recordPluginFailure(dn.mainPlugin.Name(), pluginOperationOnNodeStateChange)
+		return false, false, nil, err
 	}
 	funcLog.V(0).Info("OnNodeStateChange result",
 		"main plugin name", dn.mainPlugin.Name(),
 		"drain-required", reqDrain,
 		"reboot-required", reqReboot)
+	reasons := appendDrainReason(nil, dn.mainPlugin.Name(), reqDrain, reqReboot)
 
 	// check if any of the plugins required to drain or reboot the node
 	for _, p := range dn.additionalPlugins {
 		d, r, err := p.OnNodeStateChange(desiredNodeState)
 		if err != nil {
 			funcLog.Error(err, "OnNodeStateChange plugin error", "pluginName", p.Name())
-			return false, false, err
+			recordPluginFailure(p.Name(), pluginOperationOnNodeStateChange)
+			return false, false, nil, err
 		}
 		funcLog.V(0).Info("OnNodeStateChange result",
 			"pluginName", p.Name(),
@@ -318,9 +395,21 @@ func (dn *NodeReconciler) checkOnNodeStateChange(desiredNodeState *sriovnetworkv
 			"reboot-required", r)
 		reqDrain = reqDrain || d
 		reqReboot = reqReboot || r
+		reasons = appendDrainReason(reasons, p.Name(), d, r)
 	}
 
-	return reqReboot, reqDrain, nil
+	return reqReboot, reqDrain, reasons, nil
+}
+
+// appendDrainReason adds the drain or reboot request of a plugin to the reasons reported with the drain request
+func appendDrainReason(reasons []string, pluginName string, reqDrain, reqReboot bool) []string {
+	if reqReboot {
+		return append(reasons, fmt.Sprintf("plugin %s requested a reboot", pluginName))
+	}
+	if reqDrain {
+		return append(reasons, fmt.Sprintf("plugin %s requested a drain", pluginName))
+	}
+	return reasons
 }
 
 // checkSystemdStatus Checks the status of systemd services on the host node.
@@ -374,27 +463,36 @@ func (dn *NodeReconciler) apply(ctx context.Context, desiredNodeState *sriovnetw
 	reqLogger := log.FromContext(ctx).WithName("Apply")
 	// apply the additional plugins after we are done with drain if needed
 	for _, p := range dn.additionalPlugins {
+		start := time.Now()
 		err := p.Apply()
+		observePluginApply(p.Name(), start, err)
 		if err != nil {
 			reqLogger.Error(err, "plugin Apply failed", "plugin-name", p.Name())
-			return ctrl.Result{}, err
+			return ctrl.Result{}, dn.reportSyncFailure(ctx, desiredNodeState, err)
 		}
 	}
 
 	// if we don't need to reboot, or we are not doing the configuration in systemd
 	// we apply the main plugin
 	if !reqReboot && !vars.UsingSystemdMode && dn.mainPlugin != nil {
+		start := time.Now()
 		err := dn.mainPlugin.Apply()
+		observePluginApply(dn.mainPlugin.Name(), start, err)
 		if err != nil {
 			reqLogger.Error(err, "plugin Apply failed", "plugin-name", dn.mainPlugin.Name())
-			return ctrl.Result{}, err
+			return ctrl.Result{}, dn.reportSyncFailure(ctx, desiredNodeState, err)
 		}
 	}
 
 	if reqReboot {
 		reqLogger.Info("reboot node")
 		dn.eventRecorder.SendEvent(ctx, "RebootNode", "Reboot node has been initiated")
-		return ctrl.Result{}, dn.rebootNode()
+		// counted before the reboot, the daemon may not get the chance to do it after
+		rebootsTotal.Inc()
+		if err := dn.rebootNode(); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
 	}
 
 	if err := dn.restartDevicePluginPod(ctx); err != nil {
@@ -402,18 +500,24 @@ func (dn *NodeReconciler) apply(ctx context.Context, desiredNodeState *sriovnetw
 		return ctrl.Result{}, err
 	}
 
+	drainRequested := !utils.ObjectHasAnnotation(desiredNodeState, consts.NodeStateDrainAnnotation, consts.DrainIdle)
 	err := dn.annotate(ctx, desiredNodeState, consts.DrainIdle)
 	if err != nil {
 		reqLogger.Error(err, "failed to request annotation update to idle")
 		return ctrl.Result{}, err
 	}
+	if drainRequested {
+		dn.eventRecorder.SendNodeEvent(ctx, "DrainRequestCleared", "configuration applied, the node can return to idle")
+	}
 
 	reqLogger.Info("sync succeeded")
 	syncStatus := consts.SyncStatusSucceeded
 	lastSyncError := ""
+	var failedInterfaces []sriovnetworkv1.InterfaceSyncResult
 	if vars.UsingSystemdMode {
 		syncStatus = sriovResult.SyncStatus
 		lastSyncError = sriovResult.LastSyncError
+		failedInterfaces = sriovResult.FailedInterfaces
 	}
 
 	// Update the nodeState Status object with the existing network interfaces
@@ -423,6 +527,16 @@ func (dn *NodeReconciler) apply(ctx context.Context, desiredNodeState *sriovnetw
 		return ctrl.Result{}, err
 	}
 
+	if syncStatus == consts.SyncStatusSucceeded {
+		if rollback := desiredNodeState.Status.Rollback; rollback != nil {
+			// the known-good configuration is applied, the desired generation is still reported as failed
+			syncStatus, lastSyncError = consts.SyncStatusFailed, rollbackMessage(rollback)
+		} else if err := dn.hostHelpers.SaveKnownGoodNodeState(desiredNodeState); err != nil {
+			reqLogger.Error(err, "failed to save the known-good nodeState")
+		}
+	}
+
+	setInterfaceSyncResults(desiredNodeState, failedInterfaces, syncStatus == consts.SyncStatusSucceeded || desiredNodeState.Status.Rollback != nil)
 	err = dn.updateSyncState(ctx, desiredNodeState, syncStatus, lastSyncError)
 	if err != nil {
 		reqLogger.Error(err, "failed to update sync status")
@@ -434,6 +548,26 @@ func (dn *NodeReconciler) apply(ctx context.Context, desiredNodeState *sriovnetw
 	return ctrl.Result{RequeueAfter: consts.DaemonRequeueTime}, nil
 }
 
+// reportSyncFailure publishes the failed sync status together with the per interface results
+// extracted from the plugin error, for the failures of the plugins OnNodeStateChange and Apply.
+// The original error is returned to requeue the request,
+// the next attempt applies the known-good configuration if the failure started a rollback.
+func (dn *NodeReconciler) reportSyncFailure(ctx context.Context, desiredNodeState *sriovnetworkv1.SriovNetworkNodeState, applyErr error) error {
+	lastSyncError := applyErr.Error()
+	rolledBack, err := dn.rollbackIfNeeded(ctx, desiredNodeState, applyErr)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "failed to check the rollback of the nodeState")
+	}
+	if rolledBack {
+		lastSyncError = rollbackMessage(desiredNodeState.Status.Rollback)
+	}
+	setInterfaceSyncResults(desiredNodeState, hosttypes.InterfaceSyncFailures(applyErr), false)
+	if err := dn.updateSyncState(ctx, desiredNodeState, consts.SyncStatusFailed, lastSyncError); err != nil {
+		log.FromContext(ctx).Error(err, "failed to update sync status after plugin failure")
+	}
+	return applyErr
+}
+
 // checkHostStateDrift returns true if the node state drifted from the nodeState policy
 // Check if there is a change in the host network interfaces that require a reconfiguration by the daemon
 func (dn *NodeReconciler) checkHostStateDrift(ctx context.Context, desiredNodeState *sriovnetworkv1.SriovNetworkNodeState) (bool, error) {
@@ -462,10 +596,12 @@ func (dn *NodeReconciler) checkHostStateDrift(ctx context.Context, desiredNodeSt
 		log.Log.V(2).Info("verifying status change for plugin", "pluginName", dn.mainPlugin.Name())
 		changed, err := dn.mainPlugin.CheckStatusChanges(desiredNodeState)
 		if err != nil {
+			recordPluginFailure(dn.mainPlugin.Name(), pluginOperationCheckStatusChanges)
 			return false, err
 		}
 		if changed {
 			log.Log.V(0).Info("plugin require change", "pluginName", dn.mainPlugin.Name())
+			driftDetections.WithLabelValues(dn.mainPlugin.Name()).Inc()
 			return true, nil
 		}
 	}
@@ -475,10 +611,12 @@ func (dn *NodeReconciler) checkHostStateDrift(ctx context.Context, desiredNodeSt
 		log.Log.V(2).Info("verifying status change for plugin", "pluginName", p.Name())
 		changed, err := p.CheckStatusChanges(desiredNodeState)
 		if err != nil {
+			recordPluginFailure(p.Name(), pluginOperationCheckStatusChanges)
 			return false, err
 		}
 		if changed {
 			log.Log.V(0).Info("plugin require change", "pluginName", p.Name())
+			driftDetections.WithLabelValues(p.Name()).Inc()
 			return true, nil
 		}
 	}
@@ -524,11 +662,15 @@ func (dn *NodeReconciler) writeSystemdConfigFile(desiredNodeState *sriovnetworkv
 
 // handleDrain: adds the right annotation to the node and nodeState object
 // returns true if we need to finish the reconcile loop and wait for a new object
-func (dn *NodeReconciler) handleDrain(ctx context.Context, desiredNodeState *sriovnetworkv1.SriovNetworkNodeState, reqReboot bool) (bool, error) {
+func (dn *NodeReconciler) handleDrain(ctx context.Context, desiredNodeState *sriovnetworkv1.SriovNetworkNodeState, reqReboot bool, reason string) (bool, error) {
 	funcLog := log.Log.WithName("handleDrain")
 	// done with the drain we can continue with the configuration
 	if utils.ObjectHasAnnotation(desiredNodeState, consts.NodeStateDrainAnnotationCurrent, consts.DrainComplete) {
 		funcLog.Info("the node complete the draining")
+		if !dn.drainRequestedTime.IsZero() {
+			drainWaitDuration.Observe(time.Since(dn.drainRequestedTime).Seconds())
+			dn.drainRequestedTime = time.Time{}
+		}
 		return false, nil
 	}
 
@@ -546,10 +688,39 @@ func (dn *NodeReconciler) handleDrain(ctx context.Context, desiredNodeState *sri
 
 	// annotate both node and node state with drain or reboot
 	annotation := consts.DrainRequired
+	conditionReason := consts.ConditionReasonDrainRequired
 	if reqReboot {
 		annotation = consts.RebootRequired
+		conditionReason = consts.ConditionReasonRebootRequired
+	}
+	requested := !utils.ObjectHasAnnotation(desiredNodeState, consts.NodeStateDrainAnnotation, annotation)
+	if err := dn.annotate(ctx, desiredNodeState, annotation); err != nil {
+		return true, err
+	}
+	if requested {
+		dn.reportDrainRequest(ctx, desiredNodeState, conditionReason, reason)
+	}
+	if dn.drainRequestedTime.IsZero() {
+		dn.drainRequestedTime = time.Now()
+	}
+	return true, nil
+}
+
+// reportDrainRequest mirrors the drain or reboot request of the daemon in the Drain condition of the nodeState
+// and emits an event on both the node and the nodeState
+func (dn *NodeReconciler) reportDrainRequest(ctx context.Context, desiredNodeState *sriovnetworkv1.SriovNetworkNodeState, conditionReason, reason string) {
+	funcLog := log.Log.WithName("reportDrainRequest")
+	message := "drain requested"
+	if conditionReason == consts.ConditionReasonRebootRequired {
+		message = "reboot requested"
+	}
+	if reason != "" {
+		message = fmt.Sprintf("%s: %s", message, reason)
+	}
+	if _, err := utils.SetNodeStateDrainCondition(ctx, desiredNodeState, conditionReason, message, dn.client); err != nil {
+		funcLog.Error(err, "failed to update the drain condition")
 	}
-	return true, dn.annotate(ctx, desiredNodeState, annotation)
+	dn.eventRecorder.SendNodeEvent(ctx, conditionReason, message)
 }
 
 // restartDevicePluginPod restarts the device plugin pod on the specified node.
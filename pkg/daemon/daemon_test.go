@@ -27,6 +27,7 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
@@ -142,6 +143,10 @@ var _ = Describe("Daemon Controller", Ordered, func() {
 
 		hostHelper.EXPECT().LoadPfsStatus("0000:16:00.0").Return(&sriovnetworkv1.Interface{ExternallyManaged: false}, true, nil).AnyTimes()
 		hostHelper.EXPECT().ClearPCIAddressFolder().Return(nil).AnyTimes()
+		hostHelper.EXPECT().SaveKnownGoodNodeState(gomock.Any()).Return(nil).AnyTimes()
+		hostHelper.EXPECT().LoadKnownGoodNodeState().Return(nil, false, nil).AnyTimes()
+		hostHelper.EXPECT().LoadSyncFailures().Return(int64(0), 0, nil).AnyTimes()
+		hostHelper.EXPECT().SaveSyncFailures(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		hostHelper.EXPECT().DiscoverRDMASubsystem().Return("shared", nil).AnyTimes()
 		hostHelper.EXPECT().GetCurrentKernelArgs().Return("", nil).AnyTimes()
 		hostHelper.EXPECT().IsKernelArgsSet("", constants.KernelArgPciRealloc).Return(true).AnyTimes()
@@ -262,6 +267,14 @@ var _ = Describe("Daemon Controller", Ordered, func() {
 				g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Namespace: nodeState.Namespace, Name: nodeState.Name}, nodeState)).
 					ToNot(HaveOccurred())
 				g.Expect(daemonReconciler.GetLastAppliedGeneration()).To(Equal(int64(2)))
+				g.Expect(nodeState.Status.InterfaceSyncResults).To(Equal([]sriovnetworkv1.InterfaceSyncResult{{
+					PciAddress: "0000:16:00.0",
+					Name:       "eno1",
+					Phase:      constants.InterfaceSyncPhaseSucceeded,
+					Reason:     constants.InterfaceSyncReasonConfigured,
+				}}))
+				g.Expect(meta.IsStatusConditionTrue(nodeState.Status.Conditions, constants.ConditionReady)).To(BeTrue())
+				g.Expect(meta.IsStatusConditionFalse(nodeState.Status.Conditions, constants.ConditionDegraded)).To(BeTrue())
 			}, waitTime, retryTime).Should(Succeed())
 
 			err = k8sClient.Get(ctx, types.NamespacedName{Namespace: nodeState.Namespace, Name: nodeState.Name}, nodeState)
@@ -303,6 +316,108 @@ var _ = Describe("Daemon Controller", Ordered, func() {
 			Expect(nodeState.Status.LastSyncError).To(Equal(""))
 		})
 
+		It("Should only report the status when the node is paused", func(ctx context.Context) {
+			discoverSriovReturn.Store(&[]sriovnetworkv1.InterfaceExt{
+				{
+					Name:           "eno1",
+					Driver:         "ice",
+					PciAddress:     "0000:16:00.0",
+					DeviceID:       "1593",
+					Vendor:         "8086",
+					EswitchMode:    "legacy",
+					LinkAdminState: "up",
+					LinkSpeed:      "10000 Mb/s",
+					LinkType:       "ETH",
+					Mac:            "aa:bb:cc:dd:ee:ff",
+					Mtu:            1500,
+					TotalVfs:       2,
+					NumVfs:         0,
+				},
+			})
+			eventuallySyncStatusEqual(nodeState, constants.SyncStatusSucceeded)
+			lastAppliedGeneration := daemonReconciler.GetLastAppliedGeneration()
+
+			By("pause the node")
+			patchAnnotation(nodeState, constants.NodeStatePausedAnnotation, "true")
+			eventuallySyncStatusEqual(nodeState, constants.SyncStatusPaused)
+			Expect(meta.IsStatusConditionFalse(nodeState.Status.Conditions, constants.ConditionReady)).To(BeTrue())
+
+			By("add spec to the paused node state")
+			nodeState.Spec.Interfaces = []sriovnetworkv1.Interface{
+				{Name: "eno1",
+					PciAddress: "0000:16:00.0",
+					LinkType:   "eth",
+					NumVfs:     2,
+					VfGroups: []sriovnetworkv1.VfGroup{
+						{ResourceName: "test",
+							DeviceType: "netdevice",
+							PolicyName: "test-policy",
+							VfRange:    "eno1#0-1"},
+					}},
+			}
+			Expect(k8sClient.Update(ctx, nodeState)).ToNot(HaveOccurred())
+
+			ConsistentlyWithOffset(1, func(g Gomega) {
+				g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Namespace: nodeState.Namespace, Name: nodeState.Name}, nodeState)).
+					ToNot(HaveOccurred())
+				g.Expect(nodeState.Status.SyncStatus).To(Equal(constants.SyncStatusPaused))
+				g.Expect(nodeState.Annotations[constants.NodeStateDrainAnnotation]).To(Equal(constants.DrainIdle))
+				g.Expect(daemonReconciler.GetLastAppliedGeneration()).To(Equal(lastAppliedGeneration))
+			}, 2*time.Second, retryTime).Should(Succeed())
+
+			By("remove the spec and resume the node")
+			nodeState.Spec.Interfaces = []sriovnetworkv1.Interface{}
+			Expect(k8sClient.Update(ctx, nodeState)).ToNot(HaveOccurred())
+			patchAnnotation(nodeState, constants.NodeStatePausedAnnotation, "false")
+			eventuallySyncStatusEqual(nodeState, constants.SyncStatusSucceeded)
+		})
+
+		It("Should reset the drain request when the node is paused while draining", func(ctx context.Context) {
+			discoverSriovReturn.Store(&[]sriovnetworkv1.InterfaceExt{
+				{
+					Name:           "eno1",
+					Driver:         "ice",
+					PciAddress:     "0000:16:00.0",
+					DeviceID:       "1593",
+					Vendor:         "8086",
+					EswitchMode:    "legacy",
+					LinkAdminState: "up",
+					LinkSpeed:      "10000 Mb/s",
+					LinkType:       "ETH",
+					Mac:            "aa:bb:cc:dd:ee:ff",
+					Mtu:            1500,
+					TotalVfs:       2,
+					NumVfs:         0,
+				},
+			})
+			eventuallySyncStatusEqual(nodeState, constants.SyncStatusSucceeded)
+
+			By("pause the node while it is draining")
+			originalNodeState := nodeState.DeepCopy()
+			nodeState.Annotations[constants.NodeStatePausedAnnotation] = "true"
+			nodeState.Annotations[constants.NodeStateDrainAnnotation] = constants.DrainRequired
+			nodeState.Annotations[constants.NodeStateDrainAnnotationCurrent] = constants.Draining
+			Expect(k8sClient.Patch(ctx, nodeState, client.MergeFrom(originalNodeState))).ToNot(HaveOccurred())
+
+			By("waiting for the drain request to be reset")
+			EventuallyWithOffset(1, func(g Gomega) {
+				g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Namespace: nodeState.Namespace, Name: nodeState.Name}, nodeState)).
+					ToNot(HaveOccurred())
+				g.Expect(nodeState.Status.SyncStatus).To(Equal(constants.SyncStatusPaused))
+				g.Expect(nodeState.Annotations[constants.NodeStateDrainAnnotation]).To(Equal(constants.DrainIdle))
+
+				node := &corev1.Node{}
+				g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: nodeName}, node)).
+					ToNot(HaveOccurred())
+				g.Expect(node.Annotations[constants.NodeDrainAnnotation]).To(Equal(constants.DrainIdle))
+			}, waitTime, retryTime).Should(Succeed())
+
+			By("the drain controller returns the node to idle and the node is resumed")
+			patchAnnotation(nodeState, constants.NodeStateDrainAnnotationCurrent, constants.DrainIdle)
+			patchAnnotation(nodeState, constants.NodeStatePausedAnnotation, "false")
+			eventuallySyncStatusEqual(nodeState, constants.SyncStatusSucceeded)
+		})
+
 		It("Should apply external drainer annotation when useExternalDrainer is true", func(ctx context.Context) {
 			DeferCleanup(func(x bool) { vars.UseExternalDrainer = x }, vars.UseExternalDrainer)
 			vars.UseExternalDrainer = true
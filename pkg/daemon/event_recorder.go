@@ -61,6 +61,18 @@ func (e *EventRecorder) SendEvent(ctx context.Context, eventType string, msg str
 	e.eventRecorder.Event(nodeState, corev1.EventTypeNormal, eventType, msg)
 }
 
+// SendNodeEvent Send an Event on both the Node and the NodeState objects
+func (e *EventRecorder) SendNodeEvent(ctx context.Context, eventType string, msg string) {
+	e.SendEvent(ctx, eventType, msg)
+	node := &corev1.Node{}
+	err := e.client.Get(ctx, client.ObjectKey{Name: vars.NodeName}, node)
+	if err != nil {
+		log.Log.V(2).Error(err, "SendNodeEvent(): Failed to fetch node, skip SendNodeEvent", "name", vars.NodeName)
+		return
+	}
+	e.eventRecorder.Event(node, corev1.EventTypeNormal, eventType, msg)
+}
+
 // Shutdown Close the EventBroadcaster
 func (e *EventRecorder) Shutdown() {
 	e.eventBroadcaster.Shutdown()
@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+)
+
+// checkMaintenanceWindow returns true if the drain or the reboot of the node can start now.
+// Outside the maintenance windows of the node pool the sync status is moved to WaitingForMaintenanceWindow
+// and the function returns when the reconcile should be retried. Inside a window the caller moves the
+// status back to InProgress.
+func (dn *NodeReconciler) checkMaintenanceWindow(ctx context.Context, desiredNodeState *sriovnetworkv1.SriovNetworkNodeState) (bool, time.Duration, error) {
+	funcLog := log.Log.WithName("checkMaintenanceWindow")
+	nodePool, err := dn.findNodePoolConfig(ctx)
+	if err != nil {
+		funcLog.Error(err, "failed to find the pool for the node")
+		return false, 0, err
+	}
+
+	inWindow := true
+	var nextWindow time.Time
+	if nodePool != nil {
+		inWindow, nextWindow, err = nodePool.InMaintenanceWindow(time.Now())
+		if err != nil {
+			funcLog.Error(err, "failed to check the maintenance windows", "pool", nodePool.Name)
+			return false, 0, err
+		}
+	}
+
+	if inWindow {
+		if desiredNodeState.Status.SyncStatus == consts.SyncStatusWaitingForMaintenanceWindow {
+			funcLog.Info("maintenance window started")
+		}
+		return true, 0, nil
+	}
+
+	requeueAfter := consts.ResyncPeriod
+	if !nextWindow.IsZero() && time.Until(nextWindow) < requeueAfter {
+		requeueAfter = time.Until(nextWindow)
+	}
+	funcLog.Info("outside of the pool maintenance windows, waiting", "pool", nodePool.Name, "nextWindow", nextWindow)
+
+	if desiredNodeState.Status.SyncStatus != consts.SyncStatusWaitingForMaintenanceWindow {
+		msg := fmt.Sprintf("Pending changes are waiting for a maintenance window of pool %s", nodePool.Name)
+		if !nextWindow.IsZero() {
+			msg = fmt.Sprintf("%s, next window starts at %s", msg, nextWindow.Format(time.RFC3339))
+		}
+		dn.eventRecorder.SendEvent(ctx, "WaitingForMaintenanceWindow", msg)
+		err = dn.updateSyncState(ctx, desiredNodeState, consts.SyncStatusWaitingForMaintenanceWindow, desiredNodeState.Status.LastSyncError)
+		if err != nil {
+			return false, 0, err
+		}
+	}
+	return false, requeueAfter, nil
+}
+
+// findNodePoolConfig returns the SriovNetworkPoolConfig selecting the node, nil if the node is not part of any pool
+func (dn *NodeReconciler) findNodePoolConfig(ctx context.Context) (*sriovnetworkv1.SriovNetworkPoolConfig, error) {
+	node := &corev1.Node{}
+	if err := dn.client.Get(ctx, client.ObjectKey{Name: vars.NodeName}, node); err != nil {
+		return nil, err
+	}
+
+	npcl := &sriovnetworkv1.SriovNetworkPoolConfigList{}
+	if err := dn.client.List(ctx, npcl, &client.ListOptions{Namespace: vars.Namespace}); err != nil {
+		return nil, err
+	}
+	return sriovnetworkv1.FindNodePoolConfig(node, npcl.Items)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+)
+
+const (
+	metricsNamespace = "sriov_config_daemon"
+
+	pluginOperationOnNodeStateChange  = "OnNodeStateChange"
+	pluginOperationApply              = "Apply"
+	pluginOperationCheckStatusChanges = "CheckStatusChanges"
+)
+
+var (
+	reconcileDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "reconcile_duration_seconds",
+		Help:      "Duration of the reconciliation of the SriovNetworkNodeState by the config daemon",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+	})
+
+	pluginApplyDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "plugin_apply_duration_seconds",
+		Help:      "Duration of the Apply call of the vendor plugins",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 16),
+	}, []string{"plugin"})
+
+	pluginFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "plugin_failures_total",
+		Help:      "Number of errors returned by the vendor plugins",
+	}, []string{"plugin", "operation"})
+
+	drainWaitDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "drain_wait_duration_seconds",
+		Help:      "Time between the drain request of the node and the completion of the drain",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+	})
+
+	rebootsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "reboots_total",
+		Help:      "Number of node reboots triggered by the config daemon",
+	})
+
+	driftDetections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "drift_detections_total",
+		Help:      "Number of drifts between the host state and the SriovNetworkNodeState spec detected by the plugins",
+	}, []string{"plugin"})
+
+	syncStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "sync_status",
+		Help:      "Sync status of the node, the series with the current status is set to 1",
+	}, []string{"node", "status"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		reconcileDuration,
+		pluginApplyDuration,
+		pluginFailures,
+		drainWaitDuration,
+		rebootsTotal,
+		driftDetections,
+		syncStatus,
+	)
+}
+
+// observeReconcile records the duration of a reconcile started at start
+func observeReconcile(start time.Time) {
+	reconcileDuration.Observe(time.Since(start).Seconds())
+}
+
+// observePluginApply records the duration of the Apply call of a plugin and its failure
+func observePluginApply(pluginName string, start time.Time, err error) {
+	pluginApplyDuration.WithLabelValues(pluginName).Observe(time.Since(start).Seconds())
+	if err != nil {
+		pluginFailures.WithLabelValues(pluginName, pluginOperationApply).Inc()
+	}
+}
+
+// recordPluginFailure counts an error returned by a plugin
+func recordPluginFailure(pluginName, operation string) {
+	pluginFailures.WithLabelValues(pluginName, operation).Inc()
+}
+
+// setSyncStatusMetric publishes the current sync status of the node
+func setSyncStatusMetric(status string) {
+	syncStatus.Reset()
+	syncStatus.WithLabelValues(vars.NodeName, status).Set(1)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+)
+
+var _ = Describe("Metrics", func() {
+	BeforeEach(func() {
+		pluginApplyDuration.Reset()
+		pluginFailures.Reset()
+		driftDetections.Reset()
+		syncStatus.Reset()
+	})
+
+	It("should observe the duration of the plugin apply and count its failures", func() {
+		observePluginApply("generic", time.Now(), nil)
+		Expect(testutil.CollectAndCount(pluginApplyDuration)).To(Equal(1))
+		Expect(testutil.CollectAndCount(pluginFailures)).To(Equal(0))
+
+		observePluginApply("generic", time.Now(), fmt.Errorf("failed"))
+		Expect(testutil.CollectAndCount(pluginApplyDuration)).To(Equal(1))
+		Expect(testutil.ToFloat64(pluginFailures.WithLabelValues("generic", pluginOperationApply))).To(Equal(float64(1)))
+	})
+
+	It("should count the plugin failures per operation", func() {
+		recordPluginFailure("mellanox", pluginOperationOnNodeStateChange)
+		recordPluginFailure("mellanox", pluginOperationOnNodeStateChange)
+		recordPluginFailure("mellanox", pluginOperationCheckStatusChanges)
+		Expect(testutil.ToFloat64(pluginFailures.WithLabelValues("mellanox", pluginOperationOnNodeStateChange))).To(Equal(float64(2)))
+		Expect(testutil.ToFloat64(pluginFailures.WithLabelValues("mellanox", pluginOperationCheckStatusChanges))).To(Equal(float64(1)))
+		Expect(testutil.CollectAndCount(pluginFailures)).To(Equal(2))
+	})
+
+	It("should count the reboots and the drift detections", func() {
+		reboots := testutil.ToFloat64(rebootsTotal)
+		rebootsTotal.Inc()
+		Expect(testutil.ToFloat64(rebootsTotal)).To(Equal(reboots + 1))
+
+		driftDetections.WithLabelValues("generic").Inc()
+		Expect(testutil.ToFloat64(driftDetections.WithLabelValues("generic"))).To(Equal(float64(1)))
+	})
+
+	It("should report only the series of the current sync status", func() {
+		DeferCleanup(func(nodeName string) { vars.NodeName = nodeName }, vars.NodeName)
+		vars.NodeName = "test-node"
+
+		setSyncStatusMetric("InProgress")
+		Expect(testutil.CollectAndCount(syncStatus)).To(Equal(1))
+		Expect(testutil.ToFloat64(syncStatus.WithLabelValues("test-node", "InProgress"))).To(Equal(float64(1)))
+
+		setSyncStatusMetric("Succeeded")
+		Expect(testutil.CollectAndCount(syncStatus)).To(Equal(1))
+		Expect(testutil.ToFloat64(syncStatus.WithLabelValues("test-node", "Succeeded"))).To(Equal(float64(1)))
+	})
+})
@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+)
+
+// updatePlan evaluates the spec published by the operator in the planned-spec annotation
+// and stores the result in the plan field of the nodeState status.
+// The plan is removed when the annotation doesn't exist and is only recomputed
+// when the planned spec or the generation of the nodeState change.
+func (dn *NodeReconciler) updatePlan(desiredNodeState *sriovnetworkv1.SriovNetworkNodeState) {
+	funcLog := log.Log.WithName("updatePlan")
+	plannedSpec, hash, err := desiredNodeState.GetPlannedSpec()
+	if plannedSpec == nil && err == nil {
+		desiredNodeState.Status.Plan = nil
+		return
+	}
+	if desiredNodeState.IsPlanCurrent() {
+		return
+	}
+
+	plan := &sriovnetworkv1.NodeStatePlan{
+		PlannedSpecHash:    hash,
+		ObservedGeneration: desiredNodeState.GetGeneration(),
+	}
+	desiredNodeState.Status.Plan = plan
+	if err != nil {
+		funcLog.Error(err, "failed to read the planned spec")
+		plan.Error = err.Error()
+		return
+	}
+
+	plannedNodeState := desiredNodeState.DeepCopy()
+	plannedNodeState.Spec = *plannedSpec
+	plan.InterfaceChanges = sriovnetworkv1.DiffInterfaces(desiredNodeState.Spec.Interfaces, plannedSpec.Interfaces)
+	if bridgeChanges := sriovnetworkv1.DiffBridges(desiredNodeState.Spec.Bridges, plannedSpec.Bridges); len(bridgeChanges) > 0 {
+		plan.BridgeChanges = bridgeChanges
+	}
+	if systemChanges := sriovnetworkv1.DiffSystem(desiredNodeState.Spec.System, plannedSpec.System); len(systemChanges) > 0 {
+		plan.SystemChanges = systemChanges
+	}
+
+	result, unplannedPlugins, err := dn.planOnNodeStateChange(plannedNodeState)
+	if err != nil {
+		funcLog.Error(err, "failed to plan the node state change")
+		plan.Error = err.Error()
+		return
+	}
+
+	if vars.UsingSystemdMode && !equality.Semantic.DeepEqual(desiredNodeState.Spec, plannedNodeState.Spec) {
+		// the systemd service applies the configuration only on boot
+		result.NeedDrain = true
+		result.NeedReboot = true
+	}
+
+	plan.DrainRequired = result.NeedDrain || result.NeedReboot
+	plan.RebootRequired = result.NeedReboot
+	plan.KernelArgsToAdd = result.KernelArgsToAdd
+	plan.KernelArgsToRemove = result.KernelArgsToRemove
+	plan.UnplannedPlugins = unplannedPlugins
+	funcLog.V(0).Info("computed plan for the planned spec",
+		"drain-required", plan.DrainRequired,
+		"reboot-required", plan.RebootRequired,
+		"interface-changes", len(plan.InterfaceChanges),
+		"bridge-changes", len(plan.BridgeChanges),
+		"system-changes", len(plan.SystemChanges))
+}
+
+// planOnNodeStateChange aggregates the plans of the main and the additional plugins for the nodeState.
+// Plugins that don't support planning are skipped, their names are returned with the plan.
+func (dn *NodeReconciler) planOnNodeStateChange(plannedNodeState *sriovnetworkv1.SriovNetworkNodeState) (*plugin.NodeStatePlan, []string, error) {
+	funcLog := log.Log.WithName("planOnNodeStateChange")
+	result := &plugin.NodeStatePlan{}
+	var unplannedPlugins []string
+	for _, p := range append([]plugin.VendorPlugin{dn.mainPlugin}, dn.additionalPlugins...) {
+		planningPlugin, ok := p.(plugin.PlanningPlugin)
+		if !ok {
+			funcLog.Info("plugin doesn't support planning, skipping", "pluginName", p.Name())
+			unplannedPlugins = append(unplannedPlugins, p.Name())
+			continue
+		}
+		r, err := planningPlugin.PlanNodeStateChange(plannedNodeState)
+		if errors.Is(err, plugin.ErrPlanningNotSupported) {
+			funcLog.Info("plugin doesn't support planning, skipping", "pluginName", p.Name())
+			unplannedPlugins = append(unplannedPlugins, p.Name())
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("plugin %s failed to plan the node state change: %w", p.Name(), err)
+		}
+		result.NeedDrain = result.NeedDrain || r.NeedDrain
+		result.NeedReboot = result.NeedReboot || r.NeedReboot
+		result.KernelArgsToAdd = append(result.KernelArgsToAdd, r.KernelArgsToAdd...)
+		result.KernelArgsToRemove = append(result.KernelArgsToRemove, r.KernelArgsToRemove...)
+	}
+	slices.Sort(result.KernelArgsToAdd)
+	result.KernelArgsToAdd = slices.Compact(result.KernelArgsToAdd)
+	slices.Sort(result.KernelArgsToRemove)
+	result.KernelArgsToRemove = slices.Compact(result.KernelArgsToRemove)
+	return result, unplannedPlugins, nil
+}
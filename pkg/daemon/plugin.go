@@ -18,16 +18,20 @@ package daemon
 
 import (
 	"fmt"
+	"slices"
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/platform"
+	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
 )
 
 func (dn *NodeReconciler) loadPlugins(ns *sriovnetworkv1.SriovNetworkNodeState, disabledPlugins []string) error {
 	funcLog := log.Log.WithName("loadPlugins").WithValues("platform", vars.PlatformType, "orchestrator", vars.ClusterType)
 	funcLog.Info("loading plugins", "disabled", disabledPlugins)
+	dn.disabledPlugins = disabledPlugins
 
 	mainPlugin, additionalPlugins, err := dn.platformInterface.GetVendorPlugins(ns)
 	if err != nil {
@@ -56,6 +60,40 @@ func (dn *NodeReconciler) loadPlugins(ns *sriovnetworkv1.SriovNetworkNodeState,
 	return nil
 }
 
+// loadNewExternalPlugins adds the external vendor plugins started since the last load to the additional plugins,
+// it returns true if a plugin was added. The platforms not loading external plugins are skipped.
+func (dn *NodeReconciler) loadNewExternalPlugins() bool {
+	funcLog := log.Log.WithName("loadNewExternalPlugins")
+	loader, ok := dn.platformInterface.(platform.ExternalPluginsLoader)
+	if !ok {
+		return false
+	}
+	plugins, err := loader.LoadNewExternalPlugins()
+	if err != nil {
+		funcLog.Error(err, "failed to load the new external vendor plugins")
+		return false
+	}
+
+	added := false
+	for _, p := range plugins {
+		if isPluginDisabled(p.Name(), dn.disabledPlugins) {
+			loader.RejectExternalPlugin(p)
+			continue
+		}
+		if p.Name() == dn.mainPlugin.Name() || slices.ContainsFunc(dn.additionalPlugins, func(loaded plugin.VendorPlugin) bool {
+			return loaded.Name() == p.Name()
+		}) {
+			funcLog.Error(nil, "external vendor plugin conflicts with an already loaded plugin, skipping", "pluginName", p.Name())
+			loader.RejectExternalPlugin(p)
+			continue
+		}
+		funcLog.Info("loaded external vendor plugin", "pluginName", p.Name())
+		dn.additionalPlugins = append(dn.additionalPlugins, p)
+		added = true
+	}
+	return added
+}
+
 func isPluginDisabled(pluginName string, disabledPlugins []string) bool {
 	for _, p := range disabledPlugins {
 		if p == pluginName {
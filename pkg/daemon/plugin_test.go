@@ -0,0 +1,128 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	mock_platform "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/platform/mock"
+	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
+	mock_plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins/mock"
+)
+
+// externalPluginsPlatform is a platform loading the external plugins returned by load
+type externalPluginsPlatform struct {
+	*mock_platform.MockInterface
+	load     func() ([]plugin.VendorPlugin, error)
+	rejected []string
+}
+
+func (p *externalPluginsPlatform) LoadNewExternalPlugins() ([]plugin.VendorPlugin, error) {
+	return p.load()
+}
+
+func (p *externalPluginsPlatform) RejectExternalPlugin(rejected plugin.VendorPlugin) {
+	p.rejected = append(p.rejected, rejected.Name())
+}
+
+// planningTestPlugin is a plugin supporting the planning
+type planningTestPlugin struct {
+	*mock_plugin.MockVendorPlugin
+	*mock_plugin.MockPlanningPlugin
+}
+
+var _ = Describe("Plugins", func() {
+	var mockCtrl *gomock.Controller
+
+	newTestPlugin := func(name string) *mock_plugin.MockVendorPlugin {
+		p := mock_plugin.NewMockVendorPlugin(mockCtrl)
+		p.EXPECT().Name().Return(name).AnyTimes()
+		return p
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+	})
+
+	Context("loadNewExternalPlugins", func() {
+		It("should add the new external plugins and reject the disabled and the conflicting ones", func() {
+			var newPlugins []plugin.VendorPlugin
+			platform := &externalPluginsPlatform{
+				MockInterface: mock_platform.NewMockInterface(mockCtrl),
+				load:          func() ([]plugin.VendorPlugin, error) { return newPlugins, nil },
+			}
+			dn := &NodeReconciler{
+				platformInterface: platform,
+				mainPlugin:        newTestPlugin("generic"),
+				additionalPlugins: []plugin.VendorPlugin{newTestPlugin("mellanox")},
+				disabledPlugins:   []string{"disabled"},
+			}
+
+			Expect(dn.loadNewExternalPlugins()).To(BeFalse())
+
+			By("disabled plugins and plugins with the name of a loaded plugin are skipped")
+			newPlugins = []plugin.VendorPlugin{newTestPlugin("disabled"), newTestPlugin("mellanox"), newTestPlugin("vendor")}
+			Expect(dn.loadNewExternalPlugins()).To(BeTrue())
+			Expect(dn.additionalPlugins).To(HaveLen(2))
+			Expect(dn.additionalPlugins[1].Name()).To(Equal("vendor"))
+			// the connections to the skipped plugins are closed
+			Expect(platform.rejected).To(Equal([]string{"disabled", "mellanox"}))
+
+			newPlugins = nil
+			Expect(dn.loadNewExternalPlugins()).To(BeFalse())
+			Expect(dn.additionalPlugins).To(HaveLen(2))
+		})
+
+		It("should keep the loaded plugins when the external plugins fail to load", func() {
+			dn := &NodeReconciler{
+				platformInterface: &externalPluginsPlatform{
+					MockInterface: mock_platform.NewMockInterface(mockCtrl),
+					load:          func() ([]plugin.VendorPlugin, error) { return nil, fmt.Errorf("permission denied") },
+				},
+				mainPlugin: newTestPlugin("generic"),
+			}
+			Expect(dn.loadNewExternalPlugins()).To(BeFalse())
+			Expect(dn.additionalPlugins).To(BeEmpty())
+
+			By("the platforms without external plugins are skipped")
+			dn.platformInterface = mock_platform.NewMockInterface(mockCtrl)
+			Expect(dn.loadNewExternalPlugins()).To(BeFalse())
+		})
+	})
+
+	Context("planOnNodeStateChange", func() {
+		It("should report the plugins that don't support the planning", func() {
+			planning := &planningTestPlugin{newTestPlugin("generic"), mock_plugin.NewMockPlanningPlugin(mockCtrl)}
+			planning.MockPlanningPlugin.EXPECT().PlanNodeStateChange(gomock.Any()).Return(&plugin.NodeStatePlan{NeedDrain: true}, nil)
+			external := &planningTestPlugin{newTestPlugin("external"), mock_plugin.NewMockPlanningPlugin(mockCtrl)}
+			external.MockPlanningPlugin.EXPECT().PlanNodeStateChange(gomock.Any()).Return(nil, plugin.ErrPlanningNotSupported)
+			dn := &NodeReconciler{
+				mainPlugin:        planning,
+				additionalPlugins: []plugin.VendorPlugin{newTestPlugin("vendor"), external},
+			}
+
+			result, unplanned, err := dn.planOnNodeStateChange(newTestNodeState())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.NeedDrain).To(BeTrue())
+			Expect(unplanned).To(Equal([]string{"vendor", "external"}))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+)
+
+// applyRollbackSpec replaces the spec of a rolled back generation with the last known-good spec saved on the host.
+// The rollback is cleared once the generation changes.
+func (dn *NodeReconciler) applyRollbackSpec(desiredNodeState *sriovnetworkv1.SriovNetworkNodeState) error {
+	funcLog := log.Log.WithName("applyRollbackSpec")
+	rollback := desiredNodeState.Status.Rollback
+	if rollback == nil {
+		return nil
+	}
+	if rollback.FailedGeneration != desiredNodeState.Generation {
+		funcLog.Info("new generation, clearing the rollback", "failedGeneration", rollback.FailedGeneration,
+			"generation", desiredNodeState.Generation)
+		desiredNodeState.Status.Rollback = nil
+		return nil
+	}
+
+	knownGood, exist, err := dn.hostHelpers.LoadKnownGoodNodeState()
+	if err != nil {
+		funcLog.Error(err, "failed to load the known-good nodeState")
+		return err
+	}
+	if !exist || knownGood.Generation != rollback.KnownGoodGeneration {
+		funcLog.Info("known-good configuration not found on the host, retrying the failed generation",
+			"knownGoodGeneration", rollback.KnownGoodGeneration)
+		desiredNodeState.Status.Rollback = nil
+		return nil
+	}
+
+	funcLog.V(0).Info("node is rolled back, using the known-good spec",
+		"failedGeneration", rollback.FailedGeneration, "knownGoodGeneration", knownGood.Generation)
+	desiredNodeState.Spec = knownGood.Spec
+	return nil
+}
+
+// rollbackIfNeeded counts the failed attempts to sync the generation and starts a rollback to the last
+// known-good configuration once vars.AutoRollbackAfterFailures is reached. It returns true if the rollback started.
+// The count is saved on the host so it survives a restart of the daemon.
+func (dn *NodeReconciler) rollbackIfNeeded(ctx context.Context, desiredNodeState *sriovnetworkv1.SriovNetworkNodeState, syncErr error) (bool, error) {
+	funcLog := log.Log.WithName("rollbackIfNeeded")
+	// nothing to do when the rollback is disabled or when the known-good configuration failed too
+	if vars.AutoRollbackAfterFailures == 0 || desiredNodeState.Status.Rollback != nil {
+		return false, nil
+	}
+
+	failedGeneration, failedAttempts, err := dn.hostHelpers.LoadSyncFailures()
+	if err != nil {
+		funcLog.Error(err, "failed to load the failed sync attempts")
+		return false, err
+	}
+	if failedGeneration != desiredNodeState.Generation {
+		failedAttempts = 0
+	}
+	failedAttempts++
+	if failedAttempts < vars.AutoRollbackAfterFailures {
+		funcLog.Info("generation failed to sync", "generation", desiredNodeState.Generation,
+			"failedAttempts", failedAttempts, "autoRollbackAfterFailures", vars.AutoRollbackAfterFailures)
+		return false, dn.hostHelpers.SaveSyncFailures(desiredNodeState.Generation, failedAttempts)
+	}
+
+	knownGood, exist, err := dn.hostHelpers.LoadKnownGoodNodeState()
+	if err != nil {
+		funcLog.Error(err, "failed to load the known-good nodeState")
+		return false, err
+	}
+	if !exist || knownGood.Generation >= desiredNodeState.Generation {
+		funcLog.Info("no known-good configuration to roll back to", "generation", desiredNodeState.Generation)
+		return false, dn.hostHelpers.SaveSyncFailures(desiredNodeState.Generation, failedAttempts)
+	}
+
+	desiredNodeState.Status.Rollback = &sriovnetworkv1.NodeStateRollback{
+		FailedGeneration:    desiredNodeState.Generation,
+		KnownGoodGeneration: knownGood.Generation,
+		FailedAttempts:      failedAttempts,
+		Error:               syncErr.Error(),
+		Time:                metav1.Now(),
+	}
+	if err := dn.hostHelpers.SaveSyncFailures(desiredNodeState.Generation, 0); err != nil {
+		funcLog.Error(err, "failed to reset the failed sync attempts")
+	}
+	funcLog.Info("rolling back to the known-good configuration", "failedGeneration", desiredNodeState.Generation,
+		"knownGoodGeneration", knownGood.Generation)
+	dn.eventRecorder.SendEvent(ctx, "RollbackNodeState", rollbackMessage(desiredNodeState.Status.Rollback))
+	return true, nil
+}
+
+// rollbackMessage returns the sync error reported while the node is rolled back
+func rollbackMessage(rollback *sriovnetworkv1.NodeStateRollback) string {
+	return fmt.Sprintf("rolled back to the known-good generation %d after %d failed attempts to apply generation %d: %s",
+		rollback.KnownGoodGeneration, rollback.FailedAttempts, rollback.FailedGeneration, rollback.Error)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	mock_helper "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/helper/mock"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+)
+
+var _ = Describe("Rollback", func() {
+	var (
+		dn         *NodeReconciler
+		hostHelper *mock_helper.MockHostHelpersInterface
+		nodeState  *sriovnetworkv1.SriovNetworkNodeState
+		knownGood  *sriovnetworkv1.SriovNetworkNodeState
+	)
+
+	BeforeEach(func() {
+		DeferCleanup(func(failures int) { vars.AutoRollbackAfterFailures = failures }, vars.AutoRollbackAfterFailures)
+		hostHelper = mock_helper.NewMockHostHelpersInterface(gomock.NewController(GinkgoT()))
+		dn = &NodeReconciler{
+			hostHelpers: hostHelper,
+			eventRecorder: &EventRecorder{
+				client:        fake.NewClientBuilder().Build(),
+				eventRecorder: record.NewFakeRecorder(10),
+			},
+		}
+		nodeState = newTestNodeState()
+		knownGood = newTestNodeState()
+		knownGood.Generation = 1
+		knownGood.Spec.Interfaces = knownGood.Spec.Interfaces[:1]
+	})
+
+	Context("rollbackIfNeeded", func() {
+		It("should not roll back when the automatic rollback is disabled", func(ctx context.Context) {
+			vars.AutoRollbackAfterFailures = 0
+			rolledBack, err := dn.rollbackIfNeeded(ctx, nodeState, fmt.Errorf("apply failed"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rolledBack).To(BeFalse())
+		})
+
+		It("should count the failures of the current generation", func(ctx context.Context) {
+			vars.AutoRollbackAfterFailures = 3
+
+			By("the failures of a previous generation are not counted")
+			hostHelper.EXPECT().LoadSyncFailures().Return(int64(1), 2, nil)
+			hostHelper.EXPECT().SaveSyncFailures(int64(2), 1).Return(nil)
+			rolledBack, err := dn.rollbackIfNeeded(ctx, nodeState, fmt.Errorf("apply failed"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rolledBack).To(BeFalse())
+
+			By("the count saved before a restart of the daemon is used")
+			hostHelper.EXPECT().LoadSyncFailures().Return(int64(2), 1, nil)
+			hostHelper.EXPECT().SaveSyncFailures(int64(2), 2).Return(nil)
+			rolledBack, err = dn.rollbackIfNeeded(ctx, nodeState, fmt.Errorf("apply failed"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rolledBack).To(BeFalse())
+			Expect(nodeState.Status.Rollback).To(BeNil())
+		})
+
+		It("should roll back to the known-good configuration after too many failures", func(ctx context.Context) {
+			vars.AutoRollbackAfterFailures = 3
+			hostHelper.EXPECT().LoadSyncFailures().Return(int64(2), 2, nil)
+			hostHelper.EXPECT().LoadKnownGoodNodeState().Return(knownGood, true, nil)
+			hostHelper.EXPECT().SaveSyncFailures(int64(2), 0).Return(nil)
+			rolledBack, err := dn.rollbackIfNeeded(ctx, nodeState, fmt.Errorf("apply failed"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rolledBack).To(BeTrue())
+			Expect(nodeState.Status.Rollback).ToNot(BeNil())
+			Expect(nodeState.Status.Rollback.FailedGeneration).To(Equal(int64(2)))
+			Expect(nodeState.Status.Rollback.KnownGoodGeneration).To(Equal(int64(1)))
+			Expect(nodeState.Status.Rollback.FailedAttempts).To(Equal(3))
+			Expect(nodeState.Status.Rollback.Error).To(Equal("apply failed"))
+
+			By("the known-good configuration failing too doesn't start another rollback")
+			rolledBack, err = dn.rollbackIfNeeded(ctx, nodeState, fmt.Errorf("apply failed"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rolledBack).To(BeFalse())
+		})
+
+		It("should not roll back without a known-good configuration", func(ctx context.Context) {
+			vars.AutoRollbackAfterFailures = 1
+			hostHelper.EXPECT().LoadSyncFailures().Return(int64(0), 0, nil)
+			hostHelper.EXPECT().LoadKnownGoodNodeState().Return(nil, false, nil)
+			hostHelper.EXPECT().SaveSyncFailures(int64(2), 1).Return(nil)
+			rolledBack, err := dn.rollbackIfNeeded(ctx, nodeState, fmt.Errorf("apply failed"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rolledBack).To(BeFalse())
+			Expect(nodeState.Status.Rollback).To(BeNil())
+		})
+	})
+
+	Context("applyRollbackSpec", func() {
+		It("should not change the spec without a rollback", func() {
+			Expect(dn.applyRollbackSpec(nodeState)).To(Succeed())
+			Expect(nodeState.Spec.Interfaces).To(HaveLen(2))
+		})
+
+		It("should use the known-good spec for the rolled back generation", func() {
+			nodeState.Status.Rollback = &sriovnetworkv1.NodeStateRollback{FailedGeneration: 2, KnownGoodGeneration: 1}
+			hostHelper.EXPECT().LoadKnownGoodNodeState().Return(knownGood, true, nil)
+			Expect(dn.applyRollbackSpec(nodeState)).To(Succeed())
+			Expect(nodeState.Spec).To(Equal(knownGood.Spec))
+			Expect(nodeState.Status.Rollback).ToNot(BeNil())
+		})
+
+		It("should retry the failed generation when the known-good configuration was replaced on the host", func() {
+			nodeState.Status.Rollback = &sriovnetworkv1.NodeStateRollback{FailedGeneration: 2, KnownGoodGeneration: 0}
+			hostHelper.EXPECT().LoadKnownGoodNodeState().Return(knownGood, true, nil)
+			Expect(dn.applyRollbackSpec(nodeState)).To(Succeed())
+			Expect(nodeState.Spec.Interfaces).To(HaveLen(2))
+			Expect(nodeState.Status.Rollback).To(BeNil())
+		})
+
+		It("should clear the rollback for a new generation", func() {
+			nodeState.Generation = 3
+			nodeState.Status.Rollback = &sriovnetworkv1.NodeStateRollback{FailedGeneration: 2, KnownGoodGeneration: 1}
+			Expect(dn.applyRollbackSpec(nodeState)).To(Succeed())
+			Expect(nodeState.Spec.Interfaces).To(HaveLen(2))
+			Expect(nodeState.Status.Rollback).To(BeNil())
+		})
+	})
+})
@@ -19,13 +19,18 @@ package daemon
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
 )
 
@@ -36,8 +41,7 @@ const (
 func (dn *NodeReconciler) updateSyncState(ctx context.Context, desiredNodeState *sriovnetworkv1.SriovNetworkNodeState, status, failedMessage string) error {
 	funcLog := log.Log.WithName("updateSyncState")
 	currentNodeState := &sriovnetworkv1.SriovNetworkNodeState{}
-	desiredNodeState.Status.SyncStatus = status
-	desiredNodeState.Status.LastSyncError = failedMessage
+	setSyncState(desiredNodeState, status, failedMessage)
 
 	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		if err := dn.client.Get(ctx, client.ObjectKey{Namespace: desiredNodeState.Namespace, Name: desiredNodeState.Name}, currentNodeState); err != nil {
@@ -48,6 +52,11 @@ func (dn *NodeReconciler) updateSyncState(ctx context.Context, desiredNodeState
 		}
 		// update the object meta if not the patch can fail if the object did change
 		desiredNodeState.ObjectMeta = currentNodeState.ObjectMeta
+		// the drain condition is updated with the drain transitions, keep the latest one
+		if drainCondition := meta.FindStatusCondition(currentNodeState.Status.Conditions, consts.ConditionDrain); drainCondition != nil {
+			meta.RemoveStatusCondition(&desiredNodeState.Status.Conditions, consts.ConditionDrain)
+			meta.SetStatusCondition(&desiredNodeState.Status.Conditions, *drainCondition)
+		}
 
 		funcLog.V(2).Info("update nodeState status",
 			"CurrentSyncStatus", currentNodeState.Status.SyncStatus,
@@ -71,6 +80,7 @@ func (dn *NodeReconciler) updateSyncState(ctx context.Context, desiredNodeState
 	}
 
 	dn.recordStatusChangeEvent(ctx, currentNodeState.Status.SyncStatus, status, failedMessage)
+	setSyncStatusMetric(status)
 	return nil
 }
 
@@ -90,6 +100,11 @@ func (dn *NodeReconciler) shouldUpdateStatus(current, desiredNodeState *sriovnet
 		return true
 	}
 
+	// check for the dry-run plan
+	if !equality.Semantic.DeepEqual(current.Status.Plan, desiredNodeState.Status.Plan) {
+		return true
+	}
+
 	// check for interfaces
 	// we can't use deep equal here because if we have a vf inside a pod is name will not be available for example
 	// we use the index for both lists
@@ -155,3 +170,106 @@ func (dn *NodeReconciler) recordStatusChangeEvent(ctx context.Context, oldStatus
 		dn.eventRecorder.SendEvent(ctx, "SyncStatusChanged", eventMsg)
 	}
 }
+
+// setInterfaceSyncResults fills the per interface results of the last configuration attempt.
+// Interfaces from the spec without a failure are reported as succeeded if the configuration was applied,
+// otherwise they are reported as pending.
+func setInterfaceSyncResults(nodeState *sriovnetworkv1.SriovNetworkNodeState, failures []sriovnetworkv1.InterfaceSyncResult, applied bool) {
+	results := []sriovnetworkv1.InterfaceSyncResult{}
+	for _, failure := range failures {
+		// keep only the first failure reported for the PF
+		if slices.ContainsFunc(results, func(r sriovnetworkv1.InterfaceSyncResult) bool { return r.PciAddress == failure.PciAddress }) {
+			continue
+		}
+		if iface := nodeState.GetInterfaceStateByPciAddress(failure.PciAddress); iface != nil {
+			failure.Name = iface.Name
+		}
+		results = append(results, failure)
+	}
+	for _, iface := range nodeState.Spec.Interfaces {
+		if slices.ContainsFunc(results, func(r sriovnetworkv1.InterfaceSyncResult) bool { return r.PciAddress == iface.PciAddress }) {
+			continue
+		}
+		result := sriovnetworkv1.InterfaceSyncResult{
+			PciAddress: iface.PciAddress,
+			Name:       iface.Name,
+			Phase:      consts.InterfaceSyncPhaseSucceeded,
+			Reason:     consts.InterfaceSyncReasonConfigured,
+		}
+		if !applied {
+			result.Phase = consts.InterfaceSyncPhasePending
+			result.Reason = consts.InterfaceSyncReasonNotApplied
+			result.Message = "configuration was not applied"
+		}
+		results = append(results, result)
+	}
+	slices.SortFunc(results, func(a, b sriovnetworkv1.InterfaceSyncResult) int {
+		return strings.Compare(a.PciAddress, b.PciAddress)
+	})
+	if len(results) == 0 {
+		results = nil
+	}
+	nodeState.Status.InterfaceSyncResults = results
+}
+
+// setSyncState sets the sync status of the node state and updates its conditions.
+// The per interface results of the previous configuration attempt are cleared when a new one is in progress.
+func setSyncState(nodeState *sriovnetworkv1.SriovNetworkNodeState, status, failedMessage string) {
+	nodeState.Status.SyncStatus = status
+	nodeState.Status.LastSyncError = failedMessage
+	if status == consts.SyncStatusInProgress {
+		nodeState.Status.InterfaceSyncResults = nil
+	}
+	setSyncConditions(nodeState)
+}
+
+// setSyncConditions updates the Ready, Progressing and Degraded conditions of the node state
+// from the sync status and the per interface results
+func setSyncConditions(nodeState *sriovnetworkv1.SriovNetworkNodeState) {
+	var failedInterfaces []string
+	for _, result := range nodeState.Status.InterfaceSyncResults {
+		if result.Phase == consts.InterfaceSyncPhaseFailed {
+			failedInterfaces = append(failedInterfaces, result.PciAddress)
+		}
+	}
+
+	ready := metav1.Condition{Type: consts.ConditionReady, ObservedGeneration: nodeState.Generation}
+	progressing := metav1.Condition{Type: consts.ConditionProgressing, ObservedGeneration: nodeState.Generation,
+		Status: metav1.ConditionFalse, Reason: consts.ConditionReasonSyncSucceeded}
+	degraded := metav1.Condition{Type: consts.ConditionDegraded, ObservedGeneration: nodeState.Generation,
+		Status: metav1.ConditionFalse, Reason: consts.ConditionReasonSyncSucceeded}
+
+	switch nodeState.Status.SyncStatus {
+	case consts.SyncStatusSucceeded:
+		ready.Status, ready.Reason = metav1.ConditionTrue, consts.ConditionReasonSyncSucceeded
+	case consts.SyncStatusFailed:
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, consts.ConditionReasonSyncFailed, nodeState.Status.LastSyncError
+		degraded.Status, degraded.Reason, degraded.Message = metav1.ConditionTrue, consts.ConditionReasonSyncFailed, nodeState.Status.LastSyncError
+	case consts.SyncStatusPaused:
+		ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, consts.ConditionReasonPaused, "node configuration is paused"
+		progressing.Reason = consts.ConditionReasonPaused
+	case consts.SyncStatusWaitingForMaintenanceWindow:
+		ready.Status, ready.Reason = metav1.ConditionFalse, consts.ConditionReasonWaitingForMaintenanceWindow
+		progressing.Status, progressing.Reason = metav1.ConditionTrue, consts.ConditionReasonWaitingForMaintenanceWindow
+	default:
+		ready.Status, ready.Reason = metav1.ConditionFalse, consts.ConditionReasonSyncInProgress
+		progressing.Status, progressing.Reason = metav1.ConditionTrue, consts.ConditionReasonSyncInProgress
+	}
+
+	if rollback := nodeState.Status.Rollback; rollback != nil && rollback.FailedGeneration == nodeState.Generation &&
+		nodeState.Status.SyncStatus == consts.SyncStatusFailed {
+		ready.Reason, degraded.Reason = consts.ConditionReasonRolledBack, consts.ConditionReasonRolledBack
+	}
+
+	if len(failedInterfaces) > 0 {
+		message := "failed to configure interfaces: " + strings.Join(failedInterfaces, ", ")
+		if ready.Status == metav1.ConditionTrue {
+			ready.Status, ready.Reason, ready.Message = metav1.ConditionFalse, consts.ConditionReasonInterfaceFailed, message
+		}
+		degraded.Status, degraded.Reason, degraded.Message = metav1.ConditionTrue, consts.ConditionReasonInterfaceFailed, message
+	}
+
+	meta.SetStatusCondition(&nodeState.Status.Conditions, ready)
+	meta.SetStatusCondition(&nodeState.Status.Conditions, progressing)
+	meta.SetStatusCondition(&nodeState.Status.Conditions, degraded)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package daemon
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
+)
+
+// newTestNodeState returns a nodeState with two PFs for the unit tests of the daemon package
+func newTestNodeState() *sriovnetworkv1.SriovNetworkNodeState {
+	return &sriovnetworkv1.SriovNetworkNodeState{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Generation: 2},
+		Spec: sriovnetworkv1.SriovNetworkNodeStateSpec{
+			Interfaces: sriovnetworkv1.Interfaces{
+				{PciAddress: "0000:16:00.0", Name: "eno1", NumVfs: 2},
+				{PciAddress: "0000:16:00.1", Name: "eno2", NumVfs: 2},
+			},
+		},
+	}
+}
+
+var _ = Describe("Sync status", func() {
+	var nodeState *sriovnetworkv1.SriovNetworkNodeState
+
+	BeforeEach(func() {
+		nodeState = newTestNodeState()
+		setInterfaceSyncResults(nodeState, []sriovnetworkv1.InterfaceSyncResult{{
+			PciAddress: "0000:16:00.1",
+			Phase:      consts.InterfaceSyncPhaseFailed,
+			Reason:     consts.InterfaceSyncReasonVFConfigFailed,
+			Message:    "failed to set VF MAC address",
+		}}, false)
+		setSyncState(nodeState, consts.SyncStatusFailed, "failed to configure VFs")
+	})
+
+	It("should report the failed and the not applied interfaces", func() {
+		Expect(nodeState.Status.InterfaceSyncResults).To(Equal([]sriovnetworkv1.InterfaceSyncResult{
+			{PciAddress: "0000:16:00.0", Name: "eno1", Phase: consts.InterfaceSyncPhasePending,
+				Reason: consts.InterfaceSyncReasonNotApplied, Message: "configuration was not applied"},
+			{PciAddress: "0000:16:00.1", Phase: consts.InterfaceSyncPhaseFailed,
+				Reason: consts.InterfaceSyncReasonVFConfigFailed, Message: "failed to set VF MAC address"},
+		}))
+		ready := meta.FindStatusCondition(nodeState.Status.Conditions, consts.ConditionReady)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(consts.ConditionReasonSyncFailed))
+		Expect(ready.ObservedGeneration).To(Equal(int64(2)))
+		degraded := meta.FindStatusCondition(nodeState.Status.Conditions, consts.ConditionDegraded)
+		Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+		Expect(degraded.Reason).To(Equal(consts.ConditionReasonInterfaceFailed))
+		Expect(degraded.Message).To(Equal("failed to configure interfaces: 0000:16:00.1"))
+		Expect(meta.IsStatusConditionFalse(nodeState.Status.Conditions, consts.ConditionProgressing)).To(BeTrue())
+	})
+
+	It("should clear the results of the failed attempt when a retry starts", func() {
+		setSyncState(nodeState, consts.SyncStatusInProgress, nodeState.Status.LastSyncError)
+		Expect(nodeState.Status.InterfaceSyncResults).To(BeNil())
+		Expect(nodeState.Status.LastSyncError).To(Equal("failed to configure VFs"))
+		Expect(meta.IsStatusConditionFalse(nodeState.Status.Conditions, consts.ConditionDegraded)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(nodeState.Status.Conditions, consts.ConditionProgressing)).To(BeTrue())
+		Expect(meta.FindStatusCondition(nodeState.Status.Conditions, consts.ConditionReady).Reason).
+			To(Equal(consts.ConditionReasonSyncInProgress))
+
+		By("the retry succeeds")
+		setInterfaceSyncResults(nodeState, nil, true)
+		setSyncState(nodeState, consts.SyncStatusSucceeded, "")
+		Expect(nodeState.Status.InterfaceSyncResults).To(HaveLen(2))
+		for _, result := range nodeState.Status.InterfaceSyncResults {
+			Expect(result.Phase).To(Equal(consts.InterfaceSyncPhaseSucceeded))
+		}
+		Expect(meta.IsStatusConditionTrue(nodeState.Status.Conditions, consts.ConditionReady)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(nodeState.Status.Conditions, consts.ConditionDegraded)).To(BeTrue())
+		Expect(meta.IsStatusConditionFalse(nodeState.Status.Conditions, consts.ConditionProgressing)).To(BeTrue())
+	})
+
+	It("should report only the new failure when the retry fails", func() {
+		setSyncState(nodeState, consts.SyncStatusInProgress, nodeState.Status.LastSyncError)
+		setInterfaceSyncResults(nodeState, []sriovnetworkv1.InterfaceSyncResult{{
+			PciAddress: "0000:16:00.0",
+			Phase:      consts.InterfaceSyncPhaseFailed,
+			Reason:     consts.InterfaceSyncReasonPFConfigFailed,
+		}}, false)
+		setSyncState(nodeState, consts.SyncStatusFailed, "failed to configure PF")
+		degraded := meta.FindStatusCondition(nodeState.Status.Conditions, consts.ConditionDegraded)
+		Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+		Expect(degraded.Message).To(Equal("failed to configure interfaces: 0000:16:00.0"))
+	})
+})
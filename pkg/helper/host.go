@@ -22,6 +22,7 @@ import (
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/store"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/utils"
+	intel "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vendors/intel"
 	mlx "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vendors/mellanox"
 )
 
@@ -31,6 +32,7 @@ type HostHelpersInterface interface {
 	host.HostManagerInterface
 	store.ManagerInterface
 	mlx.MellanoxInterface
+	intel.IntelInterface
 }
 
 type hostHelpers struct {
@@ -38,6 +40,7 @@ type hostHelpers struct {
 	host.HostManagerInterface
 	store.ManagerInterface
 	mlx.MellanoxInterface
+	intel.IntelInterface
 }
 
 func NewDefaultHostHelpers() (HostHelpersInterface, error) {
@@ -48,6 +51,7 @@ func NewDefaultHostHelpers() (HostHelpersInterface, error) {
 		return nil, err
 	}
 	mlxHelper := mlx.New(utilsHelper, hostManager)
+	intelHelper := intel.New(hostManager)
 	storeManager, err := store.NewManager()
 	if err != nil {
 		log.Log.Error(err, "failed to create store manager")
@@ -58,5 +62,6 @@ func NewDefaultHostHelpers() (HostHelpersInterface, error) {
 		utilsHelper,
 		hostManager,
 		storeManager,
-		mlxHelper}, nil
+		mlxHelper,
+		intelHelper}, nil
 }
@@ -446,6 +446,21 @@ func (mr *MockHostHelpersInterfaceMockRecorder) GetCurrentKernelArgs() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentKernelArgs", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetCurrentKernelArgs))
 }
 
+// GetDDPPackage mocks base method.
+func (m *MockHostHelpersInterface) GetDDPPackage(pciAddress string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDDPPackage", pciAddress)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDDPPackage indicates an expected call of GetDDPPackage.
+func (mr *MockHostHelpersInterfaceMockRecorder) GetDDPPackage(pciAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDDPPackage", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetDDPPackage), pciAddress)
+}
+
 // GetDevlinkDeviceParam mocks base method.
 func (m *MockHostHelpersInterface) GetDevlinkDeviceParam(pciAddr, paramName string) (string, error) {
 	m.ctrl.T.Helper()
@@ -461,6 +476,51 @@ func (mr *MockHostHelpersInterfaceMockRecorder) GetDevlinkDeviceParam(pciAddr, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevlinkDeviceParam", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetDevlinkDeviceParam), pciAddr, paramName)
 }
 
+// GetDevlinkDeviceParamByCmode mocks base method.
+func (m *MockHostHelpersInterface) GetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevlinkDeviceParamByCmode", pciAddr, paramName, cmode)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevlinkDeviceParamByCmode indicates an expected call of GetDevlinkDeviceParamByCmode.
+func (mr *MockHostHelpersInterfaceMockRecorder) GetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevlinkDeviceParamByCmode", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetDevlinkDeviceParamByCmode), pciAddr, paramName, cmode)
+}
+
+// GetDevlinkDeviceSerialNumber mocks base method.
+func (m *MockHostHelpersInterface) GetDevlinkDeviceSerialNumber(pciAddr string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevlinkDeviceSerialNumber", pciAddr)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevlinkDeviceSerialNumber indicates an expected call of GetDevlinkDeviceSerialNumber.
+func (mr *MockHostHelpersInterfaceMockRecorder) GetDevlinkDeviceSerialNumber(pciAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevlinkDeviceSerialNumber", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetDevlinkDeviceSerialNumber), pciAddr)
+}
+
+// GetDevlinkParamsToUpdate mocks base method.
+func (m *MockHostHelpersInterface) GetDevlinkParamsToUpdate(iface *v1.Interface) ([]v1.DevlinkParam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevlinkParamsToUpdate", iface)
+	ret0, _ := ret[0].([]v1.DevlinkParam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevlinkParamsToUpdate indicates an expected call of GetDevlinkParamsToUpdate.
+func (mr *MockHostHelpersInterfaceMockRecorder) GetDevlinkParamsToUpdate(iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevlinkParamsToUpdate", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetDevlinkParamsToUpdate), iface)
+}
+
 // GetDriverByBusAndDevice mocks base method.
 func (m *MockHostHelpersInterface) GetDriverByBusAndDevice(bus, device string) (string, error) {
 	m.ctrl.T.Helper()
@@ -536,6 +596,20 @@ func (mr *MockHostHelpersInterfaceMockRecorder) GetMlxNicFwData(pciAddress any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMlxNicFwData", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetMlxNicFwData), pciAddress)
 }
 
+// GetNetDevFirmwareVersion mocks base method.
+func (m *MockHostHelpersInterface) GetNetDevFirmwareVersion(ifaceName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetDevFirmwareVersion", ifaceName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetNetDevFirmwareVersion indicates an expected call of GetNetDevFirmwareVersion.
+func (mr *MockHostHelpersInterfaceMockRecorder) GetNetDevFirmwareVersion(ifaceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetDevFirmwareVersion", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetNetDevFirmwareVersion), ifaceName)
+}
+
 // GetNetDevLinkAdminState mocks base method.
 func (m *MockHostHelpersInterface) GetNetDevLinkAdminState(ifaceName string) string {
 	m.ctrl.T.Helper()
@@ -592,6 +666,21 @@ func (mr *MockHostHelpersInterfaceMockRecorder) GetNetDevNodeGUID(pciAddr any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetDevNodeGUID", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetNetDevNodeGUID), pciAddr)
 }
 
+// GetNetDevPrivFlags mocks base method.
+func (m *MockHostHelpersInterface) GetNetDevPrivFlags(ifaceName string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetDevPrivFlags", ifaceName)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetDevPrivFlags indicates an expected call of GetNetDevPrivFlags.
+func (mr *MockHostHelpersInterfaceMockRecorder) GetNetDevPrivFlags(ifaceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetDevPrivFlags", reflect.TypeOf((*MockHostHelpersInterface)(nil).GetNetDevPrivFlags), ifaceName)
+}
+
 // GetNetdevMTU mocks base method.
 func (m *MockHostHelpersInterface) GetNetdevMTU(pciAddr string) int {
 	m.ctrl.T.Helper()
@@ -801,6 +890,22 @@ func (mr *MockHostHelpersInterfaceMockRecorder) LoadKernelModule(name any, args
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadKernelModule", reflect.TypeOf((*MockHostHelpersInterface)(nil).LoadKernelModule), varargs...)
 }
 
+// LoadKnownGoodNodeState mocks base method.
+func (m *MockHostHelpersInterface) LoadKnownGoodNodeState() (*v1.SriovNetworkNodeState, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadKnownGoodNodeState")
+	ret0, _ := ret[0].(*v1.SriovNetworkNodeState)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LoadKnownGoodNodeState indicates an expected call of LoadKnownGoodNodeState.
+func (mr *MockHostHelpersInterfaceMockRecorder) LoadKnownGoodNodeState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadKnownGoodNodeState", reflect.TypeOf((*MockHostHelpersInterface)(nil).LoadKnownGoodNodeState))
+}
+
 // LoadPfsStatus mocks base method.
 func (m *MockHostHelpersInterface) LoadPfsStatus(pciAddress string) (*v1.Interface, bool, error) {
 	m.ctrl.T.Helper()
@@ -817,6 +922,22 @@ func (mr *MockHostHelpersInterfaceMockRecorder) LoadPfsStatus(pciAddress any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadPfsStatus", reflect.TypeOf((*MockHostHelpersInterface)(nil).LoadPfsStatus), pciAddress)
 }
 
+// LoadSyncFailures mocks base method.
+func (m *MockHostHelpersInterface) LoadSyncFailures() (int64, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadSyncFailures")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LoadSyncFailures indicates an expected call of LoadSyncFailures.
+func (mr *MockHostHelpersInterfaceMockRecorder) LoadSyncFailures() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadSyncFailures", reflect.TypeOf((*MockHostHelpersInterface)(nil).LoadSyncFailures))
+}
+
 // LoadUdevRules mocks base method.
 func (m *MockHostHelpersInterface) LoadUdevRules() error {
 	m.ctrl.T.Helper()
@@ -875,6 +996,36 @@ func (mr *MockHostHelpersInterfaceMockRecorder) MstConfigReadData(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MstConfigReadData", reflect.TypeOf((*MockHostHelpersInterface)(nil).MstConfigReadData), arg0)
 }
 
+// NeedToUpdateEthtoolSettings mocks base method.
+func (m *MockHostHelpersInterface) NeedToUpdateEthtoolSettings(iface *v1.Interface) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedToUpdateEthtoolSettings", iface)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NeedToUpdateEthtoolSettings indicates an expected call of NeedToUpdateEthtoolSettings.
+func (mr *MockHostHelpersInterfaceMockRecorder) NeedToUpdateEthtoolSettings(iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedToUpdateEthtoolSettings", reflect.TypeOf((*MockHostHelpersInterface)(nil).NeedToUpdateEthtoolSettings), iface)
+}
+
+// NeedToUpdateNetDevEthtoolSettings mocks base method.
+func (m *MockHostHelpersInterface) NeedToUpdateNetDevEthtoolSettings(ifaceName string, settings *v1.EthtoolSettings) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedToUpdateNetDevEthtoolSettings", ifaceName, settings)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NeedToUpdateNetDevEthtoolSettings indicates an expected call of NeedToUpdateNetDevEthtoolSettings.
+func (mr *MockHostHelpersInterfaceMockRecorder) NeedToUpdateNetDevEthtoolSettings(ifaceName, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedToUpdateNetDevEthtoolSettings", reflect.TypeOf((*MockHostHelpersInterface)(nil).NeedToUpdateNetDevEthtoolSettings), ifaceName, settings)
+}
+
 // PrepareNMUdevRule mocks base method.
 func (m *MockHostHelpersInterface) PrepareNMUdevRule() error {
 	m.ctrl.T.Helper()
@@ -1007,6 +1158,20 @@ func (mr *MockHostHelpersInterfaceMockRecorder) RebindVfToDefaultDriver(pciAddr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebindVfToDefaultDriver", reflect.TypeOf((*MockHostHelpersInterface)(nil).RebindVfToDefaultDriver), pciAddr)
 }
 
+// ReloadDevlinkDevice mocks base method.
+func (m *MockHostHelpersInterface) ReloadDevlinkDevice(pciAddr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadDevlinkDevice", pciAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReloadDevlinkDevice indicates an expected call of ReloadDevlinkDevice.
+func (mr *MockHostHelpersInterfaceMockRecorder) ReloadDevlinkDevice(pciAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadDevlinkDevice", reflect.TypeOf((*MockHostHelpersInterface)(nil).ReloadDevlinkDevice), pciAddr)
+}
+
 // RemoveDisableNMUdevRule mocks base method.
 func (m *MockHostHelpersInterface) RemoveDisableNMUdevRule(pfPciAddress string) error {
 	m.ctrl.T.Helper()
@@ -1112,6 +1277,34 @@ func (mr *MockHostHelpersInterfaceMockRecorder) RunCommand(arg0 any, arg1 ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCommand", reflect.TypeOf((*MockHostHelpersInterface)(nil).RunCommand), varargs...)
 }
 
+// SaveKnownGoodNodeState mocks base method.
+func (m *MockHostHelpersInterface) SaveKnownGoodNodeState(arg0 *v1.SriovNetworkNodeState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveKnownGoodNodeState", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveKnownGoodNodeState indicates an expected call of SaveKnownGoodNodeState.
+func (mr *MockHostHelpersInterfaceMockRecorder) SaveKnownGoodNodeState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveKnownGoodNodeState", reflect.TypeOf((*MockHostHelpersInterface)(nil).SaveKnownGoodNodeState), arg0)
+}
+
+// SaveSyncFailures mocks base method.
+func (m *MockHostHelpersInterface) SaveSyncFailures(generation int64, attempts int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSyncFailures", generation, attempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSyncFailures indicates an expected call of SaveSyncFailures.
+func (mr *MockHostHelpersInterfaceMockRecorder) SaveSyncFailures(generation, attempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSyncFailures", reflect.TypeOf((*MockHostHelpersInterface)(nil).SaveSyncFailures), generation, attempts)
+}
+
 // SaveLastPfAppliedStatus mocks base method.
 func (m *MockHostHelpersInterface) SaveLastPfAppliedStatus(PfInfo *v1.Interface) error {
 	m.ctrl.T.Helper()
@@ -1126,6 +1319,20 @@ func (mr *MockHostHelpersInterfaceMockRecorder) SaveLastPfAppliedStatus(PfInfo a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLastPfAppliedStatus", reflect.TypeOf((*MockHostHelpersInterface)(nil).SaveLastPfAppliedStatus), PfInfo)
 }
 
+// SetDDPPackage mocks base method.
+func (m *MockHostHelpersInterface) SetDDPPackage(pciAddress, ddpPackage string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDDPPackage", pciAddress, ddpPackage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDDPPackage indicates an expected call of SetDDPPackage.
+func (mr *MockHostHelpersInterfaceMockRecorder) SetDDPPackage(pciAddress, ddpPackage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDDPPackage", reflect.TypeOf((*MockHostHelpersInterface)(nil).SetDDPPackage), pciAddress, ddpPackage)
+}
+
 // SetDevlinkDeviceParam mocks base method.
 func (m *MockHostHelpersInterface) SetDevlinkDeviceParam(pciAddr, paramName, value string) error {
 	m.ctrl.T.Helper()
@@ -1140,6 +1347,48 @@ func (mr *MockHostHelpersInterfaceMockRecorder) SetDevlinkDeviceParam(pciAddr, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDevlinkDeviceParam", reflect.TypeOf((*MockHostHelpersInterface)(nil).SetDevlinkDeviceParam), pciAddr, paramName, value)
 }
 
+// SetDevlinkDeviceParamByCmode mocks base method.
+func (m *MockHostHelpersInterface) SetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDevlinkDeviceParamByCmode", pciAddr, paramName, cmode, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDevlinkDeviceParamByCmode indicates an expected call of SetDevlinkDeviceParamByCmode.
+func (mr *MockHostHelpersInterfaceMockRecorder) SetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDevlinkDeviceParamByCmode", reflect.TypeOf((*MockHostHelpersInterface)(nil).SetDevlinkDeviceParamByCmode), pciAddr, paramName, cmode, value)
+}
+
+// SetNetDevEthtoolSettings mocks base method.
+func (m *MockHostHelpersInterface) SetNetDevEthtoolSettings(ifaceName string, settings *v1.EthtoolSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNetDevEthtoolSettings", ifaceName, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNetDevEthtoolSettings indicates an expected call of SetNetDevEthtoolSettings.
+func (mr *MockHostHelpersInterfaceMockRecorder) SetNetDevEthtoolSettings(ifaceName, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetDevEthtoolSettings", reflect.TypeOf((*MockHostHelpersInterface)(nil).SetNetDevEthtoolSettings), ifaceName, settings)
+}
+
+// SetNetDevPrivFlags mocks base method.
+func (m *MockHostHelpersInterface) SetNetDevPrivFlags(ifaceName string, flags map[string]bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNetDevPrivFlags", ifaceName, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNetDevPrivFlags indicates an expected call of SetNetDevPrivFlags.
+func (mr *MockHostHelpersInterfaceMockRecorder) SetNetDevPrivFlags(ifaceName, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetDevPrivFlags", reflect.TypeOf((*MockHostHelpersInterface)(nil).SetNetDevPrivFlags), ifaceName, flags)
+}
+
 // SetNetdevMTU mocks base method.
 func (m *MockHostHelpersInterface) SetNetdevMTU(pciAddr string, mtu int) error {
 	m.ctrl.T.Helper()
@@ -18,23 +18,42 @@ package bridge
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sync"
 
+	"github.com/vishvananda/netlink"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/bridge/ovs"
 	ovsStorePkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/bridge/ovs/store"
+	netlinkLibPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/lib/netlink"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/types"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/utils"
 )
 
 type bridge struct {
-	ovs ovs.Interface
+	utilsHelper utils.CmdInterface
+	ovs         ovs.Interface
+	netlinkLib  netlinkLibPkg.NetlinkLib
+
+	offloadedFlowsLock sync.Mutex
+	// number of the offloaded flows of the bridges, by bridge name
+	offloadedFlows map[string]offloadedFlowsSample
+
+	installedFlowsLock sync.Mutex
+	// result of the last comparison of the flows on the bridges with the configuration, by bridge name
+	installedFlows map[string]installedFlowsSample
 }
 
 // New return default implementation of the BridgeInterface
-func New() types.BridgeInterface {
+func New(utilsHelper utils.CmdInterface, netlinkLib netlinkLibPkg.NetlinkLib) types.BridgeInterface {
 	return &bridge{
-		ovs: ovs.New(ovsStorePkg.New()),
+		utilsHelper: utilsHelper,
+		ovs:         ovs.New(ovsStorePkg.New()),
+		netlinkLib:  netlinkLib,
 	}
 }
 
@@ -46,9 +65,114 @@ func (b *bridge) DiscoverBridges() (sriovnetworkv1.Bridges, error) {
 		log.Log.Error(err, "DiscoverBridges(): failed to discover managed OVS bridges")
 		return sriovnetworkv1.Bridges{}, err
 	}
+	for i := range discoveredOVSBridges {
+		if len(discoveredOVSBridges[i].Bridge.Flows) > 0 {
+			discoveredOVSBridges[i].Bridge.Flows = b.getInstalledFlows(&discoveredOVSBridges[i])
+		}
+		if discoveredOVSBridges[i].State != nil {
+			discoveredOVSBridges[i].State.OffloadedFlows = b.getOffloadedFlows(discoveredOVSBridges[i].Name)
+		}
+		for j := range discoveredOVSBridges[i].Ports {
+			port := &discoveredOVSBridges[i].Ports[j]
+			if len(port.IPAddresses) > 0 {
+				port.IPAddresses = b.getAssignedAddresses(port)
+			}
+		}
+	}
 	return sriovnetworkv1.Bridges{OVS: discoveredOVSBridges}, nil
 }
 
+// getAssignedAddresses returns IP addresses of the port which are assigned to the port link on the host
+func (b *bridge) getAssignedAddresses(port *sriovnetworkv1.OVSPortConfig) []string {
+	link, err := b.netlinkLib.LinkByName(port.Name)
+	if err != nil {
+		log.Log.V(2).Info("getAssignedAddresses(): failed to get link for the port", "port", port.Name, "error", err)
+		return nil
+	}
+	assigned, err := b.netlinkLib.AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		log.Log.Error(err, "getAssignedAddresses(): failed to list addresses of the port", "port", port.Name)
+		return nil
+	}
+	var result []string
+	for _, ipAddr := range port.IPAddresses {
+		addr, err := netlink.ParseAddr(ipAddr)
+		if err != nil {
+			continue
+		}
+		if hasAddress(assigned, addr) {
+			result = append(result, ipAddr)
+		}
+	}
+	return result
+}
+
+// configurePortAddresses sets the links of the internal ports up and assigns IP addresses to them,
+// addresses which were assigned to the port by the operator but are not part of the configuration anymore are removed
+func (b *bridge) configurePortAddresses(desiredBr *sriovnetworkv1.OVSConfigExt, curBr *sriovnetworkv1.OVSConfigExt) error {
+	for _, port := range desiredBr.Ports {
+		if port.Type != consts.OVSPortTypeInternal {
+			continue
+		}
+		var previous []string
+		if curBr != nil {
+			for _, curPort := range curBr.Ports {
+				if curPort.Name == port.Name {
+					previous = curPort.IPAddresses
+				}
+			}
+		}
+		link, err := b.netlinkLib.LinkByName(port.Name)
+		if err != nil {
+			return fmt.Errorf("failed to get link for port %s: %v", port.Name, err)
+		}
+		if err := b.netlinkLib.LinkSetUp(link); err != nil {
+			return fmt.Errorf("failed to set link for port %s up: %v", port.Name, err)
+		}
+		for _, ipAddr := range previous {
+			if slices.Contains(port.IPAddresses, ipAddr) {
+				continue
+			}
+			addr, err := netlink.ParseAddr(ipAddr)
+			if err != nil {
+				return fmt.Errorf("failed to parse address %s of port %s: %v", ipAddr, port.Name, err)
+			}
+			log.Log.V(2).Info("configurePortAddresses(): remove address from the port", "port", port.Name, "address", ipAddr)
+			if err := b.netlinkLib.AddrDel(link, addr); err != nil {
+				return fmt.Errorf("failed to remove address %s from port %s: %v", ipAddr, port.Name, err)
+			}
+		}
+		if len(port.IPAddresses) == 0 {
+			continue
+		}
+		assigned, err := b.netlinkLib.AddrList(link, netlink.FAMILY_ALL)
+		if err != nil {
+			return fmt.Errorf("failed to list addresses of port %s: %v", port.Name, err)
+		}
+		for _, ipAddr := range port.IPAddresses {
+			addr, err := netlink.ParseAddr(ipAddr)
+			if err != nil {
+				return fmt.Errorf("failed to parse address %s of port %s: %v", ipAddr, port.Name, err)
+			}
+			if hasAddress(assigned, addr) {
+				continue
+			}
+			log.Log.V(2).Info("configurePortAddresses(): add address to the port", "port", port.Name, "address", ipAddr)
+			if err := b.netlinkLib.AddrAdd(link, addr); err != nil {
+				return fmt.Errorf("failed to add address %s to port %s: %v", ipAddr, port.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// hasAddress returns true if the address is in the list
+func hasAddress(addrs []netlink.Addr, addr *netlink.Addr) bool {
+	return slices.ContainsFunc(addrs, func(a netlink.Addr) bool {
+		return a.IPNet != nil && a.IPNet.String() == addr.IPNet.String()
+	})
+}
+
 // ConfigureBridge configure managed bridges for the host
 func (b *bridge) ConfigureBridges(bridgesSpec sriovnetworkv1.Bridges, bridgesStatus sriovnetworkv1.Bridges) error {
 	log.Log.V(1).Info("ConfigureBridges(): configure bridges")
@@ -80,6 +204,20 @@ func (b *bridge) ConfigureBridges(bridgesSpec sriovnetworkv1.Bridges, bridgesSta
 			log.Log.Error(err, "ConfigureBridges(): failed to create OVS bridge", "bridge", desiredBr.Name)
 			return err
 		}
+		var curBr *sriovnetworkv1.OVSConfigExt
+		if pos := slices.IndexFunc(bridgesStatus.OVS, func(br sriovnetworkv1.OVSConfigExt) bool {
+			return br.Name == desiredBr.Name
+		}); pos >= 0 {
+			curBr = &bridgesStatus.OVS[pos]
+		}
+		if err := b.configurePortAddresses(&desiredBr, curBr); err != nil {
+			log.Log.Error(err, "ConfigureBridges(): failed to configure addresses of the OVS bridge ports", "bridge", desiredBr.Name)
+			return err
+		}
+		if err := b.configureFlows(&desiredBr, curBr); err != nil {
+			log.Log.Error(err, "ConfigureBridges(): failed to configure flows of the OVS bridge", "bridge", desiredBr.Name)
+			return err
+		}
 	}
 	return nil
 }
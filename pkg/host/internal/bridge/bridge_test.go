@@ -18,28 +18,40 @@ package bridge
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
+	"github.com/vishvananda/netlink"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	ovsMockPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/bridge/ovs/mock"
+	netlinkMockPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/lib/netlink/mock"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/types"
+	utilsMockPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/utils/mock"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
 )
 
 var _ = Describe("Bridge", func() {
 	var (
-		testCtrl *gomock.Controller
-		br       types.BridgeInterface
-		ovsMock  *ovsMockPkg.MockInterface
-		testErr  = fmt.Errorf("test")
+		testCtrl    *gomock.Controller
+		br          types.BridgeInterface
+		ovsMock     *ovsMockPkg.MockInterface
+		netlinkMock *netlinkMockPkg.MockNetlinkLib
+		utilsMock   *utilsMockPkg.MockCmdInterface
+		testErr     = fmt.Errorf("test")
 	)
 	BeforeEach(func() {
 		testCtrl = gomock.NewController(GinkgoT())
 		ovsMock = ovsMockPkg.NewMockInterface(testCtrl)
-		br = &bridge{ovs: ovsMock}
+		netlinkMock = netlinkMockPkg.NewMockNetlinkLib(testCtrl)
+		utilsMock = utilsMockPkg.NewMockCmdInterface(testCtrl)
+		br = &bridge{utilsHelper: utilsMock, ovs: ovsMock, netlinkLib: netlinkMock}
 	})
 	AfterEach(func() {
 		testCtrl.Finish()
@@ -56,6 +68,61 @@ var _ = Describe("Bridge", func() {
 			_, err := br.DiscoverBridges()
 			Expect(err).To(MatchError(testErr))
 		})
+		It("report assigned addresses of the internal ports", func() {
+			ovsMock.EXPECT().GetOVSBridges(gomock.Any()).Return([]sriovnetworkv1.OVSConfigExt{{
+				Name: "test",
+				Ports: []sriovnetworkv1.OVSPortConfig{
+					{Name: "patch-test", Type: "patch", Peer: "patch-br-int"},
+					{Name: "host0", Type: "internal", IPAddresses: []string{"192.168.1.10/24", "fd00::10/64"}},
+				},
+			}}, nil)
+			link := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "host0"}}
+			assigned, _ := netlink.ParseAddr("192.168.1.10/24")
+			netlinkMock.EXPECT().LinkByName("host0").Return(link, nil)
+			netlinkMock.EXPECT().AddrList(link, netlink.FAMILY_ALL).Return([]netlink.Addr{*assigned}, nil)
+			ret, err := br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].Ports[0].IPAddresses).To(BeEmpty())
+			Expect(ret.OVS[0].Ports[1].IPAddresses).To(Equal([]string{"192.168.1.10/24"}))
+		})
+		It("report number of the offloaded flows", func() {
+			ovsMock.EXPECT().GetOVSBridges(gomock.Any()).Return([]sriovnetworkv1.OVSConfigExt{{
+				Name: "test", State: &sriovnetworkv1.OVSBridgeState{HwOffload: "true"}}}, nil)
+			utilsMock.EXPECT().RunCommand("chroot", gomock.Any(), "ovs-appctl", "dpif/dump-flows", "-m", "test").Return("ufid:1, recirc_id(0),in_port(pf0) packets:10, bytes:600, used:0.1s, offloaded:yes, dp:tc, actions:rep0\n"+
+				"ufid:2, recirc_id(0),in_port(rep0) packets:1, bytes:60, used:0.1s, dp:ovs, actions:pf0\n", "", nil)
+			ret, err := br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].State.OffloadedFlows).To(Equal(ptr.To(1)))
+		})
+		It("read number of the offloaded flows again only after the refresh interval", func() {
+			ovsMock.EXPECT().GetOVSBridges(gomock.Any()).Return([]sriovnetworkv1.OVSConfigExt{{
+				Name: "test", State: &sriovnetworkv1.OVSBridgeState{HwOffload: "true"}}}, nil).Times(3)
+			dump := "ufid:1, recirc_id(0),in_port(pf0) packets:10, bytes:600, used:0.1s, offloaded:yes, dp:tc, actions:rep0\n"
+			utilsMock.EXPECT().RunCommand("chroot", gomock.Any(), "ovs-appctl", "dpif/dump-flows", "-m", "test").Return(dump, "", nil)
+			ret, err := br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].State.OffloadedFlows).To(Equal(ptr.To(1)))
+			// the datapath flows are not dumped on the next poll
+			ret, err = br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].State.OffloadedFlows).To(Equal(ptr.To(1)))
+
+			sample := br.(*bridge).offloadedFlows["test"]
+			sample.readTime = sample.readTime.Add(-offloadedFlowsRefreshInterval)
+			br.(*bridge).offloadedFlows["test"] = sample
+			utilsMock.EXPECT().RunCommand("chroot", gomock.Any(), "ovs-appctl", "dpif/dump-flows", "-m", "test").Return(dump+dump, "", nil)
+			ret, err = br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].State.OffloadedFlows).To(Equal(ptr.To(2)))
+		})
+		It("don't report number of the offloaded flows if datapath flows can't be read", func() {
+			ovsMock.EXPECT().GetOVSBridges(gomock.Any()).Return([]sriovnetworkv1.OVSConfigExt{{
+				Name: "test", State: &sriovnetworkv1.OVSBridgeState{}}}, nil)
+			utilsMock.EXPECT().RunCommand("chroot", gomock.Any(), "ovs-appctl", "dpif/dump-flows", "-m", "test").Return("", "no such bridge", testErr)
+			ret, err := br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].State.OffloadedFlows).To(BeNil())
+		})
 	})
 
 	Context("ConfigureBridges", func() {
@@ -88,6 +155,40 @@ var _ = Describe("Bridge", func() {
 				sriovnetworkv1.Bridges{OVS: []sriovnetworkv1.OVSConfigExt{}})
 			Expect(err).To(MatchError(testErr))
 		})
+		It("configure addresses of the internal ports", func() {
+			desired := sriovnetworkv1.OVSConfigExt{Name: "br", Ports: []sriovnetworkv1.OVSPortConfig{
+				{Name: "patch-test", Type: "patch", Peer: "patch-br-int"},
+				{Name: "host0", Type: "internal", IPAddresses: []string{"192.168.1.10/24", "fd00::10/64"}},
+			}}
+			current := sriovnetworkv1.OVSConfigExt{Name: "br", Ports: []sriovnetworkv1.OVSPortConfig{
+				{Name: "host0", Type: "internal", IPAddresses: []string{"192.168.1.10/24", "192.168.2.10/24"}},
+			}}
+			link := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "host0"}}
+			assigned, _ := netlink.ParseAddr("192.168.1.10/24")
+			removed, _ := netlink.ParseAddr("192.168.2.10/24")
+			added, _ := netlink.ParseAddr("fd00::10/64")
+			ovsMock.EXPECT().CreateOVSBridge(gomock.Any(), &desired).Return(nil)
+			netlinkMock.EXPECT().LinkByName("host0").Return(link, nil)
+			netlinkMock.EXPECT().LinkSetUp(link).Return(nil)
+			netlinkMock.EXPECT().AddrDel(link, removed).Return(nil)
+			netlinkMock.EXPECT().AddrList(link, netlink.FAMILY_ALL).Return([]netlink.Addr{*assigned}, nil)
+			netlinkMock.EXPECT().AddrAdd(link, added).Return(nil)
+			err := br.ConfigureBridges(
+				sriovnetworkv1.Bridges{OVS: []sriovnetworkv1.OVSConfigExt{desired}},
+				sriovnetworkv1.Bridges{OVS: []sriovnetworkv1.OVSConfigExt{current}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("failed to configure addresses of the internal ports", func() {
+			desired := sriovnetworkv1.OVSConfigExt{Name: "br", Ports: []sriovnetworkv1.OVSPortConfig{
+				{Name: "host0", Type: "internal", IPAddresses: []string{"192.168.1.10/24"}},
+			}}
+			ovsMock.EXPECT().CreateOVSBridge(gomock.Any(), &desired).Return(nil)
+			netlinkMock.EXPECT().LinkByName("host0").Return(nil, testErr)
+			err := br.ConfigureBridges(
+				sriovnetworkv1.Bridges{OVS: []sriovnetworkv1.OVSConfigExt{desired}},
+				sriovnetworkv1.Bridges{})
+			Expect(err).To(MatchError(ContainSubstring("failed to get link for port host0")))
+		})
 		It("failed on removal", func() {
 			brDelete1 := sriovnetworkv1.OVSConfigExt{Name: "br-to-delete-1"}
 			ovsMock.EXPECT().RemoveOVSBridge(gomock.Any(), brDelete1.Name).Return(testErr)
@@ -98,6 +199,123 @@ var _ = Describe("Bridge", func() {
 		})
 	})
 
+	Context("Flows", func() {
+		var (
+			origInChroot       bool
+			origFilesystemRoot string
+			flows              = []string{"table=0,priority=100,in_port=pf0,actions=NORMAL"}
+		)
+		BeforeEach(func() {
+			origInChroot, origFilesystemRoot = vars.InChroot, vars.FilesystemRoot
+			vars.InChroot = true
+			vars.FilesystemRoot = GinkgoT().TempDir()
+		})
+		AfterEach(func() {
+			vars.InChroot, vars.FilesystemRoot = origInChroot, origFilesystemRoot
+		})
+		It("report flows of the bridge only if they are installed", func() {
+			ovsMock.EXPECT().GetOVSBridges(gomock.Any()).Return([]sriovnetworkv1.OVSConfigExt{{
+				Name: "br", Bridge: sriovnetworkv1.OVSBridgeConfig{Flows: flows}}}, nil).Times(2)
+			utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "diff-flows", "br", gomock.Any()).Return("", "", nil)
+			ret, err := br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].Bridge.Flows).To(Equal(flows))
+
+			// a flow with the operator's cookie was removed from the bridge
+			br.(*bridge).installedFlows = nil
+			utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "diff-flows", "br", gomock.Any()).Return(
+				"+cookie=0x5352494f56, priority=100,in_port=pf0 actions=NORMAL\n", "", testErr)
+			ret, err = br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].Bridge.Flows).To(BeNil())
+		})
+		It("compare flows of the bridge again only after the check interval or when the flows change", func() {
+			ovsMock.EXPECT().GetOVSBridges(gomock.Any()).Return([]sriovnetworkv1.OVSConfigExt{{
+				Name: "br", Bridge: sriovnetworkv1.OVSBridgeConfig{Flows: flows}}}, nil).Times(3)
+			utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "diff-flows", "br", gomock.Any()).Return("", "", nil)
+			ret, err := br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].Bridge.Flows).To(Equal(flows))
+			// the flows are not compared on the next poll
+			ret, err = br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].Bridge.Flows).To(Equal(flows))
+
+			sample := br.(*bridge).installedFlows["br"]
+			sample.checkTime = sample.checkTime.Add(-installedFlowsCheckInterval)
+			br.(*bridge).installedFlows["br"] = sample
+			utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "diff-flows", "br", gomock.Any()).Return(
+				"-cookie=0x5352494f56, priority=100,in_port=pf0 actions=NORMAL\n", "", testErr)
+			ret, err = br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].Bridge.Flows).To(BeNil())
+
+			newFlows := []string{"table=0,priority=200,in_port=pf0,actions=NORMAL"}
+			ovsMock.EXPECT().GetOVSBridges(gomock.Any()).Return([]sriovnetworkv1.OVSConfigExt{{
+				Name: "br", Bridge: sriovnetworkv1.OVSBridgeConfig{Flows: newFlows}}}, nil)
+			utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "diff-flows", "br", gomock.Any()).Return("", "", nil)
+			ret, err = br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].Bridge.Flows).To(Equal(newFlows))
+		})
+		It("don't report flows of the bridge if they can't be compared", func() {
+			ovsMock.EXPECT().GetOVSBridges(gomock.Any()).Return([]sriovnetworkv1.OVSConfigExt{{
+				Name: "br", Bridge: sriovnetworkv1.OVSBridgeConfig{Flows: flows}}}, nil)
+			utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "diff-flows", "br", gomock.Any()).Return("", "bridge not found", testErr)
+			ret, err := br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].Bridge.Flows).To(BeNil())
+		})
+		It("replace flows of the bridge", func() {
+			desired := sriovnetworkv1.OVSConfigExt{Name: "br", Bridge: sriovnetworkv1.OVSBridgeConfig{Flows: flows}}
+			ovsMock.EXPECT().CreateOVSBridge(gomock.Any(), &desired).Return(nil)
+			gomock.InOrder(
+				utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "diff-flows", "br", gomock.Any()).DoAndReturn(
+					func(_ string, args ...string) (string, string, error) {
+						flowsFile := args[len(args)-1]
+						Expect(filepath.Dir(flowsFile)).To(Equal(consts.OVSFlowsDirPath))
+						dirInfo, err := os.Stat(filepath.Join(vars.FilesystemRoot, consts.OVSFlowsDirPath))
+						Expect(err).NotTo(HaveOccurred())
+						Expect(dirInfo.Mode().Perm()).To(Equal(os.FileMode(0700)))
+						content, err := os.ReadFile(filepath.Join(vars.FilesystemRoot, flowsFile))
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(content)).To(Equal("cookie=0x5352494f56," + flows[0] + "\n"))
+						return "+cookie=0x5352494f56, priority=100,in_port=pf0 actions=NORMAL\n", "", testErr
+					}),
+				utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "del-flows", "br", "cookie=0x5352494f56/-1").Return("", "", nil),
+				utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "--bundle", "add-flows", "br", gomock.Any()).Return("", "", nil),
+			)
+			err := br.ConfigureBridges(
+				sriovnetworkv1.Bridges{OVS: []sriovnetworkv1.OVSConfigExt{desired}},
+				sriovnetworkv1.Bridges{})
+			Expect(err).NotTo(HaveOccurred())
+
+			// the flows are reported as installed without comparing them again
+			ovsMock.EXPECT().GetOVSBridges(gomock.Any()).Return([]sriovnetworkv1.OVSConfigExt{desired}, nil)
+			ret, err := br.DiscoverBridges()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ret.OVS[0].Bridge.Flows).To(Equal(flows))
+		})
+		It("keep flows which match the configuration", func() {
+			desired := sriovnetworkv1.OVSConfigExt{Name: "br", Bridge: sriovnetworkv1.OVSBridgeConfig{Flows: flows}}
+			ovsMock.EXPECT().CreateOVSBridge(gomock.Any(), &desired).Return(nil)
+			utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "diff-flows", "br", gomock.Any()).Return("", "", nil)
+			err := br.ConfigureBridges(
+				sriovnetworkv1.Bridges{OVS: []sriovnetworkv1.OVSConfigExt{desired}},
+				sriovnetworkv1.Bridges{OVS: []sriovnetworkv1.OVSConfigExt{desired}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("failed to compare flows", func() {
+			desired := sriovnetworkv1.OVSConfigExt{Name: "br", Bridge: sriovnetworkv1.OVSBridgeConfig{Flows: flows}}
+			ovsMock.EXPECT().CreateOVSBridge(gomock.Any(), &desired).Return(nil)
+			utilsMock.EXPECT().RunCommand("ovs-ofctl", "-O", "OpenFlow14", "diff-flows", "br", gomock.Any()).Return("", "bridge not found", testErr)
+			err := br.ConfigureBridges(
+				sriovnetworkv1.Bridges{OVS: []sriovnetworkv1.OVSConfigExt{desired}},
+				sriovnetworkv1.Bridges{})
+			Expect(err).To(MatchError(ContainSubstring("failed to compare flows of the bridge br")))
+		})
+	})
+
 	Context("DetachInterfaceFromManagedBridge", func() {
 		It("succeed", func() {
 			ovsMock.EXPECT().RemoveInterfaceFromOVSBridge(gomock.Any(), "0000:d8:00.0").Return(nil)
@@ -0,0 +1,249 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bridge
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/utils"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+)
+
+const (
+	// OpenFlow version used to manage the flows, bundles require OpenFlow 1.4 or later
+	openFlowVersion = "OpenFlow14"
+	// the number of the offloaded flows changes with the traffic, it is read again only after this interval
+	// to not update the status of the node on every poll
+	offloadedFlowsRefreshInterval = 5 * time.Minute
+	// the flows on the bridge are compared with the configuration at most once per interval
+	// to not run ovs-ofctl on every poll, a drift is detected with this delay
+	installedFlowsCheckInterval = 5 * time.Minute
+)
+
+// offloadedFlowsSample holds the number of the offloaded flows of a bridge and the time it was read
+type offloadedFlowsSample struct {
+	count    *int
+	readTime time.Time
+}
+
+// installedFlowsSample holds the result of the comparison of the flows on a bridge with the configured flows
+type installedFlowsSample struct {
+	flows     []string
+	installed bool
+	checkTime time.Time
+}
+
+// configureFlows installs the static flows of the bridge,
+// flows are replaced only if the flows with the operator's cookie on the bridge differ from the configuration
+func (b *bridge) configureFlows(desiredBr *sriovnetworkv1.OVSConfigExt, curBr *sriovnetworkv1.OVSConfigExt) error {
+	if len(desiredBr.Bridge.Flows) == 0 && (curBr == nil || len(curBr.Bridge.Flows) == 0) {
+		// flows were never configured for the bridge
+		return nil
+	}
+	needUpdate, err := b.needToUpdateFlows(desiredBr.Name, desiredBr.Bridge.Flows)
+	if err != nil {
+		return err
+	}
+	if !needUpdate {
+		log.Log.V(2).Info("configureFlows(): flows of the bridge already match current configuration", "bridge", desiredBr.Name)
+		b.setInstalledFlows(desiredBr.Name, desiredBr.Bridge.Flows, true)
+		return nil
+	}
+	log.Log.V(2).Info("configureFlows(): replace flows of the bridge", "bridge", desiredBr.Name, "flows", desiredBr.Bridge.Flows)
+	if err := b.replaceFlows(desiredBr.Name, desiredBr.Bridge.Flows); err != nil {
+		return err
+	}
+	b.setInstalledFlows(desiredBr.Name, desiredBr.Bridge.Flows, true)
+	return nil
+}
+
+// getInstalledFlows returns the flows of the bridge if they are installed on the bridge,
+// returns nil if the flows with the operator's cookie on the bridge differ from the flows.
+// The bridge is checked at most once per installedFlowsCheckInterval for the same flows, the last result is returned in between.
+func (b *bridge) getInstalledFlows(br *sriovnetworkv1.OVSConfigExt) []string {
+	b.installedFlowsLock.Lock()
+	sample, ok := b.installedFlows[br.Name]
+	b.installedFlowsLock.Unlock()
+	if !ok || !slices.Equal(sample.flows, br.Bridge.Flows) || time.Since(sample.checkTime) >= installedFlowsCheckInterval {
+		needUpdate, err := b.needToUpdateFlows(br.Name, br.Bridge.Flows)
+		if err != nil {
+			log.Log.Error(err, "getInstalledFlows(): failed to check flows of the bridge", "bridge", br.Name)
+		}
+		sample = b.setInstalledFlows(br.Name, br.Bridge.Flows, err == nil && !needUpdate)
+	}
+	if !sample.installed {
+		log.Log.V(2).Info("getInstalledFlows(): flows of the bridge differ from the configuration", "bridge", br.Name)
+		return nil
+	}
+	return br.Bridge.Flows
+}
+
+// setInstalledFlows records the result of the comparison of the flows on the bridge with the flows
+func (b *bridge) setInstalledFlows(bridgeName string, flows []string, installed bool) installedFlowsSample {
+	b.installedFlowsLock.Lock()
+	defer b.installedFlowsLock.Unlock()
+	if b.installedFlows == nil {
+		b.installedFlows = map[string]installedFlowsSample{}
+	}
+	sample := installedFlowsSample{flows: slices.Clone(flows), installed: installed, checkTime: time.Now()}
+	b.installedFlows[bridgeName] = sample
+	return sample
+}
+
+// needToUpdateFlows returns true if the flows with the operator's cookie on the bridge
+// differ from the provided flows
+func (b *bridge) needToUpdateFlows(bridgeName string, flows []string) (bool, error) {
+	flowsFile, err := writeFlowsFile(bridgeName, flows)
+	if err != nil {
+		return false, err
+	}
+	defer removeFlowsFile(flowsFile)
+	// diff-flows exits with non-zero code if the flows differ, check stderr to detect errors
+	stdout, stderr, err := b.runOpenFlowCommand("diff-flows", bridgeName, flowsFile)
+	if err != nil && len(stderr) != 0 {
+		return false, fmt.Errorf("failed to compare flows of the bridge %s: %v, %s", bridgeName, err, stderr)
+	}
+	return hasManagedFlowsDiff(stdout), nil
+}
+
+// replaceFlows removes the flows with the operator's cookie from the bridge and installs the provided flows
+func (b *bridge) replaceFlows(bridgeName string, flows []string) error {
+	_, stderr, err := b.runOpenFlowCommand("del-flows", bridgeName, "cookie="+consts.OVSManagedFlowsCookie+"/-1")
+	if err != nil {
+		return fmt.Errorf("failed to remove flows from the bridge %s: %v, %s", bridgeName, err, stderr)
+	}
+	if len(flows) == 0 {
+		return nil
+	}
+	flowsFile, err := writeFlowsFile(bridgeName, flows)
+	if err != nil {
+		return err
+	}
+	defer removeFlowsFile(flowsFile)
+	_, stderr, err = b.runOpenFlowCommand("--bundle", "add-flows", bridgeName, flowsFile)
+	if err != nil {
+		return fmt.Errorf("failed to add flows to the bridge %s: %v, %s", bridgeName, err, stderr)
+	}
+	return nil
+}
+
+// runOpenFlowCommand runs ovs-ofctl on the host, the bridge is reached through its OpenFlow management socket
+func (b *bridge) runOpenFlowCommand(args ...string) (string, string, error) {
+	return b.runHostCommand("ovs-ofctl", append([]string{"-O", openFlowVersion}, args...)...)
+}
+
+// runHostCommand runs the command in the root of the host without a shell, the arguments are passed as is
+func (b *bridge) runHostCommand(command string, args ...string) (string, string, error) {
+	if vars.InChroot {
+		return b.utilsHelper.RunCommand(command, args...)
+	}
+	return b.utilsHelper.RunCommand("chroot", append([]string{utils.GetHostExtension(), command}, args...)...)
+}
+
+// getOffloadedFlows returns the number of the datapath flows of the bridge which are offloaded to the hardware,
+// returns nil if the datapath flows can't be read.
+// The datapath flows are dumped at most once per offloadedFlowsRefreshInterval, the last number read is returned in between.
+func (b *bridge) getOffloadedFlows(bridgeName string) *int {
+	b.offloadedFlowsLock.Lock()
+	defer b.offloadedFlowsLock.Unlock()
+	if sample, ok := b.offloadedFlows[bridgeName]; ok && time.Since(sample.readTime) < offloadedFlowsRefreshInterval {
+		return sample.count
+	}
+	var count *int
+	stdout, stderr, err := b.runHostCommand("ovs-appctl", "dpif/dump-flows", "-m", bridgeName)
+	if err != nil {
+		log.Log.Error(err, "getOffloadedFlows(): failed to dump datapath flows of the bridge", "bridge", bridgeName, "stderr", stderr)
+	} else {
+		count = ptr.To(countOffloadedFlows(stdout))
+	}
+	if b.offloadedFlows == nil {
+		b.offloadedFlows = map[string]offloadedFlowsSample{}
+	}
+	b.offloadedFlows[bridgeName] = offloadedFlowsSample{count: count, readTime: time.Now()}
+	return count
+}
+
+// countOffloadedFlows parses output of the ovs-appctl dpif/dump-flows -m command,
+// the verbose output marks the flows offloaded to the hardware with "offloaded:yes"
+func countOffloadedFlows(dump string) int {
+	count := 0
+	for _, line := range strings.Split(dump, "\n") {
+		if strings.Contains(line, "offloaded:yes") {
+			count++
+		}
+	}
+	return count
+}
+
+// hasManagedFlowsDiff parses output of the ovs-ofctl diff-flows command.
+// flows which exist only in the file are prefixed with "+", flows which exist only on the bridge are prefixed with "-",
+// flows on the bridge without the operator's cookie are not managed by the operator and are ignored
+func hasManagedFlowsDiff(diff string) bool {
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "+") {
+			return true
+		}
+		if strings.HasPrefix(line, "-") && strings.Contains(line, "cookie="+consts.OVSManagedFlowsCookie) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeFlowsFile writes the flows marked with the operator's cookie to a file with a random name
+// in the directory of the operator on the host, only readable by root,
+// returns path to the file inside the host root
+func writeFlowsFile(bridgeName string, flows []string) (string, error) {
+	var content strings.Builder
+	for _, flow := range flows {
+		fmt.Fprintf(&content, "cookie=%s,%s\n", consts.OVSManagedFlowsCookie, flow)
+	}
+	dir := utils.GetHostExtensionPath(consts.OVSFlowsDirPath)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create directory for the flows files: %v", err)
+	}
+	f, err := os.CreateTemp(dir, fmt.Sprintf("%s-*.flows", bridgeName))
+	if err != nil {
+		return "", fmt.Errorf("failed to create flows file for the bridge %s: %v", bridgeName, err)
+	}
+	path := filepath.Join(consts.OVSFlowsDirPath, filepath.Base(f.Name()))
+	_, err = f.WriteString(content.String())
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		removeFlowsFile(path)
+		return "", fmt.Errorf("failed to write flows file for the bridge %s: %v", bridgeName, err)
+	}
+	return path, nil
+}
+
+func removeFlowsFile(path string) {
+	if err := os.Remove(utils.GetHostExtensionPath(path)); err != nil {
+		log.Log.Error(err, "removeFlowsFile(): failed to remove flows file", "path", path)
+	}
+}
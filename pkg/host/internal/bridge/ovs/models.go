@@ -24,8 +24,9 @@ import (
 
 // OpenvSwitchEntry represents some fields of the object in the Open_vSwitch table
 type OpenvSwitchEntry struct {
-	UUID    string   `ovsdb:"_uuid"`
-	Bridges []string `ovsdb:"bridges"`
+	UUID        string            `ovsdb:"_uuid"`
+	Bridges     []string          `ovsdb:"bridges"`
+	OtherConfig map[string]string `ovsdb:"other_config"`
 }
 
 // BridgeEntry represents some fields of the object in the Bridge table
@@ -50,6 +51,7 @@ type InterfaceEntry struct {
 	Name        string            `ovsdb:"name"`
 	Type        string            `ovsdb:"type"`
 	Error       *string           `ovsdb:"error"`
+	LinkState   *string           `ovsdb:"link_state"`
 	Options     map[string]string `ovsdb:"options"`
 	ExternalIDs map[string]string `ovsdb:"external_ids"`
 	OtherConfig map[string]string `ovsdb:"other_config"`
@@ -58,9 +60,27 @@ type InterfaceEntry struct {
 
 // PortEntry represents some fields of the object in the Port table
 type PortEntry struct {
-	UUID       string   `ovsdb:"_uuid"`
-	Name       string   `ovsdb:"name"`
-	Interfaces []string `ovsdb:"interfaces"`
+	UUID        string            `ovsdb:"_uuid"`
+	Name        string            `ovsdb:"name"`
+	Interfaces  []string          `ovsdb:"interfaces"`
+	BondMode    *string           `ovsdb:"bond_mode"`
+	LACP        *string           `ovsdb:"lacp"`
+	OtherConfig map[string]string `ovsdb:"other_config"`
+	QoS         *string           `ovsdb:"qos"`
+}
+
+// QoSEntry represents some fields of the object in the QoS table
+type QoSEntry struct {
+	UUID        string            `ovsdb:"_uuid"`
+	Type        string            `ovsdb:"type"`
+	Queues      map[int]string    `ovsdb:"queues"`
+	OtherConfig map[string]string `ovsdb:"other_config"`
+}
+
+// QueueEntry represents some fields of the object in the Queue table
+type QueueEntry struct {
+	UUID        string            `ovsdb:"_uuid"`
+	OtherConfig map[string]string `ovsdb:"other_config"`
 }
 
 // DatabaseModel returns the DatabaseModel object to be used in libovsdb
@@ -70,5 +90,7 @@ func DatabaseModel() (model.ClientDBModel, error) {
 		"Interface":    &InterfaceEntry{},
 		"Open_vSwitch": &OpenvSwitchEntry{},
 		"Port":         &PortEntry{},
+		"QoS":          &QoSEntry{},
+		"Queue":        &QueueEntry{},
 	})
 }
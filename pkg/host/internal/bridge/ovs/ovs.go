@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -82,10 +83,19 @@ type ovs struct {
 func (o *ovs) CreateOVSBridge(ctx context.Context, conf *sriovnetworkv1.OVSConfigExt) error {
 	ctx, cancel := setDefaultTimeout(ctx)
 	defer cancel()
-	if len(conf.Uplinks) != 1 {
-		return fmt.Errorf("unsupported configuration, uplinks list must contain one element")
+	if conf.Bond == nil && len(conf.Uplinks) != 1 {
+		return fmt.Errorf("unsupported configuration, uplinks list must contain one element if bond is not configured")
 	}
-	funcLog := log.Log.WithValues("bridge", conf.Name, "ifaceAddr", conf.Uplinks[0].PciAddress, "ifaceName", conf.Uplinks[0].Name)
+	if conf.Bond != nil && len(conf.Uplinks) == 0 {
+		return fmt.Errorf("unsupported configuration, uplinks list must not be empty if bond is configured")
+	}
+	ifaceAddrs := make([]string, 0, len(conf.Uplinks))
+	ifaceNames := make([]string, 0, len(conf.Uplinks))
+	for _, uplink := range conf.Uplinks {
+		ifaceAddrs = append(ifaceAddrs, uplink.PciAddress)
+		ifaceNames = append(ifaceNames, uplink.Name)
+	}
+	funcLog := log.Log.WithValues("bridge", conf.Name, "ifaceAddr", ifaceAddrs, "ifaceName", ifaceNames)
 	funcLog.V(1).Info("CreateOVSBridge(): start configuration of the OVS bridge")
 
 	dbClient, err := getClient(ctx)
@@ -110,10 +120,11 @@ func (o *ovs) CreateOVSBridge(ctx context.Context, conf *sriovnetworkv1.OVSConfi
 		}
 	}
 	keepBridge := false
+	var currentState *sriovnetworkv1.OVSConfigExt
 	if knownConfig != nil {
 		funcLog.V(2).Info("CreateOVSBridge(): configuration for the bridge found in the store")
 		// use knownConfig to query current state
-		currentState, err := o.getCurrentBridgeState(ctx, dbClient, knownConfig)
+		currentState, err = o.getCurrentBridgeState(ctx, dbClient, knownConfig)
 		if err != nil {
 			funcLog.Error(err, "CreateOVSBridge(): failed to query current bridge state")
 			return err
@@ -125,20 +136,36 @@ func (o *ovs) CreateOVSBridge(ctx context.Context, conf *sriovnetworkv1.OVSConfi
 				return nil
 			}
 			funcLog.V(2).Info("CreateOVSBridge(): bridge state differs from the current configuration, reconfiguration required")
-			keepBridge = equality.Semantic.DeepEqual(conf.Bridge, currentState.Bridge)
+			keepBridge = isSameOVSBridgeConfig(&conf.Bridge, &currentState.Bridge)
 		}
 	} else {
 		funcLog.V(2).Info("CreateOVSBridge(): configuration for the bridge not found in the store, create the bridge")
 	}
-	funcLog.V(2).Info("CreateOVSBridge(): ensure uplink is not attached to any bridge")
-	// removal of the bridge should also remove all interfaces that are attached to it.
-	// we need to remove interface with additional call even if keepBridge is false to make
-	// sure that the interface is not attached to a different OVS bridge
-	if err := o.deleteInterfaceByName(ctx, dbClient, conf.Uplinks[0].Name); err != nil {
-		funcLog.Error(err, "CreateOVSBridge(): failed to remove uplink interface")
-		return err
+	// uplinks are not re-created if the bridge is kept and they already have the right config
+	keepUplinks := keepBridge && equality.Semantic.DeepEqual(conf.Uplinks, currentState.Uplinks) &&
+		equality.Semantic.DeepEqual(conf.Bond, currentState.Bond)
+	if !keepUplinks {
+		funcLog.V(2).Info("CreateOVSBridge(): ensure uplinks are not attached to any bridge")
+		// removal of the bridge should also remove all interfaces that are attached to it.
+		// we need to remove interfaces with additional calls even if keepBridge is false to make
+		// sure that the interfaces are not attached to a different OVS bridge
+		for _, uplink := range conf.Uplinks {
+			if err := o.deleteInterfaceByName(ctx, dbClient, uplink.Name); err != nil {
+				funcLog.Error(err, "CreateOVSBridge(): failed to remove uplink interface", "uplink", uplink.Name)
+				return err
+			}
+		}
+		if conf.Bond != nil {
+			// the bond port can still exist if it contains interfaces which are not members of the bond anymore
+			if err := o.deletePortByName(ctx, dbClient, conf.Bond.Name); err != nil {
+				funcLog.Error(err, "CreateOVSBridge(): failed to remove bond port")
+				return err
+			}
+		}
 	}
 	if !keepBridge {
+		// the bridge is re-created with all its ports
+		currentState = nil
 		// make sure that bridge with provided name not exist
 		if err := o.deleteBridgeByName(ctx, dbClient, conf.Name); err != nil {
 			funcLog.Error(err, "CreateOVSBridge(): failed to remove existing bridge")
@@ -174,22 +201,266 @@ func (o *ovs) CreateOVSBridge(ctx context.Context, conf *sriovnetworkv1.OVSConfi
 		funcLog.Error(err, "CreateOVSBridge(): failed to add internal interface to the bridge")
 		return err
 	}
-	funcLog.V(2).Info("CreateOVSBridge(): add uplink interface to the bridge")
-	if err := o.addInterface(ctx, dbClient, bridge, &InterfaceEntry{
-		Name:        conf.Uplinks[0].Name,
+	if err := o.ensurePorts(ctx, funcLog, dbClient, bridge, conf, knownConfig, currentState); err != nil {
+		funcLog.Error(err, "CreateOVSBridge(): failed to configure additional ports of the bridge")
+		return err
+	}
+	if keepUplinks {
+		funcLog.V(2).Info("CreateOVSBridge(): uplinks already match current configuration")
+	} else if conf.Bond != nil {
+		funcLog.V(2).Info("CreateOVSBridge(): add bond with uplink interfaces to the bridge", "bond", conf.Bond.Name)
+		ifaces := make([]*InterfaceEntry, 0, len(conf.Uplinks))
+		for i := range conf.Uplinks {
+			ifaces = append(ifaces, newUplinkInterfaceEntry(&conf.Uplinks[i]))
+		}
+		if err := o.addBond(ctx, dbClient, bridge, conf.Bond, ifaces); err != nil {
+			funcLog.Error(err, "CreateOVSBridge(): failed to add bond to the bridge")
+			return err
+		}
+	} else {
+		funcLog.V(2).Info("CreateOVSBridge(): add uplink interface to the bridge")
+		if err := o.addInterface(ctx, dbClient, bridge, newUplinkInterfaceEntry(&conf.Uplinks[0])); err != nil {
+			funcLog.Error(err, "CreateOVSBridge(): failed to add uplink interface to the bridge")
+			return err
+		}
+	}
+	if err := o.ensureQoS(ctx, funcLog, dbClient, conf); err != nil {
+		funcLog.Error(err, "CreateOVSBridge(): failed to configure QoS of the uplink port")
+		return err
+	}
+	return nil
+}
+
+// returns true if the bridge level settings are the same, QoS and flows are not taken into account
+// because they can be updated without re-creation of the bridge
+func isSameOVSBridgeConfig(x, y *sriovnetworkv1.OVSBridgeConfig) bool {
+	xCopy, yCopy := *x, *y
+	xCopy.QoS, yCopy.QoS = nil, nil
+	xCopy.Flows, yCopy.Flows = nil, nil
+	return equality.Semantic.DeepEqual(xCopy, yCopy)
+}
+
+// returns the port which contains uplinks of the bridge, this is the bond port if the bond is configured
+func (o *ovs) getUplinkPort(ctx context.Context, dbClient client.Client, conf *sriovnetworkv1.OVSConfigExt) (*PortEntry, error) {
+	if conf.Bond != nil {
+		return o.getPortByName(ctx, dbClient, conf.Bond.Name)
+	}
+	iface, err := o.getInterfaceByName(ctx, dbClient, conf.Uplinks[0].Name)
+	if err != nil {
+		return nil, err
+	}
+	if iface == nil {
+		return nil, nil
+	}
+	return o.getPortByInterface(ctx, dbClient, iface)
+}
+
+// configures QoS of the uplink port of the bridge,
+// QoS and queues which were previously attached to the port are removed
+func (o *ovs) ensureQoS(ctx context.Context, funcLog logr.Logger, dbClient client.Client, conf *sriovnetworkv1.OVSConfigExt) error {
+	port, err := o.getUplinkPort(ctx, dbClient, conf)
+	if err != nil {
+		return err
+	}
+	if port == nil {
+		return fmt.Errorf("can't find uplink port of the bridge")
+	}
+	currentQoS, err := o.getQoSConfig(ctx, dbClient, port)
+	if err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(conf.Bridge.QoS, currentQoS) {
+		funcLog.V(2).Info("ensureQoS(): QoS of the uplink port already match current configuration")
+		return nil
+	}
+	if port.QoS != nil {
+		funcLog.V(2).Info("ensureQoS(): remove QoS from the uplink port", "port", port.Name)
+		if err := o.removeQoS(ctx, dbClient, port); err != nil {
+			return err
+		}
+	}
+	if conf.Bridge.QoS == nil {
+		return nil
+	}
+	funcLog.V(2).Info("ensureQoS(): set QoS for the uplink port", "port", port.Name, "qos", conf.Bridge.QoS)
+	var operations [][]ovsdb.Operation
+	qos := &QoSEntry{
 		UUID:        uuid.NewString(),
-		Type:        conf.Uplinks[0].Interface.Type,
-		Options:     conf.Uplinks[0].Interface.Options,
-		ExternalIDs: conf.Uplinks[0].Interface.ExternalIDs,
-		OtherConfig: conf.Uplinks[0].Interface.OtherConfig,
-		MTURequest:  conf.Uplinks[0].Interface.MTURequest,
-	}); err != nil {
-		funcLog.Error(err, "CreateOVSBridge(): failed to add uplink interface to the bridge")
+		Type:        conf.Bridge.QoS.Type,
+		OtherConfig: conf.Bridge.QoS.OtherConfig,
+		Queues:      map[int]string{},
+	}
+	for _, q := range conf.Bridge.QoS.Queues {
+		queue := &QueueEntry{UUID: uuid.NewString(), OtherConfig: q.OtherConfig}
+		queueCreateOps, err := dbClient.Create(queue)
+		if err != nil {
+			return fmt.Errorf("failed to prepare operation for queue creation: %v", err)
+		}
+		operations = append(operations, queueCreateOps)
+		qos.Queues[q.ID] = queue.UUID
+	}
+	qosCreateOps, err := dbClient.Create(qos)
+	if err != nil {
+		return fmt.Errorf("failed to prepare operation for QoS creation: %v", err)
+	}
+	operations = append(operations, qosCreateOps)
+	port.QoS = &qos.UUID
+	portUpdateOps, err := dbClient.Where(port).Update(port, &port.QoS)
+	if err != nil {
+		return fmt.Errorf("failed to prepare operation for port update: %v", err)
+	}
+	operations = append(operations, portUpdateOps)
+	if err := o.execTransaction(ctx, dbClient, operations...); err != nil {
+		return fmt.Errorf("failed to set QoS for port %s: %v", port.Name, err)
+	}
+	return nil
+}
+
+// detaches QoS from the port and removes the QoS with its queues
+func (o *ovs) removeQoS(ctx context.Context, dbClient client.Client, port *PortEntry) error {
+	delQoSOps, err := o.deleteQoSOps(ctx, dbClient, port)
+	if err != nil {
 		return err
 	}
+	port.QoS = nil
+	portUpdateOps, err := dbClient.Where(port).Update(port, &port.QoS)
+	if err != nil {
+		return fmt.Errorf("failed to prepare operation for port update: %v", err)
+	}
+	if err := o.execTransaction(ctx, dbClient, portUpdateOps, delQoSOps); err != nil {
+		return fmt.Errorf("failed to remove QoS from port %s: %v", port.Name, err)
+	}
+	return nil
+}
+
+// returns QoS configuration of the port, queues are sorted by ID
+func (o *ovs) getQoSConfig(ctx context.Context, dbClient client.Client, port *PortEntry) (*sriovnetworkv1.OVSQoSConfig, error) {
+	qos, err := o.getQoSByPort(ctx, dbClient, port)
+	if err != nil || qos == nil {
+		return nil, err
+	}
+	result := &sriovnetworkv1.OVSQoSConfig{Type: qos.Type, OtherConfig: qos.OtherConfig}
+	queueIDs := make([]int, 0, len(qos.Queues))
+	for id := range qos.Queues {
+		queueIDs = append(queueIDs, id)
+	}
+	slices.Sort(queueIDs)
+	for _, id := range queueIDs {
+		queue := &QueueEntry{UUID: qos.Queues[id]}
+		if err := dbClient.Get(ctx, queue); err != nil {
+			return nil, fmt.Errorf("get call for the queue %d of the port %s failed: %v", id, port.Name, err)
+		}
+		result.Queues = append(result.Queues, sriovnetworkv1.OVSQueueConfig{ID: id, OtherConfig: queue.OtherConfig})
+	}
+	return result, nil
+}
+
+func (o *ovs) getQoSByPort(ctx context.Context, dbClient client.Client, port *PortEntry) (*QoSEntry, error) {
+	if port.QoS == nil {
+		return nil, nil
+	}
+	qos := &QoSEntry{UUID: *port.QoS}
+	if err := dbClient.Get(ctx, qos); err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return nil, nil
+		} else {
+			return nil, fmt.Errorf("get call for the QoS of the port %s failed: %v", port.Name, err)
+		}
+	}
+	return qos, nil
+}
+
+// returns operations to delete QoS and queues of the port,
+// QoS and Queue are root tables in OVSDB and their rows are not removed automatically
+func (o *ovs) deleteQoSOps(ctx context.Context, dbClient client.Client, port *PortEntry) ([]ovsdb.Operation, error) {
+	qos, err := o.getQoSByPort(ctx, dbClient, port)
+	if err != nil || qos == nil {
+		return nil, err
+	}
+	operations, err := dbClient.Where(qos).Delete()
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare operation for QoS deletion: %v", err)
+	}
+	for _, queueUUID := range qos.Queues {
+		delQueueOps, err := dbClient.Where(&QueueEntry{UUID: queueUUID}).Delete()
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare operation for queue deletion: %v", err)
+		}
+		operations = append(operations, delQueueOps...)
+	}
+	return operations, nil
+}
+
+// returns Interface table entry for the uplink
+func newUplinkInterfaceEntry(uplink *sriovnetworkv1.OVSUplinkConfigExt) *InterfaceEntry {
+	return &InterfaceEntry{
+		Name:        uplink.Name,
+		UUID:        uuid.NewString(),
+		Type:        uplink.Interface.Type,
+		Options:     uplink.Interface.Options,
+		ExternalIDs: uplink.Interface.ExternalIDs,
+		OtherConfig: uplink.Interface.OtherConfig,
+		MTURequest:  uplink.Interface.MTURequest,
+	}
+}
+
+// returns Interface table entry for the additional port of the bridge
+func newPortInterfaceEntry(port *sriovnetworkv1.OVSPortConfig) *InterfaceEntry {
+	iface := &InterfaceEntry{
+		Name:        port.Name,
+		UUID:        uuid.NewString(),
+		Type:        port.Type,
+		ExternalIDs: port.ExternalIDs,
+		MTURequest:  port.MTURequest,
+	}
+	if port.Peer != "" {
+		iface.Options = map[string]string{"peer": port.Peer}
+	}
+	return iface
+}
+
+// ensurePorts removes additional ports which were created for the bridge but are not part of the configuration anymore,
+// creates (or re-creates) additional ports which are missing in the current state or have a different configuration
+func (o *ovs) ensurePorts(ctx context.Context, funcLog logr.Logger, dbClient client.Client, bridge *BridgeEntry,
+	conf, knownConfig, currentState *sriovnetworkv1.OVSConfigExt) error {
+	if knownConfig != nil {
+		for _, knownPort := range knownConfig.Ports {
+			if slices.ContainsFunc(conf.Ports, func(p sriovnetworkv1.OVSPortConfig) bool { return p.Name == knownPort.Name }) {
+				continue
+			}
+			funcLog.V(2).Info("CreateOVSBridge(): remove port which is not part of the configuration anymore", "port", knownPort.Name)
+			if err := o.deleteInterfaceByName(ctx, dbClient, knownPort.Name); err != nil {
+				return err
+			}
+		}
+	}
+	for i := range conf.Ports {
+		port := &conf.Ports[i]
+		if currentState != nil && slices.ContainsFunc(currentState.Ports, func(p sriovnetworkv1.OVSPortConfig) bool {
+			return isSameOVSPortConfig(port, &p)
+		}) {
+			continue
+		}
+		funcLog.V(2).Info("CreateOVSBridge(): add port to the bridge", "port", port.Name, "type", port.Type)
+		// make sure that the port is not attached to any bridge
+		if err := o.deleteInterfaceByName(ctx, dbClient, port.Name); err != nil {
+			return err
+		}
+		if err := o.addInterface(ctx, dbClient, bridge, newPortInterfaceEntry(port)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// returns true if OVSDB configuration of the ports is the same,
+// IP addresses are configured on the host and are not taken into account
+func isSameOVSPortConfig(x, y *sriovnetworkv1.OVSPortConfig) bool {
+	xCopy, yCopy := *x, *y
+	xCopy.IPAddresses, yCopy.IPAddresses = nil, nil
+	return equality.Semantic.DeepEqual(xCopy, yCopy)
+}
+
 func (o *ovs) ensureInternalInterface(ctx context.Context, funcLog logr.Logger, dbClient client.Client, bridge *BridgeEntry) error {
 	funcLog.V(2).Info("CreateOVSBridge(): Check if internal interface exists in the bridge")
 	existingIface, err := o.getInterfaceByName(ctx, dbClient, bridge.Name)
@@ -253,9 +524,15 @@ func (o *ovs) GetOVSBridges(ctx context.Context) ([]sriovnetworkv1.OVSConfigExt,
 			funcLog.Error(err, "GetOVSBridges(): failed to get state for the managed bridge", "bridge", knownConfig.Name)
 			return nil, err
 		}
-		if currentState != nil {
-			result = append(result, *currentState)
+		if currentState == nil {
+			continue
+		}
+		currentState.State, err = o.getBridgeObservedState(ctx, dbClient, knownConfig)
+		if err != nil {
+			funcLog.Error(err, "GetOVSBridges(): failed to get observed state for the managed bridge", "bridge", knownConfig.Name)
+			return nil, err
 		}
+		result = append(result, *currentState)
 	}
 	// always return bridges in the same order to make sure that the caller can easily compare
 	// two results returned by the GetOVSBridges function
@@ -326,9 +603,16 @@ func (o *ovs) RemoveInterfaceFromOVSBridge(ctx context.Context, pciAddress strin
 		return fmt.Errorf("failed to read data from store: %v", err)
 	}
 	var relatedBridges []*sriovnetworkv1.OVSConfigExt
+	var uplinkName string
 	for _, kc := range knownConfigs {
-		if len(kc.Uplinks) > 0 && kc.Uplinks[0].PciAddress == pciAddress && kc.Uplinks[0].Name != "" {
-			relatedBridges = append(relatedBridges, kc)
+		for _, uplink := range kc.Uplinks {
+			if uplink.PciAddress == pciAddress && uplink.Name != "" {
+				if len(relatedBridges) == 0 {
+					uplinkName = uplink.Name
+				}
+				relatedBridges = append(relatedBridges, kc)
+				break
+			}
 		}
 	}
 	if len(relatedBridges) == 0 {
@@ -363,7 +647,7 @@ func (o *ovs) RemoveInterfaceFromOVSBridge(ctx context.Context, pciAddress strin
 	}
 
 	funcLog.V(2).Info("RemoveInterfaceFromOVSBridge(): remove interface from the bridge")
-	if err := o.deleteInterfaceByName(ctx, dbClient, brConf.Uplinks[0].Name); err != nil {
+	if err := o.deleteInterfaceByName(ctx, dbClient, uplinkName); err != nil {
 		funcLog.Error(err, "RemoveInterfaceFromOVSBridge(): failed to remove interface from the bridge", "bridge", brConf.Name)
 		return err
 	}
@@ -395,6 +679,18 @@ func (o *ovs) getInterfaceByName(ctx context.Context, dbClient client.Client, na
 	return iface, nil
 }
 
+func (o *ovs) getPortByName(ctx context.Context, dbClient client.Client, name string) (*PortEntry, error) {
+	port := &PortEntry{Name: name}
+	if err := dbClient.Get(ctx, port); err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return nil, nil
+		} else {
+			return nil, fmt.Errorf("get call for the port %s failed: %v", name, err)
+		}
+	}
+	return port, nil
+}
+
 func (o *ovs) getPortByInterface(ctx context.Context, dbClient client.Client, iface *InterfaceEntry) (*PortEntry, error) {
 	portEntry := &PortEntry{}
 	portEntryList := []*PortEntry{}
@@ -456,11 +752,37 @@ func (o *ovs) createBridge(ctx context.Context, dbClient client.Client, br *Brid
 // add interface with provided configuration to the provided bridge
 // and check that interface has no error for the next 2 seconds
 func (o *ovs) addInterface(ctx context.Context, dbClient client.Client, br *BridgeEntry, iface *InterfaceEntry) error {
-	addInterfaceOPs, err := dbClient.Create(iface)
-	if err != nil {
-		return fmt.Errorf("failed to prepare operation for interface creation: %v", err)
+	return o.addPort(ctx, dbClient, br, &PortEntry{Name: iface.Name, UUID: uuid.NewString()}, iface)
+}
+
+// add bond port with provided configuration and member interfaces to the provided bridge
+// and check that interfaces have no error for the next 2 seconds
+func (o *ovs) addBond(ctx context.Context, dbClient client.Client, br *BridgeEntry,
+	bond *sriovnetworkv1.OVSBondConfigExt, ifaces []*InterfaceEntry) error {
+	port := &PortEntry{Name: bond.Name, UUID: uuid.NewString(), OtherConfig: bond.OtherConfig}
+	if bond.Mode != "" {
+		mode := bond.Mode
+		port.BondMode = &mode
+	}
+	if bond.LACP != "" {
+		lacp := bond.LACP
+		port.LACP = &lacp
+	}
+	return o.addPort(ctx, dbClient, br, port, ifaces...)
+}
+
+// add port with provided configuration and interfaces to the provided bridge
+// and check that interfaces have no error for the next 2 seconds
+func (o *ovs) addPort(ctx context.Context, dbClient client.Client, br *BridgeEntry, port *PortEntry, ifaces ...*InterfaceEntry) error {
+	var addInterfaceOPs []ovsdb.Operation
+	for _, iface := range ifaces {
+		ops, err := dbClient.Create(iface)
+		if err != nil {
+			return fmt.Errorf("failed to prepare operation for interface creation: %v", err)
+		}
+		addInterfaceOPs = append(addInterfaceOPs, ops...)
+		port.Interfaces = append(port.Interfaces, iface.UUID)
 	}
-	port := &PortEntry{Name: iface.Name, UUID: uuid.NewString(), Interfaces: []string{iface.UUID}}
 	addPortOPs, err := dbClient.Create(port)
 	if err != nil {
 		return fmt.Errorf("failed to prepare operation for port creation: %v", err)
@@ -476,17 +798,19 @@ func (o *ovs) addInterface(ctx context.Context, dbClient client.Client, br *Brid
 	if err := o.execTransaction(ctx, dbClient, addInterfaceOPs, addPortOPs, bridgeMutateOps); err != nil {
 		return fmt.Errorf("bridge add interface failed: %v", err)
 	}
-	// check that interface has no error right after creation
+	// check that interfaces have no error right after creation
 	for i := 0; i < interfaceErrorCheckCount; i++ {
 		select {
 		case <-time.After(interfaceErrorCheckInterval):
 		case <-ctx.Done():
 		}
-		if err := dbClient.Get(ctx, iface); err != nil {
-			return fmt.Errorf("failed to read interface after creation: %v", err)
-		}
-		if iface.Error != nil {
-			return fmt.Errorf("created interface is in error state: %s", *iface.Error)
+		for _, iface := range ifaces {
+			if err := dbClient.Get(ctx, iface); err != nil {
+				return fmt.Errorf("failed to read interface %s after creation: %v", iface.Name, err)
+			}
+			if iface.Error != nil {
+				return fmt.Errorf("created interface %s is in error state: %s", iface.Name, *iface.Error)
+			}
 		}
 	}
 	return nil
@@ -501,6 +825,21 @@ func (o *ovs) deleteBridgeByName(ctx context.Context, dbClient client.Client, br
 	if br == nil {
 		return nil
 	}
+	// QoS is not removed together with the ports of the bridge
+	for _, portUUID := range br.Ports {
+		port := &PortEntry{UUID: portUUID}
+		if err := dbClient.Get(ctx, port); err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				continue
+			}
+			return fmt.Errorf("get call for the port %s failed: %v", portUUID, err)
+		}
+		if port.QoS != nil {
+			if err := o.removeQoS(ctx, dbClient, port); err != nil {
+				return err
+			}
+		}
+	}
 	brDeleteOps, err := dbClient.Where(br).Delete()
 	if err != nil {
 		return fmt.Errorf("failed to prepare operation for bridge deletion: %v", err)
@@ -543,12 +882,29 @@ func (o *ovs) deleteInterfaceByName(ctx context.Context, dbClient client.Client,
 	if err != nil {
 		return err
 	}
-	if port != nil {
+	if port != nil && len(port.Interfaces) > 1 {
+		// the port is a bond, keep the port with the other interfaces
+		portMutateOps, err := dbClient.Where(port).Mutate(port, model.Mutation{
+			Field:   &port.Interfaces,
+			Mutator: ovsdb.MutateOperationDelete,
+			Value:   []string{iface.UUID},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to prepare operation for port mutate: %v", err)
+		}
+		operations = append(operations, portMutateOps)
+	} else if port != nil {
 		delPortOPs, err := dbClient.Where(port).Delete()
 		if err != nil {
 			return fmt.Errorf("failed to prepare operation for port deletion: %v", err)
 		}
 		operations = append(operations, delPortOPs)
+		if port.QoS != nil {
+			// QoS is not removed together with the port
+			if err := o.removeQoS(ctx, dbClient, port); err != nil {
+				return err
+			}
+		}
 
 		bridge, err := o.getBridgeByPort(ctx, dbClient, port)
 		if err != nil {
@@ -572,6 +928,49 @@ func (o *ovs) deleteInterfaceByName(ctx context.Context, dbClient client.Client,
 	return nil
 }
 
+// delete port by the name, interfaces of the port are removed by OVSDB
+// when they are not referenced anymore
+func (o *ovs) deletePortByName(ctx context.Context, dbClient client.Client, portName string) error {
+	port, err := o.getPortByName(ctx, dbClient, portName)
+	if err != nil {
+		return err
+	}
+	if port == nil {
+		return nil
+	}
+	operations := [][]ovsdb.Operation{}
+	delPortOPs, err := dbClient.Where(port).Delete()
+	if err != nil {
+		return fmt.Errorf("failed to prepare operation for port deletion: %v", err)
+	}
+	operations = append(operations, delPortOPs)
+	if port.QoS != nil {
+		// QoS is not removed together with the port
+		if err := o.removeQoS(ctx, dbClient, port); err != nil {
+			return err
+		}
+	}
+	bridge, err := o.getBridgeByPort(ctx, dbClient, port)
+	if err != nil {
+		return err
+	}
+	if bridge != nil {
+		bridgeMutateOps, err := dbClient.Where(bridge).Mutate(bridge, model.Mutation{
+			Field:   &bridge.Ports,
+			Mutator: ovsdb.MutateOperationDelete,
+			Value:   []string{port.UUID},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to prepare operation for bridge mutate: %v", err)
+		}
+		operations = append(operations, bridgeMutateOps)
+	}
+	if err := o.execTransaction(ctx, dbClient, operations...); err != nil {
+		return fmt.Errorf("failed to remove port %s: %v", port.Name, err)
+	}
+	return nil
+}
+
 // execute multiple prepared OVSDB operations as a single transaction
 func (o *ovs) execTransaction(ctx context.Context, dbClient client.Client, ops ...[]ovsdb.Operation) error {
 	var operations []ovsdb.Operation
@@ -615,24 +1014,119 @@ func (o *ovs) getCurrentBridgeState(ctx context.Context, dbClient client.Client,
 			ExternalIDs: updateMap(knownConfig.Bridge.ExternalIDs, bridge.ExternalIDs),
 			OtherConfig: updateMap(knownConfig.Bridge.OtherConfig, bridge.OtherConfig),
 			FailMode:    failMode,
+			// flows are not stored in OVSDB, the configured flows are returned,
+			// the caller reports them only if they are installed on the bridge
+			Flows: slices.Clone(knownConfig.Bridge.Flows),
 		},
 	}
+	for i := range knownConfig.Ports {
+		port, err := o.getCurrentPortState(ctx, dbClient, bridge, &knownConfig.Ports[i])
+		if err != nil {
+			return nil, err
+		}
+		if port != nil {
+			currentConfig.Ports = append(currentConfig.Ports, *port)
+		}
+	}
 	if len(knownConfig.Uplinks) == 0 {
 		return currentConfig, nil
 	}
-	knownConfigUplink := knownConfig.Uplinks[0]
+	var bondPort *PortEntry
+	if knownConfig.Bond != nil {
+		bondPort, err = o.getPortByName(ctx, dbClient, knownConfig.Bond.Name)
+		if err != nil {
+			return nil, err
+		}
+		if bondPort == nil || !bridge.HasPort(bondPort.UUID) {
+			// bond not found or belongs to a wrong bridge, do not include bond and uplinks config to
+			// the current bridge state to let the operator try to fix this
+			return currentConfig, nil
+		}
+		currentConfig.Bond = &sriovnetworkv1.OVSBondConfigExt{
+			Name:        bondPort.Name,
+			OtherConfig: updateMap(knownConfig.Bond.OtherConfig, bondPort.OtherConfig),
+		}
+		if bondPort.BondMode != nil {
+			currentConfig.Bond.Mode = *bondPort.BondMode
+		}
+		if bondPort.LACP != nil {
+			currentConfig.Bond.LACP = *bondPort.LACP
+		}
+	}
+	for i := range knownConfig.Uplinks {
+		uplink, err := o.getCurrentUplinkState(ctx, dbClient, bridge, bondPort, &knownConfig.Uplinks[i])
+		if err != nil {
+			return nil, err
+		}
+		if uplink != nil {
+			currentConfig.Uplinks = append(currentConfig.Uplinks, *uplink)
+		}
+	}
+	if len(currentConfig.Uplinks) == 0 {
+		return currentConfig, nil
+	}
+	uplinkPort := bondPort
+	if uplinkPort == nil {
+		uplinkPort, err = o.getUplinkPort(ctx, dbClient, currentConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if uplinkPort != nil {
+		currentConfig.Bridge.QoS, err = o.getQoSConfig(ctx, dbClient, uplinkPort)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return currentConfig, nil
+}
+
+// return observed state of the bridge, the state is reported for all known uplinks,
+// including the uplinks in error state which are not included to the current bridge configuration
+func (o *ovs) getBridgeObservedState(ctx context.Context, dbClient client.Client, knownConfig *sriovnetworkv1.OVSConfigExt) (*sriovnetworkv1.OVSBridgeState, error) {
+	rootObj, err := o.getRootObj(ctx, dbClient)
+	if err != nil {
+		return nil, err
+	}
+	state := &sriovnetworkv1.OVSBridgeState{HwOffload: rootObj.OtherConfig["hw-offload"]}
+	for _, uplink := range knownConfig.Uplinks {
+		iface, err := o.getInterfaceByName(ctx, dbClient, uplink.Name)
+		if err != nil {
+			return nil, err
+		}
+		ifaceState := sriovnetworkv1.OVSInterfaceState{PciAddress: uplink.PciAddress, Name: uplink.Name}
+		if iface == nil {
+			ifaceState.Error = "interface not found in OVSDB"
+		} else {
+			if iface.LinkState != nil {
+				ifaceState.LinkState = *iface.LinkState
+			}
+			if iface.Error != nil {
+				ifaceState.Error = *iface.Error
+			}
+		}
+		state.Uplinks = append(state.Uplinks, ifaceState)
+	}
+	return state, nil
+}
+
+// return current state of the uplink interface, returns nil if the uplink is not attached
+// to the bridge (or to the bond port if bondPort is not nil) or if it is in error state
+func (o *ovs) getCurrentUplinkState(ctx context.Context, dbClient client.Client, bridge *BridgeEntry,
+	bondPort *PortEntry, knownConfigUplink *sriovnetworkv1.OVSUplinkConfigExt) (*sriovnetworkv1.OVSUplinkConfigExt, error) {
+	funcLog := log.Log.WithValues("bridge", bridge.Name)
 	iface, err := o.getInterfaceByName(ctx, dbClient, knownConfigUplink.Name)
 	if err != nil {
 		return nil, err
 	}
 	if iface == nil {
-		return currentConfig, nil
+		return nil, nil
 	}
 
 	if iface.Error != nil {
 		funcLog.V(2).Info("getCurrentBridgeState(): interface has an error, remove it from the bridge state", "interface", iface.Name, "error", iface.Error)
 		// interface has an error, do not report info about it to let the operator try to recreate it
-		return currentConfig, nil
+		return nil, nil
 	}
 
 	port, err := o.getPortByInterface(ctx, dbClient, iface)
@@ -640,15 +1134,15 @@ func (o *ovs) getCurrentBridgeState(ctx context.Context, dbClient client.Client,
 		return nil, err
 	}
 	if port == nil {
-		return currentConfig, nil
+		return nil, nil
 	}
 
-	if !bridge.HasPort(port.UUID) {
-		// interface belongs to a wrong bridge, do not include uplink config to
+	if (bondPort != nil && port.UUID != bondPort.UUID) || !bridge.HasPort(port.UUID) {
+		// interface belongs to a wrong bridge or is not a member of the bond, do not include uplink config to
 		// the current bridge state to let the operator try to fix this
-		return currentConfig, nil
+		return nil, nil
 	}
-	currentConfig.Uplinks = []sriovnetworkv1.OVSUplinkConfigExt{{
+	uplink := &sriovnetworkv1.OVSUplinkConfigExt{
 		PciAddress: knownConfigUplink.PciAddress,
 		Name:       knownConfigUplink.Name,
 		Interface: sriovnetworkv1.OVSInterfaceConfig{
@@ -657,12 +1151,50 @@ func (o *ovs) getCurrentBridgeState(ctx context.Context, dbClient client.Client,
 			Options:     updateMap(knownConfigUplink.Interface.Options, iface.Options),
 			OtherConfig: updateMap(knownConfigUplink.Interface.OtherConfig, iface.OtherConfig),
 		},
-	}}
+	}
 	if iface.MTURequest != nil {
 		mtu := *iface.MTURequest
-		currentConfig.Uplinks[0].Interface.MTURequest = &mtu
+		uplink.Interface.MTURequest = &mtu
 	}
-	return currentConfig, nil
+	return uplink, nil
+}
+
+// return current state of the additional port of the bridge, returns nil if the port is not attached
+// to the bridge or if it is in error state
+func (o *ovs) getCurrentPortState(ctx context.Context, dbClient client.Client, bridge *BridgeEntry,
+	knownPort *sriovnetworkv1.OVSPortConfig) (*sriovnetworkv1.OVSPortConfig, error) {
+	iface, err := o.getInterfaceByName(ctx, dbClient, knownPort.Name)
+	if err != nil {
+		return nil, err
+	}
+	if iface == nil {
+		return nil, nil
+	}
+	if iface.Error != nil {
+		log.Log.V(2).Info("getCurrentBridgeState(): port has an error, remove it from the bridge state",
+			"bridge", bridge.Name, "port", iface.Name, "error", iface.Error)
+		return nil, nil
+	}
+	port, err := o.getPortByInterface(ctx, dbClient, iface)
+	if err != nil {
+		return nil, err
+	}
+	if port == nil || !bridge.HasPort(port.UUID) {
+		return nil, nil
+	}
+	current := &sriovnetworkv1.OVSPortConfig{
+		Name:        iface.Name,
+		Type:        iface.Type,
+		Peer:        iface.Options["peer"],
+		ExternalIDs: updateMap(knownPort.ExternalIDs, iface.ExternalIDs),
+		// IP addresses are not stored in OVSDB, they are reported by the caller from the host
+		IPAddresses: slices.Clone(knownPort.IPAddresses),
+	}
+	if iface.MTURequest != nil {
+		mtu := *iface.MTURequest
+		current.MTURequest = &mtu
+	}
+	return current, nil
 }
 
 func (o *ovs) getRootObj(ctx context.Context, dbClient client.Client) (*OpenvSwitchEntry, error) {
@@ -735,6 +1267,8 @@ func getClient(ctx context.Context) (client.Client, error) {
 	bridgeEntry := &BridgeEntry{}
 	interfaceEntry := &InterfaceEntry{}
 	portEntry := &PortEntry{}
+	qosEntry := &QoSEntry{}
+	queueEntry := &QueueEntry{}
 	clientDBModel, err := DatabaseModel()
 	if err != nil {
 		return nil, fmt.Errorf("can't create client DB model: %v", err)
@@ -760,6 +1294,7 @@ func getClient(ctx context.Context) (client.Client, error) {
 		client.WithTable(openvSwitchEntry,
 			&openvSwitchEntry.UUID,
 			&openvSwitchEntry.Bridges,
+			&openvSwitchEntry.OtherConfig,
 		),
 		client.WithTable(bridgeEntry,
 			&bridgeEntry.UUID,
@@ -775,6 +1310,7 @@ func getClient(ctx context.Context) (client.Client, error) {
 			&interfaceEntry.Name,
 			&interfaceEntry.Type,
 			&interfaceEntry.Error,
+			&interfaceEntry.LinkState,
 			&interfaceEntry.Options,
 			&interfaceEntry.ExternalIDs,
 			&interfaceEntry.OtherConfig,
@@ -784,6 +1320,20 @@ func getClient(ctx context.Context) (client.Client, error) {
 			&portEntry.UUID,
 			&portEntry.Name,
 			&portEntry.Interfaces,
+			&portEntry.BondMode,
+			&portEntry.LACP,
+			&portEntry.OtherConfig,
+			&portEntry.QoS,
+		),
+		client.WithTable(qosEntry,
+			&qosEntry.UUID,
+			&qosEntry.Type,
+			&qosEntry.Queues,
+			&qosEntry.OtherConfig,
+		),
+		client.WithTable(queueEntry,
+			&queueEntry.UUID,
+			&queueEntry.OtherConfig,
 		),
 	))
 	if err != nil {
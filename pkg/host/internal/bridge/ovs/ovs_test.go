@@ -31,6 +31,7 @@ import (
 	"github.com/ovn-kubernetes/libovsdb/ovsdb"
 	"github.com/ovn-kubernetes/libovsdb/server"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -68,11 +69,37 @@ func getManagedBridges() map[string]*sriovnetworkv1.OVSConfigExt {
 	}
 }
 
+func getManagedBondBridge() *sriovnetworkv1.OVSConfigExt {
+	return &sriovnetworkv1.OVSConfigExt{
+		Name: "br-0000_d8_00.0",
+		Bridge: sriovnetworkv1.OVSBridgeConfig{
+			DatapathType: "netdev",
+		},
+		Uplinks: []sriovnetworkv1.OVSUplinkConfigExt{{
+			PciAddress: "0000:d8:00.0",
+			Name:       "enp216s0f0np0",
+			Interface:  sriovnetworkv1.OVSInterfaceConfig{Type: "dpdk"},
+		}, {
+			PciAddress: "0000:d8:00.1",
+			Name:       "enp216s0f1np1",
+			Interface:  sriovnetworkv1.OVSInterfaceConfig{Type: "dpdk"},
+		}},
+		Bond: &sriovnetworkv1.OVSBondConfigExt{
+			Name:        "br-0000_d8_00.0-bond",
+			Mode:        "balance-tcp",
+			LACP:        "active",
+			OtherConfig: map[string]string{"lacp-time": "fast"},
+		},
+	}
+}
+
 type testDBEntries struct {
 	OpenVSwitch []*OpenvSwitchEntry
 	Bridge      []*BridgeEntry
 	Port        []*PortEntry
 	Interface   []*InterfaceEntry
+	QoS         []*QoSEntry
+	Queue       []*QueueEntry
 }
 
 func (t *testDBEntries) GetCreateOperations(c client.Client) []ovsdb.Operation {
@@ -91,6 +118,12 @@ func (t *testDBEntries) GetCreateOperations(c client.Client) []ovsdb.Operation {
 	for _, o := range t.Interface {
 		mdls = append(mdls, o)
 	}
+	for _, o := range t.QoS {
+		mdls = append(mdls, o)
+	}
+	for _, o := range t.Queue {
+		mdls = append(mdls, o)
+	}
 	for _, e := range mdls {
 		if e != nil {
 			o, err := c.Create(e)
@@ -137,12 +170,53 @@ func getDefaultInitialDBContent() *testDBEntries {
 	}
 }
 
+func getBondInitialDBContent() *testDBEntries {
+	iface0 := &InterfaceEntry{Name: "enp216s0f0np0", UUID: uuid.NewString(), Type: "dpdk"}
+	iface1 := &InterfaceEntry{Name: "enp216s0f1np1", UUID: uuid.NewString(), Type: "dpdk"}
+	bondMode := "balance-tcp"
+	lacp := "active"
+	port := &PortEntry{
+		Name:        "br-0000_d8_00.0-bond",
+		UUID:        uuid.NewString(),
+		Interfaces:  []string{iface0.UUID, iface1.UUID},
+		BondMode:    &bondMode,
+		LACP:        &lacp,
+		OtherConfig: map[string]string{"lacp-time": "fast"},
+	}
+	br := &BridgeEntry{
+		Name:         "br-0000_d8_00.0",
+		UUID:         uuid.NewString(),
+		Ports:        []string{port.UUID},
+		DatapathType: "netdev",
+	}
+	ovs := &OpenvSwitchEntry{
+		UUID:    uuid.NewString(),
+		Bridges: []string{br.UUID},
+	}
+	return &testDBEntries{
+		OpenVSwitch: []*OpenvSwitchEntry{ovs},
+		Bridge:      []*BridgeEntry{br},
+		Port:        []*PortEntry{port},
+		Interface:   []*InterfaceEntry{iface0, iface1},
+	}
+}
+
 func getDBContent(ctx context.Context, c client.Client) *testDBEntries {
 	ret := &testDBEntries{}
 	Expect(c.List(ctx, &ret.OpenVSwitch)).NotTo(HaveOccurred())
 	Expect(c.List(ctx, &ret.Bridge)).NotTo(HaveOccurred())
 	Expect(c.List(ctx, &ret.Port)).NotTo(HaveOccurred())
 	Expect(c.List(ctx, &ret.Interface)).NotTo(HaveOccurred())
+	Expect(c.List(ctx, &ret.QoS)).NotTo(HaveOccurred())
+	Expect(c.List(ctx, &ret.Queue)).NotTo(HaveOccurred())
+	// QoS and Queue tables are empty in most of the tests,
+	// use nil slices to be able to compare the content with the initial content
+	if len(ret.QoS) == 0 {
+		ret.QoS = nil
+	}
+	if len(ret.Queue) == 0 {
+		ret.Queue = nil
+	}
 	return ret
 }
 
@@ -197,6 +271,37 @@ func validateDBConfig(dbContent *testDBEntries, conf *sriovnetworkv1.OVSConfigEx
 	Expect(internalIface.ExternalIDs).To(BeNil())
 }
 
+func validateBondDBConfig(dbContent *testDBEntries, conf *sriovnetworkv1.OVSConfigExt) {
+	Expect(dbContent.Bridge).To(HaveLen(1))
+	Expect(dbContent.Interface).To(HaveLen(len(conf.Uplinks) + 1))
+	Expect(dbContent.Port).To(HaveLen(2))
+	br := dbContent.Bridge[0]
+	Expect(br.Name).To(Equal(conf.Name))
+	var bondPort *PortEntry
+	for _, p := range dbContent.Port {
+		if p.Name == conf.Bond.Name {
+			bondPort = p
+		}
+	}
+	Expect(bondPort).NotTo(BeNil())
+	Expect(br.Ports).To(ContainElement(bondPort.UUID))
+	Expect(bondPort.BondMode).To(Equal(&conf.Bond.Mode))
+	Expect(bondPort.LACP).To(Equal(&conf.Bond.LACP))
+	Expect(bondPort.OtherConfig).To(Equal(conf.Bond.OtherConfig))
+	Expect(bondPort.Interfaces).To(HaveLen(len(conf.Uplinks)))
+	for _, uplink := range conf.Uplinks {
+		var iface *InterfaceEntry
+		for _, ifc := range dbContent.Interface {
+			if ifc.Name == uplink.Name {
+				iface = ifc
+			}
+		}
+		Expect(iface).NotTo(BeNil())
+		Expect(bondPort.Interfaces).To(ContainElement(iface.UUID))
+		Expect(iface.Type).To(Equal(uplink.Interface.Type))
+	}
+}
+
 var _ = Describe("OVS", func() {
 	var (
 		ctx context.Context
@@ -528,6 +633,268 @@ var _ = Describe("OVS", func() {
 				Expect(internalIfaceFound).To(BeTrue())
 			})
 		})
+		Context("CreateOVSBridge with bond", func() {
+			It("No Bridge, create bridge with bond", func() {
+				expectedConf := getManagedBondBridge()
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(nil, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				createInitialDBContent(ctx, ovsClient, &testDBEntries{OpenVSwitch: []*OpenvSwitchEntry{{UUID: uuid.NewString()}}})
+
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				validateBondDBConfig(getDBContent(ctx, ovsClient), expectedConf)
+			})
+			It("Bond already exist with the right config, do nothing", func() {
+				expectedConf := getManagedBondBridge()
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(expectedConf, nil)
+				initialDBContent := getBondInitialDBContent()
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				Expect(dbContent.Bridge).To(Equal(initialDBContent.Bridge))
+				Expect(dbContent.Port).To(Equal(initialDBContent.Port))
+				// interfaces are listed in random order
+				Expect(dbContent.Interface).To(ConsistOf(initialDBContent.Interface))
+			})
+			It("Bond exist with wrong mode, should recreate bond only", func() {
+				expectedConf := getManagedBondBridge()
+				expectedConf.Bond.Mode = "active-backup"
+				oldConfig := getManagedBondBridge()
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(oldConfig, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				initialDBContent := getBondInitialDBContent()
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				validateBondDBConfig(dbContent, expectedConf)
+				Expect(dbContent.Bridge[0].UUID).To(Equal(initialDBContent.Bridge[0].UUID))
+			})
+			It("Uplink converted to bond, should attach all members to the bond", func() {
+				expectedConf := getManagedBondBridge()
+				oldConfig := getManagedBridges()["br-0000_d8_00.0"]
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(oldConfig, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				createInitialDBContent(ctx, ovsClient, getDefaultInitialDBContent())
+
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				validateBondDBConfig(getDBContent(ctx, ovsClient), expectedConf)
+			})
+			It("Multiple uplinks without bond, should fail", func() {
+				conf := getManagedBondBridge()
+				conf.Bond = nil
+				Expect(ovs.CreateOVSBridge(ctx, conf)).To(MatchError(ContainSubstring("uplinks list must contain one element")))
+			})
+		})
+		Context("CreateOVSBridge with additional ports", func() {
+			getPorts := func() []sriovnetworkv1.OVSPortConfig {
+				mtu := 1400
+				return []sriovnetworkv1.OVSPortConfig{
+					{Name: "patch-to-br-int", Type: "patch", Peer: "patch-from-br-int"},
+					{Name: "host0", Type: "internal", IPAddresses: []string{"192.168.1.10/24"}, MTURequest: &mtu},
+				}
+			}
+			findIface := func(dbContent *testDBEntries, name string) *InterfaceEntry {
+				for _, iface := range dbContent.Interface {
+					if iface.Name == name {
+						return iface
+					}
+				}
+				return nil
+			}
+			It("No Bridge, create bridge with ports", func() {
+				expectedConf := getManagedBridges()["br-0000_d8_00.0"]
+				expectedConf.Ports = getPorts()
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(nil, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				createInitialDBContent(ctx, ovsClient, &testDBEntries{OpenVSwitch: []*OpenvSwitchEntry{{UUID: uuid.NewString()}}})
+
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				Expect(dbContent.Port).To(HaveLen(4))
+				patch := findIface(dbContent, "patch-to-br-int")
+				Expect(patch).NotTo(BeNil())
+				Expect(patch.Type).To(Equal("patch"))
+				Expect(patch.Options).To(Equal(map[string]string{"peer": "patch-from-br-int"}))
+				internal := findIface(dbContent, "host0")
+				Expect(internal).NotTo(BeNil())
+				Expect(internal.Type).To(Equal("internal"))
+				Expect(*internal.MTURequest).To(Equal(1400))
+			})
+			It("Bridge exist, should add missing port and remove stale port only", func() {
+				oldConfig := getManagedBridges()["br-0000_d8_00.0"]
+				oldConfig.Ports = []sriovnetworkv1.OVSPortConfig{{Name: "stale", Type: "internal"}}
+				expectedConf := getManagedBridges()["br-0000_d8_00.0"]
+				expectedConf.Ports = getPorts()
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(oldConfig, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+
+				initialDBContent := getDefaultInitialDBContent()
+				staleIface := &InterfaceEntry{Name: "stale", UUID: uuid.NewString(), Type: "internal"}
+				stalePort := &PortEntry{Name: "stale", UUID: uuid.NewString(), Interfaces: []string{staleIface.UUID}}
+				initialDBContent.Interface = append(initialDBContent.Interface, staleIface)
+				initialDBContent.Port = append(initialDBContent.Port, stalePort)
+				initialDBContent.Bridge[0].Ports = append(initialDBContent.Bridge[0].Ports, stalePort.UUID)
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				Expect(dbContent.Bridge[0].UUID).To(Equal(initialDBContent.Bridge[0].UUID))
+				Expect(findIface(dbContent, "stale")).To(BeNil())
+				Expect(findIface(dbContent, "patch-to-br-int")).NotTo(BeNil())
+				Expect(findIface(dbContent, "host0")).NotTo(BeNil())
+				// uplink has the right config and should be kept
+				Expect(findIface(dbContent, "enp216s0f0np0").UUID).To(Equal(initialDBContent.Interface[0].UUID))
+			})
+			It("Port exist with the right config, only IP addresses changed, should keep the port", func() {
+				oldConfig := getManagedBridges()["br-0000_d8_00.0"]
+				oldConfig.Ports = getPorts()
+				expectedConf := getManagedBridges()["br-0000_d8_00.0"]
+				expectedConf.Ports = getPorts()
+				expectedConf.Ports[1].IPAddresses = []string{"192.168.1.11/24"}
+				// create the bridge with the old config first
+				createInitialDBContent(ctx, ovsClient, &testDBEntries{OpenVSwitch: []*OpenvSwitchEntry{{UUID: uuid.NewString()}}})
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(nil, nil)
+				store.EXPECT().AddManagedOVSBridge(oldConfig).Return(nil)
+				Expect(ovs.CreateOVSBridge(ctx, oldConfig)).NotTo(HaveOccurred())
+				initialDBContent := getDBContent(ctx, ovsClient)
+
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(oldConfig, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				Expect(findIface(dbContent, "host0").UUID).To(Equal(findIface(initialDBContent, "host0").UUID))
+			})
+		})
+		Context("CreateOVSBridge with QoS", func() {
+			getQoS := func() *sriovnetworkv1.OVSQoSConfig {
+				return &sriovnetworkv1.OVSQoSConfig{
+					Type:        "linux-htb",
+					OtherConfig: map[string]string{"max-rate": "10000000000"},
+					Queues: []sriovnetworkv1.OVSQueueConfig{
+						{ID: 0, OtherConfig: map[string]string{"min-rate": "1000000000"}},
+						{ID: 1, OtherConfig: map[string]string{"max-rate": "5000000000", "priority": "1"}},
+					},
+				}
+			}
+			findPort := func(dbContent *testDBEntries, name string) *PortEntry {
+				for _, port := range dbContent.Port {
+					if port.Name == name {
+						return port
+					}
+				}
+				return nil
+			}
+			It("No Bridge, create bridge with QoS", func() {
+				expectedConf := getManagedBridges()["br-0000_d8_00.0"]
+				expectedConf.Bridge.QoS = getQoS()
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(nil, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				createInitialDBContent(ctx, ovsClient, &testDBEntries{OpenVSwitch: []*OpenvSwitchEntry{{UUID: uuid.NewString()}}})
+
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				validateDBConfig(dbContent, expectedConf)
+				Expect(dbContent.QoS).To(HaveLen(1))
+				Expect(dbContent.Queue).To(HaveLen(2))
+				qos := dbContent.QoS[0]
+				Expect(findPort(dbContent, "enp216s0f0np0").QoS).To(Equal(&qos.UUID))
+				Expect(qos.Type).To(Equal("linux-htb"))
+				Expect(qos.OtherConfig).To(Equal(map[string]string{"max-rate": "10000000000"}))
+				Expect(qos.Queues).To(HaveLen(2))
+				for _, queue := range dbContent.Queue {
+					if queue.UUID == qos.Queues[1] {
+						Expect(queue.OtherConfig).To(Equal(map[string]string{"max-rate": "5000000000", "priority": "1"}))
+					} else {
+						Expect(queue.UUID).To(Equal(qos.Queues[0]))
+					}
+				}
+			})
+			It("No Bridge, create bond bridge with QoS", func() {
+				expectedConf := getManagedBondBridge()
+				expectedConf.Bridge.QoS = &sriovnetworkv1.OVSQoSConfig{
+					Type: "egress-policer", OtherConfig: map[string]string{"cir": "1000000", "cbs": "100000"}}
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(nil, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				createInitialDBContent(ctx, ovsClient, &testDBEntries{OpenVSwitch: []*OpenvSwitchEntry{{UUID: uuid.NewString()}}})
+
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				validateBondDBConfig(dbContent, expectedConf)
+				Expect(dbContent.QoS).To(HaveLen(1))
+				Expect(dbContent.Queue).To(BeEmpty())
+				Expect(findPort(dbContent, expectedConf.Bond.Name).QoS).To(Equal(&dbContent.QoS[0].UUID))
+			})
+			It("QoS changed, should replace QoS only", func() {
+				oldConfig := getManagedBridges()["br-0000_d8_00.0"]
+				oldConfig.Bridge.QoS = getQoS()
+				expectedConf := getManagedBridges()["br-0000_d8_00.0"]
+				expectedConf.Bridge.QoS = getQoS()
+				expectedConf.Bridge.QoS.Queues = expectedConf.Bridge.QoS.Queues[:1]
+				// create the bridge with the old config first
+				createInitialDBContent(ctx, ovsClient, &testDBEntries{OpenVSwitch: []*OpenvSwitchEntry{{UUID: uuid.NewString()}}})
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(nil, nil)
+				store.EXPECT().AddManagedOVSBridge(oldConfig).Return(nil)
+				Expect(ovs.CreateOVSBridge(ctx, oldConfig)).NotTo(HaveOccurred())
+				initialDBContent := getDBContent(ctx, ovsClient)
+
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(oldConfig, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				Expect(dbContent.Bridge[0].UUID).To(Equal(initialDBContent.Bridge[0].UUID))
+				Expect(findPort(dbContent, "enp216s0f0np0").UUID).To(Equal(findPort(initialDBContent, "enp216s0f0np0").UUID))
+				Expect(dbContent.QoS).To(HaveLen(1))
+				Expect(dbContent.QoS[0].UUID).NotTo(Equal(initialDBContent.QoS[0].UUID))
+				Expect(dbContent.Queue).To(HaveLen(1))
+				Expect(findPort(dbContent, "enp216s0f0np0").QoS).To(Equal(&dbContent.QoS[0].UUID))
+			})
+			It("QoS removed from the config, should remove QoS and queues", func() {
+				oldConfig := getManagedBridges()["br-0000_d8_00.0"]
+				oldConfig.Bridge.QoS = getQoS()
+				expectedConf := getManagedBridges()["br-0000_d8_00.0"]
+				createInitialDBContent(ctx, ovsClient, &testDBEntries{OpenVSwitch: []*OpenvSwitchEntry{{UUID: uuid.NewString()}}})
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(nil, nil)
+				store.EXPECT().AddManagedOVSBridge(oldConfig).Return(nil)
+				Expect(ovs.CreateOVSBridge(ctx, oldConfig)).NotTo(HaveOccurred())
+
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(oldConfig, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				validateDBConfig(dbContent, expectedConf)
+				Expect(dbContent.QoS).To(BeEmpty())
+				Expect(dbContent.Queue).To(BeEmpty())
+				Expect(findPort(dbContent, "enp216s0f0np0").QoS).To(BeNil())
+			})
+			It("Flows changed, should keep the bridge", func() {
+				oldConfig := getManagedBridges()["br-0000_d8_00.0"]
+				oldConfig.Bridge.Flows = []string{"table=0,priority=100,actions=NORMAL"}
+				expectedConf := getManagedBridges()["br-0000_d8_00.0"]
+				expectedConf.Bridge.Flows = []string{"table=0,priority=200,actions=drop"}
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(oldConfig, nil)
+				store.EXPECT().AddManagedOVSBridge(expectedConf).Return(nil)
+				initialDBContent := getDefaultInitialDBContent()
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+
+				Expect(ovs.CreateOVSBridge(ctx, expectedConf)).NotTo(HaveOccurred())
+
+				dbContent := getDBContent(ctx, ovsClient)
+				Expect(dbContent.Bridge[0].UUID).To(Equal(initialDBContent.Bridge[0].UUID))
+				Expect(findPort(dbContent, "enp216s0f0np0").UUID).To(Equal(initialDBContent.Port[0].UUID))
+			})
+		})
 		Context("GetOVSBridges", func() {
 			It("Bridge exist, but no managed bridges in config", func() {
 				createInitialDBContent(ctx, ovsClient, getDefaultInitialDBContent())
@@ -542,8 +909,36 @@ var _ = Describe("OVS", func() {
 				store.EXPECT().GetManagedOVSBridges().Return(conf, nil)
 				ret, err := ovs.GetOVSBridges(ctx)
 				Expect(err).NotTo(HaveOccurred())
+				Expect(ret).To(HaveLen(1))
+				Expect(ret[0].State).To(Equal(&sriovnetworkv1.OVSBridgeState{
+					Uplinks: []sriovnetworkv1.OVSInterfaceState{{PciAddress: "0000:d8:00.0", Name: "enp216s0f0np0"}},
+				}))
+				ret[0].State = nil
 				Expect(ret).To(ContainElement(*conf["br-0000_d8_00.0"]))
 			})
+			It("Managed bridge exist, should report state of the uplinks and hw-offload", func() {
+				initialDBContent := getDefaultInitialDBContent()
+				initialDBContent.OpenVSwitch[0].OtherConfig = map[string]string{"hw-offload": "true"}
+				initialDBContent.Interface[0].Error = ptr.To("could not open network device enp216s0f0np0 (No such device)")
+				initialDBContent.Interface[0].LinkState = ptr.To("down")
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+				conf := getManagedBridges()
+				store.EXPECT().GetManagedOVSBridges().Return(conf, nil)
+				ret, err := ovs.GetOVSBridges(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ret).To(HaveLen(1))
+				// uplink in error state is not included to the configuration, but is reported in the state
+				Expect(ret[0].Uplinks).To(BeEmpty())
+				Expect(ret[0].State).To(Equal(&sriovnetworkv1.OVSBridgeState{
+					HwOffload: "true",
+					Uplinks: []sriovnetworkv1.OVSInterfaceState{{
+						PciAddress: "0000:d8:00.0",
+						Name:       "enp216s0f0np0",
+						LinkState:  "down",
+						Error:      "could not open network device enp216s0f0np0 (No such device)",
+					}},
+				}))
+			})
 			It("Managed bridge exist, interface not found", func() {
 				initialDBContent := getDefaultInitialDBContent()
 				initialDBContent.Bridge[0].Ports = nil
@@ -557,6 +952,76 @@ var _ = Describe("OVS", func() {
 				Expect(ret).To(HaveLen(1))
 				Expect(ret[0].Bridge).To(Equal(conf["br-0000_d8_00.0"].Bridge))
 				Expect(ret[0].Uplinks).To(BeEmpty())
+				Expect(ret[0].State.Uplinks).To(Equal([]sriovnetworkv1.OVSInterfaceState{
+					{PciAddress: "0000:d8:00.0", Name: "enp216s0f0np0", Error: "interface not found in OVSDB"}}))
+			})
+			It("Managed bond bridge exist with the right config", func() {
+				createInitialDBContent(ctx, ovsClient, getBondInitialDBContent())
+				conf := getManagedBondBridge()
+				store.EXPECT().GetManagedOVSBridges().Return(map[string]*sriovnetworkv1.OVSConfigExt{conf.Name: conf}, nil)
+				ret, err := ovs.GetOVSBridges(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ret).To(HaveLen(1))
+				Expect(ret[0].Bond).To(Equal(conf.Bond))
+				Expect(ret[0].Uplinks).To(HaveLen(2))
+				Expect(ret[0].Uplinks[0].Name).To(Equal("enp216s0f0np0"))
+				Expect(ret[0].Uplinks[1].Name).To(Equal("enp216s0f1np1"))
+			})
+			It("Managed bond bridge exist, bond port not found", func() {
+				initialDBContent := getBondInitialDBContent()
+				initialDBContent.Bridge[0].Ports = nil
+				initialDBContent.Interface = nil
+				initialDBContent.Port = nil
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+				conf := getManagedBondBridge()
+				store.EXPECT().GetManagedOVSBridges().Return(map[string]*sriovnetworkv1.OVSConfigExt{conf.Name: conf}, nil)
+				ret, err := ovs.GetOVSBridges(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ret).To(HaveLen(1))
+				Expect(ret[0].Bond).To(BeNil())
+				Expect(ret[0].Uplinks).To(BeEmpty())
+			})
+			It("Managed bridge exist with additional ports", func() {
+				initialDBContent := getDefaultInitialDBContent()
+				patchIface := &InterfaceEntry{Name: "patch-to-br-int", UUID: uuid.NewString(), Type: "patch",
+					Options: map[string]string{"peer": "patch-from-br-int"}}
+				patchPort := &PortEntry{Name: "patch-to-br-int", UUID: uuid.NewString(), Interfaces: []string{patchIface.UUID}}
+				initialDBContent.Interface = append(initialDBContent.Interface, patchIface)
+				initialDBContent.Port = append(initialDBContent.Port, patchPort)
+				initialDBContent.Bridge[0].Ports = append(initialDBContent.Bridge[0].Ports, patchPort.UUID)
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+				conf := getManagedBridges()
+				conf["br-0000_d8_00.0"].Ports = []sriovnetworkv1.OVSPortConfig{
+					{Name: "patch-to-br-int", Type: "patch", Peer: "patch-from-br-int"},
+					{Name: "host0", Type: "internal"},
+				}
+				store.EXPECT().GetManagedOVSBridges().Return(conf, nil)
+				ret, err := ovs.GetOVSBridges(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ret).To(HaveLen(1))
+				Expect(ret[0].Ports).To(HaveLen(1))
+				Expect(ret[0].Ports[0].Name).To(Equal("patch-to-br-int"))
+				Expect(ret[0].Ports[0].Peer).To(Equal("patch-from-br-int"))
+			})
+			It("Managed bridge exist with QoS and flows", func() {
+				initialDBContent := getDefaultInitialDBContent()
+				queue := &QueueEntry{UUID: uuid.NewString(), OtherConfig: map[string]string{"min-rate": "1000"}}
+				qos := &QoSEntry{UUID: uuid.NewString(), Type: "linux-htb", Queues: map[int]string{1: queue.UUID}}
+				initialDBContent.Port[0].QoS = &qos.UUID
+				initialDBContent.QoS = []*QoSEntry{qos}
+				initialDBContent.Queue = []*QueueEntry{queue}
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+				conf := getManagedBridges()
+				conf["br-0000_d8_00.0"].Bridge.Flows = []string{"table=0,priority=100,actions=NORMAL"}
+				store.EXPECT().GetManagedOVSBridges().Return(conf, nil)
+				ret, err := ovs.GetOVSBridges(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ret).To(HaveLen(1))
+				Expect(ret[0].Bridge.Flows).To(Equal(conf["br-0000_d8_00.0"].Bridge.Flows))
+				Expect(ret[0].Bridge.QoS).To(Equal(&sriovnetworkv1.OVSQoSConfig{
+					Type:   "linux-htb",
+					Queues: []sriovnetworkv1.OVSQueueConfig{{ID: 1, OtherConfig: map[string]string{"min-rate": "1000"}}},
+				}))
 			})
 			It("Config exist, bridge not found", func() {
 				store.EXPECT().GetManagedOVSBridges().Return(getManagedBridges(), nil)
@@ -576,6 +1041,8 @@ var _ = Describe("OVS", func() {
 				createInitialDBContent(ctx, ovsClient, initialDBContent)
 				ret, err := ovs.GetOVSBridges(ctx)
 				Expect(err).NotTo(HaveOccurred())
+				Expect(ret).To(HaveLen(1))
+				ret[0].State = nil
 				Expect(ret).To(ContainElement(*conf["br-0000_d8_00.0"]))
 			})
 			It("Should not report managed fields which are missing in ovsdb", func() {
@@ -614,6 +1081,23 @@ var _ = Describe("OVS", func() {
 				Expect(dbContent.Interface).To(BeEmpty())
 				Expect(dbContent.Port).To(BeEmpty())
 			})
+			It("Remove bridge with QoS", func() {
+				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(getManagedBridges()["br-0000_d8_00.0"], nil)
+				store.EXPECT().RemoveManagedOVSBridge("br-0000_d8_00.0").Return(nil)
+				initialDBContent := getDefaultInitialDBContent()
+				queue := &QueueEntry{UUID: uuid.NewString()}
+				qos := &QoSEntry{UUID: uuid.NewString(), Type: "linux-htb", Queues: map[int]string{0: queue.UUID}}
+				initialDBContent.Port[0].QoS = &qos.UUID
+				initialDBContent.QoS = []*QoSEntry{qos}
+				initialDBContent.Queue = []*QueueEntry{queue}
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+				Expect(ovs.RemoveOVSBridge(ctx, "br-0000_d8_00.0")).NotTo(HaveOccurred())
+				dbContent := getDBContent(ctx, ovsClient)
+				Expect(dbContent.Bridge).To(BeEmpty())
+				Expect(dbContent.Port).To(BeEmpty())
+				Expect(dbContent.QoS).To(BeEmpty())
+				Expect(dbContent.Queue).To(BeEmpty())
+			})
 			It("Should keep unmanaged bridge", func() {
 				store.EXPECT().GetManagedOVSBridge("br-0000_d8_00.0").Return(nil, nil)
 				initialDBContent := getDefaultInitialDBContent()
@@ -640,6 +1124,19 @@ var _ = Describe("OVS", func() {
 				Expect(dbContent.Interface).To(BeEmpty())
 				Expect(dbContent.Port).To(BeEmpty())
 			})
+			It("should remove member interface from the bond", func() {
+				conf := getManagedBondBridge()
+				store.EXPECT().GetManagedOVSBridges().Return(map[string]*sriovnetworkv1.OVSConfigExt{conf.Name: conf}, nil)
+				initialDBContent := getBondInitialDBContent()
+				createInitialDBContent(ctx, ovsClient, initialDBContent)
+				Expect(ovs.RemoveInterfaceFromOVSBridge(ctx, "0000:d8:00.1")).NotTo(HaveOccurred())
+				dbContent := getDBContent(ctx, ovsClient)
+				Expect(dbContent.Port).To(HaveLen(1))
+				Expect(dbContent.Port[0].UUID).To(Equal(initialDBContent.Port[0].UUID))
+				Expect(dbContent.Port[0].Interfaces).To(Equal([]string{initialDBContent.Interface[0].UUID}))
+				Expect(dbContent.Interface).To(HaveLen(1))
+				Expect(dbContent.Interface[0].Name).To(Equal("enp216s0f0np0"))
+			})
 			It("bridge not found", func() {
 				store.EXPECT().GetManagedOVSBridges().Return(getManagedBridges(), nil)
 				store.EXPECT().RemoveManagedOVSBridge("br-0000_d8_00.0").Return(nil)
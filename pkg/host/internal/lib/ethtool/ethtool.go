@@ -32,6 +32,20 @@ type EthtoolLib interface {
 	FeatureNames(ifaceName string) (map[string]uint, error)
 	// Change requests a change in the given device's features.
 	Change(ifaceName string, config map[string]bool) error
+	// PrivFlags retrieves private flags of the given interface name.
+	PrivFlags(ifaceName string) (map[string]bool, error)
+	// UpdatePrivFlags requests a change in the given device's private flags.
+	UpdatePrivFlags(ifaceName string, config map[string]bool) error
+	// GetRing retrieves ring parameters of the given interface name.
+	GetRing(ifaceName string) (ethtool.Ring, error)
+	// SetRing sets ring parameters of the given interface name.
+	SetRing(ifaceName string, ring ethtool.Ring) error
+	// GetChannels retrieves the number of channels of the given interface name.
+	GetChannels(ifaceName string) (ethtool.Channels, error)
+	// SetChannels sets the number of channels of the given interface name.
+	SetChannels(ifaceName string, channels ethtool.Channels) error
+	// DriverInfo retrieves the driver information of the given interface name, including the firmware version.
+	DriverInfo(ifaceName string) (ethtool.DrvInfo, error)
 }
 
 type libWrapper struct{}
@@ -65,3 +79,75 @@ func (w *libWrapper) Change(ifaceName string, config map[string]bool) error {
 	defer e.Close()
 	return e.Change(ifaceName, config)
 }
+
+// PrivFlags retrieves private flags of the given interface name.
+func (w *libWrapper) PrivFlags(ifaceName string) (map[string]bool, error) {
+	e, err := ethtool.NewEthtool()
+	if err != nil {
+		return nil, err
+	}
+	defer e.Close()
+	return e.PrivFlags(ifaceName)
+}
+
+// UpdatePrivFlags requests a change in the given device's private flags.
+func (w *libWrapper) UpdatePrivFlags(ifaceName string, config map[string]bool) error {
+	e, err := ethtool.NewEthtool()
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+	return e.UpdatePrivFlags(ifaceName, config)
+}
+
+// GetRing retrieves ring parameters of the given interface name.
+func (w *libWrapper) GetRing(ifaceName string) (ethtool.Ring, error) {
+	e, err := ethtool.NewEthtool()
+	if err != nil {
+		return ethtool.Ring{}, err
+	}
+	defer e.Close()
+	return e.GetRing(ifaceName)
+}
+
+// SetRing sets ring parameters of the given interface name.
+func (w *libWrapper) SetRing(ifaceName string, ring ethtool.Ring) error {
+	e, err := ethtool.NewEthtool()
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+	_, err = e.SetRing(ifaceName, ring)
+	return err
+}
+
+// GetChannels retrieves the number of channels of the given interface name.
+func (w *libWrapper) GetChannels(ifaceName string) (ethtool.Channels, error) {
+	e, err := ethtool.NewEthtool()
+	if err != nil {
+		return ethtool.Channels{}, err
+	}
+	defer e.Close()
+	return e.GetChannels(ifaceName)
+}
+
+// SetChannels sets the number of channels of the given interface name.
+func (w *libWrapper) SetChannels(ifaceName string, channels ethtool.Channels) error {
+	e, err := ethtool.NewEthtool()
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+	_, err = e.SetChannels(ifaceName, channels)
+	return err
+}
+
+// DriverInfo retrieves the driver information of the given interface name, including the firmware version.
+func (w *libWrapper) DriverInfo(ifaceName string) (ethtool.DrvInfo, error) {
+	e, err := ethtool.NewEthtool()
+	if err != nil {
+		return ethtool.DrvInfo{}, err
+	}
+	defer e.Close()
+	return e.DriverInfo(ifaceName)
+}
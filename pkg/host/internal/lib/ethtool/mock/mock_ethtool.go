@@ -12,6 +12,7 @@ package mock_ethtool
 import (
 	reflect "reflect"
 
+	ethtool "github.com/safchain/ethtool"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -53,6 +54,21 @@ func (mr *MockEthtoolLibMockRecorder) Change(ifaceName, config any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Change", reflect.TypeOf((*MockEthtoolLib)(nil).Change), ifaceName, config)
 }
 
+// DriverInfo mocks base method.
+func (m *MockEthtoolLib) DriverInfo(ifaceName string) (ethtool.DrvInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DriverInfo", ifaceName)
+	ret0, _ := ret[0].(ethtool.DrvInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DriverInfo indicates an expected call of DriverInfo.
+func (mr *MockEthtoolLibMockRecorder) DriverInfo(ifaceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DriverInfo", reflect.TypeOf((*MockEthtoolLib)(nil).DriverInfo), ifaceName)
+}
+
 // FeatureNames mocks base method.
 func (m *MockEthtoolLib) FeatureNames(ifaceName string) (map[string]uint, error) {
 	m.ctrl.T.Helper()
@@ -82,3 +98,90 @@ func (mr *MockEthtoolLibMockRecorder) Features(ifaceName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features", reflect.TypeOf((*MockEthtoolLib)(nil).Features), ifaceName)
 }
+
+// GetChannels mocks base method.
+func (m *MockEthtoolLib) GetChannels(ifaceName string) (ethtool.Channels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannels", ifaceName)
+	ret0, _ := ret[0].(ethtool.Channels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannels indicates an expected call of GetChannels.
+func (mr *MockEthtoolLibMockRecorder) GetChannels(ifaceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannels", reflect.TypeOf((*MockEthtoolLib)(nil).GetChannels), ifaceName)
+}
+
+// GetRing mocks base method.
+func (m *MockEthtoolLib) GetRing(ifaceName string) (ethtool.Ring, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRing", ifaceName)
+	ret0, _ := ret[0].(ethtool.Ring)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRing indicates an expected call of GetRing.
+func (mr *MockEthtoolLibMockRecorder) GetRing(ifaceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRing", reflect.TypeOf((*MockEthtoolLib)(nil).GetRing), ifaceName)
+}
+
+// PrivFlags mocks base method.
+func (m *MockEthtoolLib) PrivFlags(ifaceName string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivFlags", ifaceName)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivFlags indicates an expected call of PrivFlags.
+func (mr *MockEthtoolLibMockRecorder) PrivFlags(ifaceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivFlags", reflect.TypeOf((*MockEthtoolLib)(nil).PrivFlags), ifaceName)
+}
+
+// SetChannels mocks base method.
+func (m *MockEthtoolLib) SetChannels(ifaceName string, channels ethtool.Channels) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChannels", ifaceName, channels)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChannels indicates an expected call of SetChannels.
+func (mr *MockEthtoolLibMockRecorder) SetChannels(ifaceName, channels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannels", reflect.TypeOf((*MockEthtoolLib)(nil).SetChannels), ifaceName, channels)
+}
+
+// SetRing mocks base method.
+func (m *MockEthtoolLib) SetRing(ifaceName string, ring ethtool.Ring) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRing", ifaceName, ring)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRing indicates an expected call of SetRing.
+func (mr *MockEthtoolLibMockRecorder) SetRing(ifaceName, ring any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRing", reflect.TypeOf((*MockEthtoolLib)(nil).SetRing), ifaceName, ring)
+}
+
+// UpdatePrivFlags mocks base method.
+func (m *MockEthtoolLib) UpdatePrivFlags(ifaceName string, config map[string]bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrivFlags", ifaceName, config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrivFlags indicates an expected call of UpdatePrivFlags.
+func (mr *MockEthtoolLibMockRecorder) UpdatePrivFlags(ifaceName, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivFlags", reflect.TypeOf((*MockEthtoolLib)(nil).UpdatePrivFlags), ifaceName, config)
+}
@@ -94,6 +94,49 @@ func (m *MockNetlinkLib) EXPECT() *MockNetlinkLibMockRecorder {
 	return m.recorder
 }
 
+// AddrAdd mocks base method.
+func (m *MockNetlinkLib) AddrAdd(link netlink.Link, addr *netlink0.Addr) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddrAdd", link, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddrAdd indicates an expected call of AddrAdd.
+func (mr *MockNetlinkLibMockRecorder) AddrAdd(link, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddrAdd", reflect.TypeOf((*MockNetlinkLib)(nil).AddrAdd), link, addr)
+}
+
+// AddrDel mocks base method.
+func (m *MockNetlinkLib) AddrDel(link netlink.Link, addr *netlink0.Addr) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddrDel", link, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddrDel indicates an expected call of AddrDel.
+func (mr *MockNetlinkLibMockRecorder) AddrDel(link, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddrDel", reflect.TypeOf((*MockNetlinkLib)(nil).AddrDel), link, addr)
+}
+
+// AddrList mocks base method.
+func (m *MockNetlinkLib) AddrList(link netlink.Link, family int) ([]netlink0.Addr, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddrList", link, family)
+	ret0, _ := ret[0].([]netlink0.Addr)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddrList indicates an expected call of AddrList.
+func (mr *MockNetlinkLibMockRecorder) AddrList(link, family any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddrList", reflect.TypeOf((*MockNetlinkLib)(nil).AddrList), link, family)
+}
+
 // DevLinkGetDeviceByName mocks base method.
 func (m *MockNetlinkLib) DevLinkGetDeviceByName(bus, device string) (*netlink0.DevlinkDevice, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +166,21 @@ func (mr *MockNetlinkLibMockRecorder) DevLinkSetEswitchMode(dev, newMode any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevLinkSetEswitchMode", reflect.TypeOf((*MockNetlinkLib)(nil).DevLinkSetEswitchMode), dev, newMode)
 }
 
+// DevlinkGetDeviceInfoByName mocks base method.
+func (m *MockNetlinkLib) DevlinkGetDeviceInfoByName(bus, device string) (*netlink0.DevlinkDeviceInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DevlinkGetDeviceInfoByName", bus, device)
+	ret0, _ := ret[0].(*netlink0.DevlinkDeviceInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DevlinkGetDeviceInfoByName indicates an expected call of DevlinkGetDeviceInfoByName.
+func (mr *MockNetlinkLibMockRecorder) DevlinkGetDeviceInfoByName(bus, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevlinkGetDeviceInfoByName", reflect.TypeOf((*MockNetlinkLib)(nil).DevlinkGetDeviceInfoByName), bus, device)
+}
+
 // DevlinkGetDeviceParamByName mocks base method.
 func (m *MockNetlinkLib) DevlinkGetDeviceParamByName(bus, device, param string) (*netlink0.DevlinkParam, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +339,62 @@ func (mr *MockNetlinkLibMockRecorder) LinkSetVfPortGUID(link, vf, portguid any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkSetVfPortGUID", reflect.TypeOf((*MockNetlinkLib)(nil).LinkSetVfPortGUID), link, vf, portguid)
 }
 
+// LinkSetVfRate mocks base method.
+func (m *MockNetlinkLib) LinkSetVfRate(link netlink.Link, vf, minRate, maxRate int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkSetVfRate", link, vf, minRate, maxRate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkSetVfRate indicates an expected call of LinkSetVfRate.
+func (mr *MockNetlinkLibMockRecorder) LinkSetVfRate(link, vf, minRate, maxRate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkSetVfRate", reflect.TypeOf((*MockNetlinkLib)(nil).LinkSetVfRate), link, vf, minRate, maxRate)
+}
+
+// LinkSetVfSpoofchk mocks base method.
+func (m *MockNetlinkLib) LinkSetVfSpoofchk(link netlink.Link, vf int, check bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkSetVfSpoofchk", link, vf, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkSetVfSpoofchk indicates an expected call of LinkSetVfSpoofchk.
+func (mr *MockNetlinkLibMockRecorder) LinkSetVfSpoofchk(link, vf, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkSetVfSpoofchk", reflect.TypeOf((*MockNetlinkLib)(nil).LinkSetVfSpoofchk), link, vf, check)
+}
+
+// LinkSetVfTrust mocks base method.
+func (m *MockNetlinkLib) LinkSetVfTrust(link netlink.Link, vf int, state bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkSetVfTrust", link, vf, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkSetVfTrust indicates an expected call of LinkSetVfTrust.
+func (mr *MockNetlinkLibMockRecorder) LinkSetVfTrust(link, vf, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkSetVfTrust", reflect.TypeOf((*MockNetlinkLib)(nil).LinkSetVfTrust), link, vf, state)
+}
+
+// LinkSetVfVlanQos mocks base method.
+func (m *MockNetlinkLib) LinkSetVfVlanQos(link netlink.Link, vf, vlan, qos int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkSetVfVlanQos", link, vf, vlan, qos)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkSetVfVlanQos indicates an expected call of LinkSetVfVlanQos.
+func (mr *MockNetlinkLibMockRecorder) LinkSetVfVlanQos(link, vf, vlan, qos any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkSetVfVlanQos", reflect.TypeOf((*MockNetlinkLib)(nil).LinkSetVfVlanQos), link, vf, vlan, qos)
+}
+
 // RdmaLinkByName mocks base method.
 func (m *MockNetlinkLib) RdmaLinkByName(name string) (*netlink0.RdmaLink, error) {
 	m.ctrl.T.Helper()
@@ -48,12 +48,34 @@ type NetlinkLib interface {
 	// LinkSetVfHardwareAddr sets the hardware address of a vf for the link.
 	// Equivalent to: `ip link set $link vf $vf mac $hwaddr`
 	LinkSetVfHardwareAddr(link Link, vf int, hwaddr net.HardwareAddr) error
+	// LinkSetVfVlanQos sets the vlan and qos priority of a vf for the link.
+	// Equivalent to: `ip link set $link vf $vf vlan $vlan qos $qos`
+	LinkSetVfVlanQos(link Link, vf, vlan, qos int) error
+	// LinkSetVfSpoofchk enables/disables spoof check on a vf for the link.
+	// Equivalent to: `ip link set $link vf $vf spoofchk $check`
+	LinkSetVfSpoofchk(link Link, vf int, check bool) error
+	// LinkSetVfTrust enables/disables trust state on a vf for the link.
+	// Equivalent to: `ip link set $link vf $vf trust $state`
+	LinkSetVfTrust(link Link, vf int, state bool) error
+	// LinkSetVfRate sets the min and max tx rate of a vf for the link.
+	// Equivalent to: `ip link set $link vf $vf min_tx_rate $min_rate max_tx_rate $max_rate`
+	LinkSetVfRate(link Link, vf, minRate, maxRate int) error
 	// LinkSetUp enables the link device.
 	// Equivalent to: `ip link set $link up`
 	LinkSetUp(link Link) error
 	// LinkSetMTU sets the mtu of the link device.
 	// Equivalent to: `ip link set $link mtu $mtu`
 	LinkSetMTU(link Link, mtu int) error
+	// AddrList gets a list of IP addresses in the system.
+	// Equivalent to: `ip addr show`.
+	// The list can be filtered by link and ip family.
+	AddrList(link Link, family int) ([]netlink.Addr, error)
+	// AddrAdd will add an IP address to a link device.
+	// Equivalent to: `ip addr add $addr dev $link`
+	AddrAdd(link Link, addr *netlink.Addr) error
+	// AddrDel will delete an IP address from a link device.
+	// Equivalent to: `ip addr del $addr dev $link`
+	AddrDel(link Link, addr *netlink.Addr) error
 	// DevlinkGetDeviceByName provides a pointer to devlink device and nil error,
 	// otherwise returns an error code.
 	DevLinkGetDeviceByName(bus string, device string) (*netlink.DevlinkDevice, error)
@@ -79,6 +101,10 @@ type NetlinkLib interface {
 	// cmode argument should contain valid cmode value as uint8, modes are define in nl.DEVLINK_PARAM_CMODE_* constants
 	// value argument should have one of the following types: uint8, uint16, uint32, string, bool
 	DevlinkSetDeviceParam(bus string, device string, param string, cmode uint8, value interface{}) error
+	// DevlinkGetDeviceInfoByName returns devlink info for selected device,
+	// otherwise returns an error code.
+	// Equivalent to: `devlink dev info $dev`
+	DevlinkGetDeviceInfoByName(bus string, device string) (*netlink.DevlinkDeviceInfo, error)
 	// RdmaLinkByName finds a link by name and returns a pointer to the object if
 	// found and nil error, otherwise returns error code.
 	RdmaLinkByName(name string) (*netlink.RdmaLink, error)
@@ -135,6 +161,30 @@ func (w *libWrapper) LinkSetVfHardwareAddr(link Link, vf int, hwaddr net.Hardwar
 	return netlink.LinkSetVfHardwareAddr(link, vf, hwaddr)
 }
 
+// LinkSetVfVlanQos sets the vlan and qos priority of a vf for the link.
+// Equivalent to: `ip link set $link vf $vf vlan $vlan qos $qos`
+func (w *libWrapper) LinkSetVfVlanQos(link Link, vf, vlan, qos int) error {
+	return netlink.LinkSetVfVlanQos(link, vf, vlan, qos)
+}
+
+// LinkSetVfSpoofchk enables/disables spoof check on a vf for the link.
+// Equivalent to: `ip link set $link vf $vf spoofchk $check`
+func (w *libWrapper) LinkSetVfSpoofchk(link Link, vf int, check bool) error {
+	return netlink.LinkSetVfSpoofchk(link, vf, check)
+}
+
+// LinkSetVfTrust enables/disables trust state on a vf for the link.
+// Equivalent to: `ip link set $link vf $vf trust $state`
+func (w *libWrapper) LinkSetVfTrust(link Link, vf int, state bool) error {
+	return netlink.LinkSetVfTrust(link, vf, state)
+}
+
+// LinkSetVfRate sets the min and max tx rate of a vf for the link.
+// Equivalent to: `ip link set $link vf $vf min_tx_rate $min_rate max_tx_rate $max_rate`
+func (w *libWrapper) LinkSetVfRate(link Link, vf, minRate, maxRate int) error {
+	return netlink.LinkSetVfRate(link, vf, minRate, maxRate)
+}
+
 // LinkSetUp enables the link device.
 // Equivalent to: `ip link set $link up`
 func (w *libWrapper) LinkSetUp(link Link) error {
@@ -147,6 +197,25 @@ func (w *libWrapper) LinkSetMTU(link Link, mtu int) error {
 	return netlink.LinkSetMTU(link, mtu)
 }
 
+// AddrList gets a list of IP addresses in the system.
+// Equivalent to: `ip addr show`.
+// The list can be filtered by link and ip family.
+func (w *libWrapper) AddrList(link Link, family int) ([]netlink.Addr, error) {
+	return netlink.AddrList(link, family)
+}
+
+// AddrAdd will add an IP address to a link device.
+// Equivalent to: `ip addr add $addr dev $link`
+func (w *libWrapper) AddrAdd(link Link, addr *netlink.Addr) error {
+	return netlink.AddrAdd(link, addr)
+}
+
+// AddrDel will delete an IP address from a link device.
+// Equivalent to: `ip addr del $addr dev $link`
+func (w *libWrapper) AddrDel(link Link, addr *netlink.Addr) error {
+	return netlink.AddrDel(link, addr)
+}
+
 // DevlinkGetDeviceByName provides a pointer to devlink device and nil error,
 // otherwise returns an error code.
 func (w *libWrapper) DevLinkGetDeviceByName(bus string, device string) (*netlink.DevlinkDevice, error) {
@@ -193,6 +262,13 @@ func (w *libWrapper) DevlinkSetDeviceParam(bus string, device string, param stri
 	return netlink.DevlinkSetDeviceParam(bus, device, param, cmode, value)
 }
 
+// DevlinkGetDeviceInfoByName returns devlink info for selected device,
+// otherwise returns an error code.
+// Equivalent to: `devlink dev info $dev`
+func (w *libWrapper) DevlinkGetDeviceInfoByName(bus string, device string) (*netlink.DevlinkDeviceInfo, error) {
+	return netlink.DevlinkGetDeviceInfoByName(bus, device)
+}
+
 // RdmaLinkByName finds a link by name and returns a pointer to the object if
 // found and nil error, otherwise returns error code.
 func (w *libWrapper) RdmaLinkByName(name string) (*netlink.RdmaLink, error) {
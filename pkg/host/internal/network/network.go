@@ -27,9 +27,11 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
+	"github.com/safchain/ethtool"
 	"github.com/vishvananda/netlink/nl"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	dputilsPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/lib/dputils"
 	ethtoolPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/lib/ethtool"
@@ -282,40 +284,43 @@ func (n *network) GetDevlinkDeviceParam(pciAddr, paramName string) (string, erro
 		funcLog.Info("GetDevlinkDeviceParam(): WARNING: can't read devlink parameter from the device, an empty value received")
 		return "", nil
 	}
-	var value string
-	var ok bool
-	switch param.Type {
-	case nl.DEVLINK_PARAM_TYPE_U8, nl.DEVLINK_PARAM_TYPE_U16, nl.DEVLINK_PARAM_TYPE_U32:
-		var valData uint64
-		switch v := param.Values[0].Data.(type) {
-		case uint8:
-			valData = uint64(v)
-		case uint16:
-			valData = uint64(v)
-		case uint32:
-			valData = uint64(v)
-		default:
-			return "", fmt.Errorf("value is not uint")
-		}
-		value = strconv.FormatUint(valData, 10)
+	value, err := devlinkParamValueToString(param.Type, param.Values[0].Data)
+	if err != nil {
+		return "", err
+	}
+	funcLog.V(2).Info("GetDevlinkDeviceParam(): result", "value", value)
+	return value, nil
+}
 
-	case nl.DEVLINK_PARAM_TYPE_STRING:
-		value, ok = param.Values[0].Data.(string)
-		if !ok {
-			return "", fmt.Errorf("value is not a string")
+// GetDevlinkDeviceParamByCmode returns the value of the devlink parameter for the device in the configuration mode
+// as a string, returns an error if the parameter doesn't support the configuration mode
+func (n *network) GetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode string) (string, error) {
+	funcLog := log.Log.WithValues("device", pciAddr, "param", paramName, "cmode", cmode)
+	funcLog.V(2).Info("GetDevlinkDeviceParamByCmode(): get device parameter")
+	targetCmode, err := devlinkParamCmodeFromString(cmode)
+	if err != nil {
+		return "", err
+	}
+	param, err := n.netlinkLib.DevlinkGetDeviceParamByName(consts.BusPci, pciAddr, paramName)
+	if err != nil {
+		funcLog.Error(err, "GetDevlinkDeviceParamByCmode(): fail to get devlink device param")
+		return "", err
+	}
+	for _, v := range param.Values {
+		if v.CMODE != targetCmode {
+			continue
 		}
-	case nl.DEVLINK_PARAM_TYPE_BOOL:
-		var boolValue bool
-		boolValue, ok = param.Values[0].Data.(bool)
-		if !ok {
-			return "", fmt.Errorf("value is not a bool")
+		if v.Data == nil {
+			return "", nil
 		}
-		value = strconv.FormatBool(boolValue)
-	default:
-		return "", fmt.Errorf("unknown value type: %d", param.Type)
+		value, err := devlinkParamValueToString(param.Type, v.Data)
+		if err != nil {
+			return "", err
+		}
+		funcLog.V(2).Info("GetDevlinkDeviceParamByCmode(): result", "value", value)
+		return value, nil
 	}
-	funcLog.V(2).Info("GetDevlinkDeviceParam(): result", "value", value)
-	return value, nil
+	return "", fmt.Errorf("param %s doesn't support cmode %s", paramName, cmode)
 }
 
 // SetDevlinkDeviceParam set devlink parameter for the device, accepts paramName and value
@@ -334,10 +339,95 @@ func (n *network) SetDevlinkDeviceParam(pciAddr, paramName, value string) error
 		funcLog.Error(err, "SetDevlinkDeviceParam(): error")
 		return err
 	}
-	targetCMOD := param.Values[0].CMODE
+	typedValue, err := devlinkParamValueFromString(param.Type, value)
+	if err != nil {
+		funcLog.Error(err, "SetDevlinkDeviceParam(): error")
+		return err
+	}
+	if err := n.netlinkLib.DevlinkSetDeviceParam(consts.BusPci, pciAddr, paramName, param.Values[0].CMODE, typedValue); err != nil {
+		funcLog.Error(err, "SetDevlinkDeviceParam(): failed to set parameter")
+		return err
+	}
+	return nil
+}
+
+// SetDevlinkDeviceParamByCmode sets the devlink parameter for the device in the configuration mode,
+// accepts the value as a string and converts it to the type of the parameter
+func (n *network) SetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode, value string) error {
+	funcLog := log.Log.WithValues("device", pciAddr, "param", paramName, "cmode", cmode, "value", value)
+	funcLog.V(2).Info("SetDevlinkDeviceParamByCmode(): set device parameter")
+	targetCmode, err := devlinkParamCmodeFromString(cmode)
+	if err != nil {
+		return err
+	}
+	param, err := n.netlinkLib.DevlinkGetDeviceParamByName(consts.BusPci, pciAddr, paramName)
+	if err != nil {
+		funcLog.Error(err, "SetDevlinkDeviceParamByCmode(): can't get existing param data")
+		return err
+	}
+	typedValue, err := devlinkParamValueFromString(param.Type, value)
+	if err != nil {
+		funcLog.Error(err, "SetDevlinkDeviceParamByCmode(): error")
+		return err
+	}
+	if err := n.netlinkLib.DevlinkSetDeviceParam(consts.BusPci, pciAddr, paramName, targetCmode, typedValue); err != nil {
+		funcLog.Error(err, "SetDevlinkDeviceParamByCmode(): failed to set parameter")
+		return err
+	}
+	return nil
+}
+
+// devlinkParamCmodeFromString converts the name of a devlink configuration mode to its netlink value
+func devlinkParamCmodeFromString(cmode string) (uint8, error) {
+	switch cmode {
+	case consts.DevlinkParamCmodeRuntime:
+		return nl.DEVLINK_PARAM_CMODE_RUNTIME, nil
+	case consts.DevlinkParamCmodeDriverinit:
+		return nl.DEVLINK_PARAM_CMODE_DRIVERINIT, nil
+	case consts.DevlinkParamCmodePermanent:
+		return nl.DEVLINK_PARAM_CMODE_PERMANENT, nil
+	}
+	return 0, fmt.Errorf("unknown devlink param cmode: %s", cmode)
+}
+
+// devlinkParamValueToString converts the value of a devlink parameter to a string
+func devlinkParamValueToString(paramType uint8, data interface{}) (string, error) {
+	switch paramType {
+	case nl.DEVLINK_PARAM_TYPE_U8, nl.DEVLINK_PARAM_TYPE_U16, nl.DEVLINK_PARAM_TYPE_U32:
+		var valData uint64
+		switch v := data.(type) {
+		case uint8:
+			valData = uint64(v)
+		case uint16:
+			valData = uint64(v)
+		case uint32:
+			valData = uint64(v)
+		default:
+			return "", fmt.Errorf("value is not uint")
+		}
+		return strconv.FormatUint(valData, 10), nil
+	case nl.DEVLINK_PARAM_TYPE_STRING:
+		value, ok := data.(string)
+		if !ok {
+			return "", fmt.Errorf("value is not a string")
+		}
+		return value, nil
+	case nl.DEVLINK_PARAM_TYPE_BOOL:
+		boolValue, ok := data.(bool)
+		if !ok {
+			return "", fmt.Errorf("value is not a bool")
+		}
+		return strconv.FormatBool(boolValue), nil
+	}
+	return "", fmt.Errorf("unknown value type: %d", paramType)
+}
+
+// devlinkParamValueFromString converts a string to the type of the devlink parameter
+func devlinkParamValueFromString(paramType uint8, value string) (interface{}, error) {
 	var typedValue interface{}
 	var v uint64
-	switch param.Type {
+	var err error
+	switch paramType {
 	case nl.DEVLINK_PARAM_TYPE_U8:
 		v, err = strconv.ParseUint(value, 10, 8)
 		typedValue = uint8(v)
@@ -348,23 +438,16 @@ func (n *network) SetDevlinkDeviceParam(pciAddr, paramName, value string) error
 		v, err = strconv.ParseUint(value, 10, 32)
 		typedValue = uint32(v)
 	case nl.DEVLINK_PARAM_TYPE_STRING:
-		err = nil
 		typedValue = value
 	case nl.DEVLINK_PARAM_TYPE_BOOL:
 		typedValue, err = strconv.ParseBool(value)
 	default:
-		return fmt.Errorf("parameter has unknown value type: %d", param.Type)
+		return nil, fmt.Errorf("parameter has unknown value type: %d", paramType)
 	}
 	if err != nil {
-		err = fmt.Errorf("failed to convert value %s to the required type: %T, devlink paramType is: %d", value, typedValue, param.Type)
-		funcLog.Error(err, "SetDevlinkDeviceParam(): error")
-		return err
-	}
-	if err := n.netlinkLib.DevlinkSetDeviceParam(consts.BusPci, pciAddr, paramName, targetCMOD, typedValue); err != nil {
-		funcLog.Error(err, "SetDevlinkDeviceParam(): failed to set parameter")
-		return err
+		return nil, fmt.Errorf("failed to convert value %s to the required type: %T, devlink paramType is: %d", value, typedValue, paramType)
 	}
-	return nil
+	return typedValue, nil
 }
 
 // EnableHwTcOffload makes sure that hw-tc-offload feature is enabled if device supports it
@@ -407,6 +490,215 @@ func (n *network) EnableHwTcOffload(ifaceName string) error {
 	return nil
 }
 
+// NeedToUpdateNetDevEthtoolSettings returns true if the offload features, the ring sizes or the number of channels
+// of the network device don't have the desired values
+func (n *network) NeedToUpdateNetDevEthtoolSettings(ifaceName string, settings *sriovnetworkv1.EthtoolSettings) (bool, error) {
+	if settings == nil {
+		return false, nil
+	}
+	features, err := n.getNetDevFeaturesToUpdate(ifaceName, settings.Features)
+	if err != nil {
+		return false, err
+	}
+	if len(features) > 0 {
+		log.Log.V(2).Info("NeedToUpdateNetDevEthtoolSettings(): features need update", "device", ifaceName, "features", features)
+		return true, nil
+	}
+	if settings.Rings != nil {
+		ring, err := n.ethtoolLib.GetRing(ifaceName)
+		if err != nil {
+			log.Log.Error(err, "NeedToUpdateNetDevEthtoolSettings(): can't read ring parameters", "device", ifaceName)
+			return false, err
+		}
+		if _, changed := desiredRing(ring, settings.Rings); changed {
+			log.Log.V(2).Info("NeedToUpdateNetDevEthtoolSettings(): rings need update", "device", ifaceName)
+			return true, nil
+		}
+	}
+	if settings.Channels != nil {
+		channels, err := n.ethtoolLib.GetChannels(ifaceName)
+		if err != nil {
+			log.Log.Error(err, "NeedToUpdateNetDevEthtoolSettings(): can't read channels", "device", ifaceName)
+			return false, err
+		}
+		if _, changed := desiredChannels(channels, settings.Channels); changed {
+			log.Log.V(2).Info("NeedToUpdateNetDevEthtoolSettings(): channels need update", "device", ifaceName)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// SetNetDevEthtoolSettings sets the offload features, the ring sizes and the number of channels of the network device,
+// only the settings that don't have the desired values are changed
+func (n *network) SetNetDevEthtoolSettings(ifaceName string, settings *sriovnetworkv1.EthtoolSettings) error {
+	if settings == nil {
+		return nil
+	}
+	log.Log.V(2).Info("SetNetDevEthtoolSettings(): set ethtool settings", "device", ifaceName, "settings", settings)
+	features, err := n.getNetDevFeaturesToUpdate(ifaceName, settings.Features)
+	if err != nil {
+		return err
+	}
+	if len(features) > 0 {
+		if err := n.ethtoolLib.Change(ifaceName, features); err != nil {
+			log.Log.Error(err, "SetNetDevEthtoolSettings(): can't change features", "device", ifaceName)
+			return err
+		}
+		// the fixed features are silently ignored by the kernel
+		current, err := n.ethtoolLib.Features(ifaceName)
+		if err != nil {
+			log.Log.Error(err, "SetNetDevEthtoolSettings(): can't read features state", "device", ifaceName)
+			return err
+		}
+		for name, value := range features {
+			if current[name] != value {
+				return fmt.Errorf("failed to set feature %s to %t on device %s, the feature can't be changed", name, value, ifaceName)
+			}
+		}
+	}
+	if settings.Rings != nil {
+		ring, err := n.ethtoolLib.GetRing(ifaceName)
+		if err != nil {
+			log.Log.Error(err, "SetNetDevEthtoolSettings(): can't read ring parameters", "device", ifaceName)
+			return err
+		}
+		if ring, changed := desiredRing(ring, settings.Rings); changed {
+			if ring.RxPending > ring.RxMaxPending || ring.TxPending > ring.TxMaxPending {
+				return fmt.Errorf("requested ring sizes rx %d tx %d exceed the maximum rx %d tx %d of device %s",
+					ring.RxPending, ring.TxPending, ring.RxMaxPending, ring.TxMaxPending, ifaceName)
+			}
+			if err := n.ethtoolLib.SetRing(ifaceName, ring); err != nil {
+				log.Log.Error(err, "SetNetDevEthtoolSettings(): can't set ring parameters", "device", ifaceName)
+				return err
+			}
+		}
+	}
+	if settings.Channels != nil {
+		channels, err := n.ethtoolLib.GetChannels(ifaceName)
+		if err != nil {
+			log.Log.Error(err, "SetNetDevEthtoolSettings(): can't read channels", "device", ifaceName)
+			return err
+		}
+		if channels, changed := desiredChannels(channels, settings.Channels); changed {
+			if channels.RxCount > channels.MaxRx || channels.TxCount > channels.MaxTx ||
+				channels.CombinedCount > channels.MaxCombined || channels.OtherCount > channels.MaxOther {
+				return fmt.Errorf("requested channels rx %d tx %d combined %d other %d exceed the maximum rx %d tx %d combined %d other %d of device %s",
+					channels.RxCount, channels.TxCount, channels.CombinedCount, channels.OtherCount,
+					channels.MaxRx, channels.MaxTx, channels.MaxCombined, channels.MaxOther, ifaceName)
+			}
+			if err := n.ethtoolLib.SetChannels(ifaceName, channels); err != nil {
+				log.Log.Error(err, "SetNetDevEthtoolSettings(): can't set channels", "device", ifaceName)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getNetDevFeaturesToUpdate returns the features that don't have the desired state on the network device,
+// an error is returned if the device doesn't know a feature
+func (n *network) getNetDevFeaturesToUpdate(ifaceName string, desired map[string]bool) (map[string]bool, error) {
+	toUpdate := map[string]bool{}
+	if len(desired) == 0 {
+		return toUpdate, nil
+	}
+	knownFeatures, err := n.ethtoolLib.FeatureNames(ifaceName)
+	if err != nil {
+		log.Log.Error(err, "getNetDevFeaturesToUpdate(): can't list supported features", "device", ifaceName)
+		return nil, err
+	}
+	current, err := n.ethtoolLib.Features(ifaceName)
+	if err != nil {
+		log.Log.Error(err, "getNetDevFeaturesToUpdate(): can't read features state", "device", ifaceName)
+		return nil, err
+	}
+	for name, value := range desired {
+		if _, isKnown := knownFeatures[name]; !isKnown {
+			return nil, fmt.Errorf("feature %s is not supported by device %s", name, ifaceName)
+		}
+		if current[name] != value {
+			toUpdate[name] = value
+		}
+	}
+	return toUpdate, nil
+}
+
+// desiredRing returns the ring parameters with the desired sizes and true if they differ from the current ones
+func desiredRing(ring ethtool.Ring, rings *sriovnetworkv1.EthtoolRings) (ethtool.Ring, bool) {
+	changed := false
+	if rings.Rx != nil && ring.RxPending != uint32(*rings.Rx) {
+		ring.RxPending = uint32(*rings.Rx)
+		changed = true
+	}
+	if rings.Tx != nil && ring.TxPending != uint32(*rings.Tx) {
+		ring.TxPending = uint32(*rings.Tx)
+		changed = true
+	}
+	return ring, changed
+}
+
+// desiredChannels returns the channels with the desired counts and true if they differ from the current ones
+func desiredChannels(channels ethtool.Channels, desired *sriovnetworkv1.EthtoolChannels) (ethtool.Channels, bool) {
+	changed := false
+	update := func(current *uint32, value *int) {
+		if value != nil && *current != uint32(*value) {
+			*current = uint32(*value)
+			changed = true
+		}
+	}
+	update(&channels.RxCount, desired.Rx)
+	update(&channels.TxCount, desired.Tx)
+	update(&channels.CombinedCount, desired.Combined)
+	update(&channels.OtherCount, desired.Other)
+	return channels, changed
+}
+
+// GetDevlinkDeviceSerialNumber returns the serial number (DSN) of the device reported by devlink
+func (n *network) GetDevlinkDeviceSerialNumber(pciAddr string) (string, error) {
+	log.Log.V(2).Info("GetDevlinkDeviceSerialNumber(): get device serial number", "device", pciAddr)
+	info, err := n.netlinkLib.DevlinkGetDeviceInfoByName(consts.BusPci, pciAddr)
+	if err != nil {
+		log.Log.Error(err, "GetDevlinkDeviceSerialNumber(): failed to get devlink device info", "device", pciAddr)
+		return "", err
+	}
+	return info.SerialNumber, nil
+}
+
+// ReloadDevlinkDevice reloads the driver of the device to apply the devlink parameters with the driverinit cmode
+func (n *network) ReloadDevlinkDevice(pciAddr string) error {
+	log.Log.Info("ReloadDevlinkDevice(): reload device", "device", pciAddr)
+	chrootDefinition := utils.GetChrootExtension()
+	_, stderr, err := n.utilsHelper.RunCommand("/bin/sh", "-c",
+		fmt.Sprintf("%s devlink dev reload %s/%s", chrootDefinition, consts.BusPci, pciAddr))
+	if err != nil {
+		log.Log.Error(err, "ReloadDevlinkDevice(): failed to reload device", "device", pciAddr, "stderr", stderr)
+		return err
+	}
+	return nil
+}
+
+// GetNetDevPrivFlags returns the ethtool private flags of the interface
+func (n *network) GetNetDevPrivFlags(ifaceName string) (map[string]bool, error) {
+	log.Log.V(2).Info("GetNetDevPrivFlags(): get private flags", "device", ifaceName)
+	flags, err := n.ethtoolLib.PrivFlags(ifaceName)
+	if err != nil {
+		log.Log.Error(err, "GetNetDevPrivFlags(): failed to get private flags", "device", ifaceName)
+		return nil, err
+	}
+	return flags, nil
+}
+
+// SetNetDevPrivFlags sets the ethtool private flags of the interface, the flags not in the map are left unchanged
+func (n *network) SetNetDevPrivFlags(ifaceName string, flags map[string]bool) error {
+	log.Log.V(2).Info("SetNetDevPrivFlags(): set private flags", "device", ifaceName, "flags", flags)
+	if err := n.ethtoolLib.UpdatePrivFlags(ifaceName, flags); err != nil {
+		log.Log.Error(err, "SetNetDevPrivFlags(): failed to set private flags", "device", ifaceName)
+		return err
+	}
+	return nil
+}
+
 // GetNetDevLinkAdminState returns the admin state of the interface.
 func (n *network) GetNetDevLinkAdminState(ifaceName string) string {
 	log.Log.V(2).Info("GetNetDevLinkAdminState(): get LinkAdminState", "device", ifaceName)
@@ -427,6 +719,17 @@ func (n *network) GetNetDevLinkAdminState(ifaceName string) string {
 	return consts.LinkAdminStateDown
 }
 
+// GetNetDevFirmwareVersion returns the firmware version of the network interface reported by ethtool
+func (n *network) GetNetDevFirmwareVersion(ifaceName string) string {
+	log.Log.V(2).Info("GetNetDevFirmwareVersion(): get firmware version", "device", ifaceName)
+	info, err := n.ethtoolLib.DriverInfo(ifaceName)
+	if err != nil {
+		log.Log.Error(err, "GetNetDevFirmwareVersion(): failed to get driver info", "device", ifaceName)
+		return ""
+	}
+	return info.FwVersion
+}
+
 // GetPciAddressFromInterfaceName parses sysfs to get pci address of an interface by name
 func (n *network) GetPciAddressFromInterfaceName(interfaceName string) (string, error) {
 	log.Log.V(2).Info("GetPciAddressFromInterfaceName(): get pci address", "interface", interfaceName)
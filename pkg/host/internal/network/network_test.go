@@ -23,10 +23,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/safchain/ethtool"
 	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netlink/nl"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	dputilsMockPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/lib/dputils/mock"
 	ethtoolMockPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/lib/ethtool/mock"
@@ -196,6 +199,52 @@ var _ = Describe("Network", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+	Context("DevlinkDeviceParamByCmode", func() {
+		multiCmodeParam := func() *netlink.DevlinkParam {
+			return &netlink.DevlinkParam{
+				Name: "param_name",
+				Type: nl.DEVLINK_PARAM_TYPE_U32,
+				Values: []netlink.DevlinkParamValue{
+					{Data: uint32(64), CMODE: nl.DEVLINK_PARAM_CMODE_RUNTIME},
+					{Data: uint32(128), CMODE: nl.DEVLINK_PARAM_CMODE_DRIVERINIT}},
+			}
+		}
+		It("get - value of the cmode", func() {
+			netlinkLibMock.EXPECT().DevlinkGetDeviceParamByName("pci", "0000:d8:00.1", "param_name").Return(multiCmodeParam(), nil)
+			result, err := n.GetDevlinkDeviceParamByCmode("0000:d8:00.1", "param_name", "driverinit")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal("128"))
+		})
+		It("get - unsupported cmode", func() {
+			netlinkLibMock.EXPECT().DevlinkGetDeviceParamByName("pci", "0000:d8:00.1", "param_name").Return(multiCmodeParam(), nil)
+			_, err := n.GetDevlinkDeviceParamByCmode("0000:d8:00.1", "param_name", "permanent")
+			Expect(err).To(MatchError(ContainSubstring("doesn't support cmode permanent")))
+		})
+		It("get - unknown cmode", func() {
+			_, err := n.GetDevlinkDeviceParamByCmode("0000:d8:00.1", "param_name", "foo")
+			Expect(err).To(HaveOccurred())
+		})
+		It("set - value in the cmode", func() {
+			netlinkLibMock.EXPECT().DevlinkGetDeviceParamByName("pci", "0000:d8:00.1", "param_name").Return(multiCmodeParam(), nil)
+			netlinkLibMock.EXPECT().DevlinkSetDeviceParam("pci", "0000:d8:00.1", "param_name",
+				uint8(nl.DEVLINK_PARAM_CMODE_RUNTIME), uint32(32)).Return(nil)
+			Expect(n.SetDevlinkDeviceParamByCmode("0000:d8:00.1", "param_name", "runtime", "32")).To(Succeed())
+		})
+		It("set - failed to convert type", func() {
+			netlinkLibMock.EXPECT().DevlinkGetDeviceParamByName("pci", "0000:d8:00.1", "param_name").Return(multiCmodeParam(), nil)
+			Expect(n.SetDevlinkDeviceParamByCmode("0000:d8:00.1", "param_name", "runtime", "true")).NotTo(Succeed())
+		})
+	})
+	Context("GetNetDevFirmwareVersion", func() {
+		It("Get", func() {
+			ethtoolLibMock.EXPECT().DriverInfo("enp216s0f0np0").Return(ethtool.DrvInfo{Driver: "mlx5_core", FwVersion: "22.39.1002 (MT_0000000359)"}, nil)
+			Expect(n.GetNetDevFirmwareVersion("enp216s0f0np0")).To(Equal("22.39.1002 (MT_0000000359)"))
+		})
+		It("Failed to get driver info", func() {
+			ethtoolLibMock.EXPECT().DriverInfo("enp216s0f0np0").Return(ethtool.DrvInfo{}, testErr)
+			Expect(n.GetNetDevFirmwareVersion("enp216s0f0np0")).To(BeEmpty())
+		})
+	})
 	Context("EnableHwTcOffload", func() {
 		It("Enabled", func() {
 			ethtoolLibMock.EXPECT().FeatureNames("enp216s0f0np0").Return(map[string]uint{"hw-tc-offload": 42}, nil)
@@ -243,6 +292,59 @@ var _ = Describe("Network", func() {
 			Expect(n.EnableHwTcOffload("enp216s0f0np0")).To(MatchError(testErr))
 		})
 	})
+	Context("NetDevEthtoolSettings", func() {
+		var settings *sriovnetworkv1.EthtoolSettings
+		BeforeEach(func() {
+			settings = &sriovnetworkv1.EthtoolSettings{
+				Features: map[string]bool{"rx-gro-hw": false, "rx-vlan-filter": true},
+				Rings:    &sriovnetworkv1.EthtoolRings{Rx: ptr.To(4096)},
+				Channels: &sriovnetworkv1.EthtoolChannels{Combined: ptr.To(8)},
+			}
+			ethtoolLibMock.EXPECT().FeatureNames("enp216s0f0np0").Return(map[string]uint{"rx-gro-hw": 1, "rx-vlan-filter": 2}, nil).AnyTimes()
+		})
+		It("no update needed", func() {
+			ethtoolLibMock.EXPECT().Features("enp216s0f0np0").Return(map[string]bool{"rx-gro-hw": false, "rx-vlan-filter": true}, nil)
+			ethtoolLibMock.EXPECT().GetRing("enp216s0f0np0").Return(ethtool.Ring{RxMaxPending: 8192, RxPending: 4096, TxPending: 1024}, nil)
+			ethtoolLibMock.EXPECT().GetChannels("enp216s0f0np0").Return(ethtool.Channels{MaxCombined: 16, CombinedCount: 8}, nil)
+			Expect(n.NeedToUpdateNetDevEthtoolSettings("enp216s0f0np0", settings)).To(BeFalse())
+		})
+		It("update needed - channels", func() {
+			ethtoolLibMock.EXPECT().Features("enp216s0f0np0").Return(map[string]bool{"rx-gro-hw": false, "rx-vlan-filter": true}, nil)
+			ethtoolLibMock.EXPECT().GetRing("enp216s0f0np0").Return(ethtool.Ring{RxMaxPending: 8192, RxPending: 4096, TxPending: 1024}, nil)
+			ethtoolLibMock.EXPECT().GetChannels("enp216s0f0np0").Return(ethtool.Channels{MaxCombined: 16, CombinedCount: 16}, nil)
+			Expect(n.NeedToUpdateNetDevEthtoolSettings("enp216s0f0np0", settings)).To(BeTrue())
+		})
+		It("no settings", func() {
+			Expect(n.NeedToUpdateNetDevEthtoolSettings("enp216s0f0np0", nil)).To(BeFalse())
+			Expect(n.SetNetDevEthtoolSettings("enp216s0f0np0", nil)).To(Succeed())
+		})
+		It("set - only changed settings", func() {
+			ethtoolLibMock.EXPECT().Features("enp216s0f0np0").Return(map[string]bool{"rx-gro-hw": true, "rx-vlan-filter": true}, nil)
+			ethtoolLibMock.EXPECT().Change("enp216s0f0np0", map[string]bool{"rx-gro-hw": false}).Return(nil)
+			ethtoolLibMock.EXPECT().Features("enp216s0f0np0").Return(map[string]bool{"rx-gro-hw": false, "rx-vlan-filter": true}, nil)
+			ethtoolLibMock.EXPECT().GetRing("enp216s0f0np0").Return(ethtool.Ring{RxMaxPending: 8192, TxMaxPending: 8192, RxPending: 1024, TxPending: 1024}, nil)
+			ethtoolLibMock.EXPECT().SetRing("enp216s0f0np0",
+				ethtool.Ring{RxMaxPending: 8192, TxMaxPending: 8192, RxPending: 4096, TxPending: 1024}).Return(nil)
+			ethtoolLibMock.EXPECT().GetChannels("enp216s0f0np0").Return(ethtool.Channels{MaxCombined: 16, CombinedCount: 8}, nil)
+			Expect(n.SetNetDevEthtoolSettings("enp216s0f0np0", settings)).To(Succeed())
+		})
+		It("set - fail on unknown feature", func() {
+			settings.Features["unknown"] = true
+			ethtoolLibMock.EXPECT().Features("enp216s0f0np0").Return(map[string]bool{"rx-gro-hw": false, "rx-vlan-filter": true}, nil)
+			Expect(n.SetNetDevEthtoolSettings("enp216s0f0np0", settings)).To(MatchError(ContainSubstring("feature unknown is not supported")))
+		})
+		It("set - fail on fixed feature", func() {
+			ethtoolLibMock.EXPECT().Features("enp216s0f0np0").Return(map[string]bool{"rx-gro-hw": true, "rx-vlan-filter": true}, nil)
+			ethtoolLibMock.EXPECT().Change("enp216s0f0np0", map[string]bool{"rx-gro-hw": false}).Return(nil)
+			ethtoolLibMock.EXPECT().Features("enp216s0f0np0").Return(map[string]bool{"rx-gro-hw": true, "rx-vlan-filter": true}, nil)
+			Expect(n.SetNetDevEthtoolSettings("enp216s0f0np0", settings)).To(MatchError(ContainSubstring("can't be changed")))
+		})
+		It("set - fail on ring size above the maximum", func() {
+			settings.Features = nil
+			ethtoolLibMock.EXPECT().GetRing("enp216s0f0np0").Return(ethtool.Ring{RxMaxPending: 2048, TxMaxPending: 2048, RxPending: 1024, TxPending: 1024}, nil)
+			Expect(n.SetNetDevEthtoolSettings("enp216s0f0np0", settings)).To(MatchError(ContainSubstring("exceed the maximum")))
+		})
+	})
 	Context("GetNetDevNodeGUID", func() {
 		It("Returns empty when pciAddr is empty", func() {
 			Expect(n.GetNetDevNodeGUID("")).To(Equal(""))
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
@@ -186,6 +187,94 @@ func (s *sriov) getVfInfo(vfAddr string, pfName string, eswitchMode string, devi
 	return vf
 }
 
+// setVfAdminAttributesStatus reports the administrative attributes of the VF configured through the PF
+func setVfAdminAttributesStatus(vf *sriovnetworkv1.VirtualFunction, vfInfos []netlink.VfInfo) {
+	info := findVfInfo(vfInfos, vf.VfID)
+	if info == nil {
+		return
+	}
+	spoofChk := info.Spoofchk
+	trust := info.Trust != 0
+	vf.Vlan = info.Vlan
+	vf.VlanQoS = info.Qos
+	vf.SpoofChk = &spoofChk
+	vf.Trust = &trust
+	vf.MinTxRate = int(info.MinTxRate)
+	vf.MaxTxRate = int(info.MaxTxRate)
+}
+
+// updateVfAdminAttributes sets the administrative attributes of the VFs, by VF ID, through the PF link
+func (s *sriov) updateVfAdminAttributes(iface *sriovnetworkv1.Interface, vfAttrs map[int]*sriovnetworkv1.VfAttributes) error {
+	pfLink, err := s.netlinkLib.LinkByName(iface.Name)
+	if err != nil {
+		log.Log.Error(err, "updateVfAdminAttributes(): unable to get PF link for device", "device", iface.PciAddress)
+		return err
+	}
+	for vfID, attrs := range vfAttrs {
+		if err := s.setVfAdminAttributes(pfLink, vfID, attrs); err != nil {
+			log.Log.Error(err, "updateVfAdminAttributes(): fail to configure VF admin attributes",
+				"device", iface.PciAddress, "vf", vfID)
+			return err
+		}
+	}
+	return nil
+}
+
+func findVfInfo(vfInfos []netlink.VfInfo, vfID int) *netlink.VfInfo {
+	for i := range vfInfos {
+		if vfInfos[i].ID == vfID {
+			return &vfInfos[i]
+		}
+	}
+	return nil
+}
+
+// setVfAdminAttributes sets the administrative attributes of the VF through the PF link,
+// the attributes not defined in the policy are left untouched
+func (s *sriov) setVfAdminAttributes(pfLink netlink.Link, vfID int, attrs *sriovnetworkv1.VfAttributes) error {
+	if attrs == nil {
+		return nil
+	}
+	log.Log.V(2).Info("setVfAdminAttributes(): configure VF", "vf", vfID, "attributes", attrs)
+
+	if attrs.Vlan != nil {
+		qos := 0
+		if attrs.VlanQoS != nil {
+			qos = *attrs.VlanQoS
+		}
+		if err := s.netlinkLib.LinkSetVfVlanQos(pfLink, vfID, *attrs.Vlan, qos); err != nil {
+			return fmt.Errorf("failed to set vlan %d qos %d: %w", *attrs.Vlan, qos, err)
+		}
+	}
+	if attrs.SpoofChk != nil {
+		if err := s.netlinkLib.LinkSetVfSpoofchk(pfLink, vfID, *attrs.SpoofChk); err != nil {
+			return fmt.Errorf("failed to set spoofchk %t: %w", *attrs.SpoofChk, err)
+		}
+	}
+	if attrs.Trust != nil {
+		if err := s.netlinkLib.LinkSetVfTrust(pfLink, vfID, *attrs.Trust); err != nil {
+			return fmt.Errorf("failed to set trust %t: %w", *attrs.Trust, err)
+		}
+	}
+	if attrs.MinTxRate != nil || attrs.MaxTxRate != nil {
+		// both rates are set together, keep the current value of the one not defined in the policy
+		var minRate, maxRate int
+		if info := findVfInfo(pfLink.Attrs().Vfs, vfID); info != nil {
+			minRate, maxRate = int(info.MinTxRate), int(info.MaxTxRate)
+		}
+		if attrs.MinTxRate != nil {
+			minRate = *attrs.MinTxRate
+		}
+		if attrs.MaxTxRate != nil {
+			maxRate = *attrs.MaxTxRate
+		}
+		if err := s.netlinkLib.LinkSetVfRate(pfLink, vfID, minRate, maxRate); err != nil {
+			return fmt.Errorf("failed to set tx rate min %d max %d: %w", minRate, maxRate, err)
+		}
+	}
+	return nil
+}
+
 func (s *sriov) VFIsReady(pciAddr string) (netlink.Link, error) {
 	log.Log.Info("VFIsReady()", "device", pciAddr)
 	var err error
@@ -234,6 +323,36 @@ func getDeviceClass(device *ghw.PCIDevice) (int64, error) {
 	return devClass, nil
 }
 
+// getPciPhysicalSlot returns the name of the physical slot of the PCI device, empty if it is not in a slot
+func getPciPhysicalSlot(pciAddr string) string {
+	slotsPath := filepath.Join(vars.FilesystemRoot, consts.SysBusPciSlots)
+	slots, err := os.ReadDir(slotsPath)
+	if err != nil {
+		return ""
+	}
+	// the address of the slot doesn't include the function of the devices
+	for _, slot := range slots {
+		data, err := os.ReadFile(filepath.Join(slotsPath, slot.Name(), "address"))
+		if err != nil {
+			continue
+		}
+		address := strings.TrimSpace(string(data))
+		if address != "" && strings.HasPrefix(pciAddr, address+".") {
+			return slot.Name()
+		}
+	}
+	return ""
+}
+
+// getPciLabel returns the label of the PCI device assigned by the firmware, empty if it has none
+func getPciLabel(pciAddr string) string {
+	data, err := os.ReadFile(filepath.Join(vars.FilesystemRoot, consts.SysBusPciDevices, pciAddr, "label"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
 func (s *sriov) DiscoverSriovDevices(storeManager store.ManagerInterface) ([]sriovnetworkv1.InterfaceExt, error) {
 	log.Log.V(2).Info("DiscoverSriovDevices")
 	pfList := []sriovnetworkv1.InterfaceExt{}
@@ -303,6 +422,14 @@ func (s *sriov) DiscoverSriovDevices(storeManager store.ManagerInterface) ([]sri
 			LinkType:       s.encapTypeToLinkType(link.Attrs().EncapType),
 			LinkSpeed:      s.networkHelper.GetNetDevLinkSpeed(pfNetName),
 			LinkAdminState: s.networkHelper.GetNetDevLinkAdminState(pfNetName),
+
+			FirmwareVersion: s.networkHelper.GetNetDevFirmwareVersion(pfNetName),
+			PhysicalSlot:    getPciPhysicalSlot(device.Address),
+			PciLabel:        getPciLabel(device.Address),
+		}
+		if device.Node != nil {
+			numaNode := device.Node.ID
+			iface.NumaNode = &numaNode
 		}
 
 		pfStatus, exist, err := storeManager.LoadPfsStatus(iface.PciAddress)
@@ -327,6 +454,7 @@ func (s *sriov) DiscoverSriovDevices(storeManager store.ManagerInterface) ([]sri
 				}
 				for _, vf := range vfs {
 					instance := s.getVfInfo(vf, pfNetName, iface.EswitchMode, devices)
+					setVfAdminAttributesStatus(&instance, link.Attrs().Vfs)
 					iface.VFs = append(iface.VFs, instance)
 				}
 			}
@@ -406,6 +534,9 @@ func (s *sriov) configSriovPFDevice(iface *sriovnetworkv1.Interface) error {
 	if err := s.configureHWOptionsForSwitchdev(iface); err != nil {
 		return err
 	}
+	if err := s.configDevlinkParams(iface); err != nil {
+		return err
+	}
 	// remove all UDEV rules for the PF before adding new rules to
 	// make sure that rules are always in a consistent state, e.g. there is no
 	// switchdev-related rules for PF in legacy mode
@@ -427,6 +558,12 @@ func (s *sriov) configSriovPFDevice(iface *sriovnetworkv1.Interface) error {
 		log.Log.Error(err, "configSriovPFDevice(): fail to add VR representor udev rule", "device", iface.PciAddress)
 		return err
 	}
+	if iface.Ethtool != nil {
+		if err := s.networkHelper.SetNetDevEthtoolSettings(iface.Name, iface.Ethtool); err != nil {
+			log.Log.Error(err, "configSriovPFDevice(): fail to set ethtool settings for PF", "device", iface.PciAddress)
+			return err
+		}
+	}
 	// set PF mtu
 	if iface.Mtu > 0 && iface.Mtu > s.networkHelper.GetNetdevMTU(iface.PciAddress) {
 		err = s.networkHelper.SetNetdevMTU(iface.PciAddress, iface.Mtu)
@@ -486,6 +623,133 @@ func (s *sriov) configureHWOptionsForSwitchdev(iface *sriovnetworkv1.Interface)
 	return nil
 }
 
+// GetDevlinkParamsToUpdate returns the devlink parameters of the PF that don't have the desired value on the host
+func (s *sriov) GetDevlinkParamsToUpdate(iface *sriovnetworkv1.Interface) ([]sriovnetworkv1.DevlinkParam, error) {
+	toUpdate := []sriovnetworkv1.DevlinkParam{}
+	for _, param := range iface.GetDevlinkParams() {
+		current, err := s.networkHelper.GetDevlinkDeviceParamByCmode(iface.PciAddress, param.Name, param.GetCmode())
+		if err != nil {
+			log.Log.Error(err, "GetDevlinkParamsToUpdate(): fail to read devlink param", "device", iface.PciAddress, "param", param.Name)
+			return nil, fmt.Errorf("failed to read devlink param %s of device %s: %w", param.Name, iface.PciAddress, err)
+		}
+		if !devlinkParamValueEqual(current, param.Value) {
+			log.Log.V(2).Info("GetDevlinkParamsToUpdate(): devlink param needs update", "device", iface.PciAddress,
+				"param", param.Name, "cmode", param.GetCmode(), "current", current, "desired", param.Value)
+			toUpdate = append(toUpdate, param)
+		}
+	}
+	return toUpdate, nil
+}
+
+// devlinkParamValueEqual compares the values of a devlink parameter, the booleans can have different representations
+func devlinkParamValueEqual(current, desired string) bool {
+	if current == desired {
+		return true
+	}
+	c, err := strconv.ParseBool(current)
+	if err != nil {
+		return false
+	}
+	d, err := strconv.ParseBool(desired)
+	if err != nil {
+		return false
+	}
+	return c == d
+}
+
+// configDevlinkParams sets the devlink parameters of the PF and reloads the driver of the device
+// if a driverinit parameter changed, the permanent parameters are applied after a reboot of the node
+func (s *sriov) configDevlinkParams(iface *sriovnetworkv1.Interface) error {
+	params, err := s.GetDevlinkParamsToUpdate(iface)
+	if err != nil {
+		return err
+	}
+	needReload := false
+	for _, param := range params {
+		if err := s.networkHelper.SetDevlinkDeviceParamByCmode(iface.PciAddress, param.Name, param.GetCmode(), param.Value); err != nil {
+			log.Log.Error(err, "configDevlinkParams(): fail to set devlink param", "device", iface.PciAddress, "param", param.Name)
+			return fmt.Errorf("failed to set devlink param %s of device %s: %w", param.Name, iface.PciAddress, err)
+		}
+		if param.GetCmode() == consts.DevlinkParamCmodeDriverinit {
+			needReload = true
+		}
+	}
+	if !needReload {
+		return nil
+	}
+	// the drivers don't support the reload of a device with VFs
+	if s.dputilsLib.GetVFconfigured(iface.PciAddress) > 0 {
+		if err := s.setEswitchModeAndNumVFs(iface.PciAddress, sriovnetworkv1.ESwithModeLegacy, 0); err != nil {
+			log.Log.Error(err, "configDevlinkParams(): fail to remove the VFs before the reload of the device", "device", iface.PciAddress)
+			return err
+		}
+	}
+	if err := s.networkHelper.ReloadDevlinkDevice(iface.PciAddress); err != nil {
+		log.Log.Error(err, "configDevlinkParams(): fail to reload the device", "device", iface.PciAddress)
+		return err
+	}
+	return nil
+}
+
+// NeedToUpdateEthtoolSettings returns true if the ethtool settings of the PF or of its VFs in the host
+// network namespace don't have the desired values, the VFs moved to a pod are not checked
+func (s *sriov) NeedToUpdateEthtoolSettings(iface *sriovnetworkv1.Interface) (bool, error) {
+	if !iface.ExternallyManaged && iface.Ethtool != nil {
+		needUpdate, err := s.networkHelper.NeedToUpdateNetDevEthtoolSettings(iface.Name, iface.Ethtool)
+		if err != nil {
+			return false, fmt.Errorf("failed to read ethtool settings of device %s: %w", iface.PciAddress, err)
+		}
+		if needUpdate {
+			return true, nil
+		}
+	}
+	if !slices.ContainsFunc(iface.VfGroups, func(g sriovnetworkv1.VfGroup) bool { return g.Ethtool != nil }) {
+		return false, nil
+	}
+	vfAddrs, err := s.dputilsLib.GetVFList(iface.PciAddress)
+	if err != nil {
+		return false, fmt.Errorf("failed to list VFs of device %s: %w", iface.PciAddress, err)
+	}
+	for _, addr := range vfAddrs {
+		vfID, err := s.dputilsLib.GetVFID(addr)
+		if err != nil {
+			return false, fmt.Errorf("failed to get VF id of device %s: %w", addr, err)
+		}
+		for _, group := range iface.VfGroups {
+			if !sriovnetworkv1.IndexInRange(vfID, group.VfRange) {
+				continue
+			}
+			if group.Ethtool == nil || sriovnetworkv1.StringInArray(group.DeviceType, vars.DpdkDrivers) {
+				break
+			}
+			vfName := s.networkHelper.TryGetInterfaceName(addr)
+			if vfName == "" {
+				break
+			}
+			needUpdate, err := s.networkHelper.NeedToUpdateNetDevEthtoolSettings(vfName, group.Ethtool)
+			if err != nil {
+				return false, fmt.Errorf("failed to read ethtool settings of VF %s: %w", addr, err)
+			}
+			if needUpdate {
+				return true, nil
+			}
+			break
+		}
+	}
+	return false, nil
+}
+
+// setVfEthtoolSettings sets the ethtool settings of the VF, the settings are only applied
+// while the VF netdev is in the host network namespace
+func (s *sriov) setVfEthtoolSettings(addr string, settings *sriovnetworkv1.EthtoolSettings) error {
+	vfName := s.networkHelper.TryGetInterfaceName(addr)
+	if vfName == "" {
+		log.Log.V(2).Info("setVfEthtoolSettings(): VF netdev not found in the host network namespace, skip", "address", addr)
+		return nil
+	}
+	return s.networkHelper.SetNetDevEthtoolSettings(vfName, settings)
+}
+
 func (s *sriov) checkExternallyManagedPF(iface *sriovnetworkv1.Interface) error {
 	log.Log.V(2).Info("checkExternallyManagedPF(): configure PF sriov device",
 		"device", iface.PciAddress)
@@ -598,6 +862,12 @@ func (s *sriov) configSriovVFDevices(iface *sriovnetworkv1.Interface) error {
 				}
 			}
 
+			// administrative attributes are set through the PF, so they apply to all the VF drivers
+			if err := s.setVfAdminAttributes(pfLink, vfID, group.VfAttributes); err != nil {
+				log.Log.Error(err, "configSriovVFDevices(): fail to configure VF admin attributes", "device", addr)
+				return err
+			}
+
 			if err = s.kernelHelper.UnbindDriverIfNeeded(addr, group.IsRdma); err != nil {
 				return err
 			}
@@ -623,6 +893,12 @@ func (s *sriov) configSriovVFDevices(iface *sriovnetworkv1.Interface) error {
 						return err
 					}
 				}
+				if group.Ethtool != nil {
+					if err := s.setVfEthtoolSettings(addr, group.Ethtool); err != nil {
+						log.Log.Error(err, "configSriovVFDevices(): fail to set ethtool settings for VF", "address", addr)
+						return err
+					}
+				}
 				if sriovnetworkv1.GetEswitchModeFromSpec(iface) == sriovnetworkv1.ESwithModeSwitchDev && group.VdpaType != "" {
 					if err := s.vdpaHelper.CreateVDPADevice(addr, group.VdpaType); err != nil {
 						log.Log.Error(err, "configSriovVFDevices(): fail to create VDPA device",
@@ -647,7 +923,7 @@ func (s *sriov) configSriovDevice(iface *sriovnetworkv1.Interface, skipVFConfigu
 		"device", iface.PciAddress, "config", iface, "skipVFConfiguration", skipVFConfiguration)
 	if !iface.ExternallyManaged {
 		if err := s.configSriovPFDevice(iface); err != nil {
-			return err
+			return types.NewInterfaceError(iface.PciAddress, consts.InterfaceSyncReasonPFConfigFailed, err)
 		}
 	}
 	if skipVFConfiguration {
@@ -656,7 +932,10 @@ func (s *sriov) configSriovDevice(iface *sriovnetworkv1.Interface, skipVFConfigu
 		}
 		log.Log.V(2).Info("configSriovDevice(): skipVFConfiguration is true, unbind all VFs from drivers",
 			"device", iface.PciAddress)
-		return s.unbindAllVFsOnPF(iface.PciAddress)
+		if err := s.unbindAllVFsOnPF(iface.PciAddress); err != nil {
+			return types.NewInterfaceError(iface.PciAddress, consts.InterfaceSyncReasonVFConfigFailed, err)
+		}
+		return nil
 	}
 	// we don't need to validate externally managed PFs when skipVFConfiguration is true.
 	// The function usually called with skipVFConfiguration true when running in the systemd mode and configuration is
@@ -664,21 +943,21 @@ func (s *sriov) configSriovDevice(iface *sriovnetworkv1.Interface, skipVFConfigu
 
 	if iface.ExternallyManaged {
 		if err := s.checkExternallyManagedPF(iface); err != nil {
-			return err
+			return types.NewInterfaceError(iface.PciAddress, consts.InterfaceSyncReasonExternallyManagedPF, err)
 		}
 	}
 	if err := s.configSriovVFDevices(iface); err != nil {
-		return err
+		return types.NewInterfaceError(iface.PciAddress, consts.InterfaceSyncReasonVFConfigFailed, err)
 	}
 	// Set PF link up
 	pfLink, err := s.netlinkLib.LinkByName(iface.Name)
 	if err != nil {
-		return err
+		return types.NewInterfaceError(iface.PciAddress, consts.InterfaceSyncReasonLinkSetupFailed, err)
 	}
 	if !s.netlinkLib.IsLinkAdminStateUp(pfLink) {
 		err = s.netlinkLib.LinkSetUp(pfLink)
 		if err != nil {
-			return err
+			return types.NewInterfaceError(iface.PciAddress, consts.InterfaceSyncReasonLinkSetupFailed, err)
 		}
 	}
 	return nil
@@ -699,7 +978,7 @@ func (s *sriov) ConfigSriovInterfaces(storeManager store.ManagerInterface,
 	}
 	if err != nil {
 		log.Log.Error(err, "cannot configure sriov interfaces")
-		return fmt.Errorf("cannot configure sriov interfaces")
+		return fmt.Errorf("cannot configure sriov interfaces: %w", err)
 	}
 	if sriovnetworkv1.ContainsSwitchdevInterface(interfaces) && len(toBeConfigured) > 0 {
 		// for switchdev devices we create udev rule that renames VF representors
@@ -717,7 +996,7 @@ func (s *sriov) ConfigSriovInterfaces(storeManager store.ManagerInterface,
 	}
 	if err != nil {
 		log.Log.Error(err, "cannot reset sriov interfaces")
-		return fmt.Errorf("cannot reset sriov interfaces")
+		return fmt.Errorf("cannot reset sriov interfaces: %w", err)
 	}
 	return nil
 }
@@ -731,7 +1010,7 @@ func (s *sriov) getConfigureAndReset(storeManager store.ManagerInterface, interf
 		for _, iface := range interfaces {
 			if iface.PciAddress == ifaceStatus.PciAddress {
 				configured = true
-				skip, err := skipSriovConfig(&iface, &ifaceStatus, storeManager)
+				skip, err := s.skipSriovConfig(&iface, &ifaceStatus, storeManager)
 				if err != nil {
 					log.Log.Error(err, "getConfigureAndReset(): failed to check interface")
 					return nil, nil, err
@@ -769,7 +1048,7 @@ func (s *sriov) configSriovInterfacesInParallel(storeManager store.ManagerInterf
 				} else {
 					if resetErr := s.ResetSriovDevice(iface.IfaceStatus); resetErr != nil {
 						log.Log.Error(resetErr, "configSriovInterfacesInParallel(): failed to reset on error SR-IOV interface")
-						err = resetErr
+						err = errors.Join(err, types.NewInterfaceError(iface.Iface.PciAddress, consts.InterfaceSyncReasonResetFailed, resetErr))
 					}
 				}
 			}
@@ -805,6 +1084,7 @@ func (s *sriov) resetSriovInterfacesInParallel(storeManager store.ManagerInterfa
 			var err error
 			if err = s.checkForConfigAndReset(*iface, storeManager); err != nil {
 				log.Log.Error(err, "resetSriovInterfacesInParallel(): fail to reset sriov interface. resetting interface.", "address", iface.PciAddress)
+				err = types.NewInterfaceError(iface.PciAddress, consts.InterfaceSyncReasonResetFailed, err)
 			}
 			errChannel <- err
 		}(&interfaces[ifaceIndex])
@@ -853,7 +1133,7 @@ func (s *sriov) resetSriovInterfaces(storeManager store.ManagerInterface, interf
 	for _, iface := range interfaces {
 		if err := s.checkForConfigAndReset(iface, storeManager); err != nil {
 			log.Log.Error(err, "resetSriovInterfaces(): failed to reset sriov interface. resetting interface.", "address", iface.PciAddress)
-			return err
+			return types.NewInterfaceError(iface.PciAddress, consts.InterfaceSyncReasonResetFailed, err)
 		}
 	}
 	log.Log.V(2).Info("resetSriovInterfaces(): sriov reset finished")
@@ -861,8 +1141,33 @@ func (s *sriov) resetSriovInterfaces(storeManager store.ManagerInterface, interf
 }
 
 // / skipSriovConfig checks if we need to apply SR-IOV configuration specified specific interface
-func skipSriovConfig(iface *sriovnetworkv1.Interface, ifaceStatus *sriovnetworkv1.InterfaceExt, storeManager store.ManagerInterface) (bool, error) {
+func (s *sriov) skipSriovConfig(iface *sriovnetworkv1.Interface, ifaceStatus *sriovnetworkv1.InterfaceExt, storeManager store.ManagerInterface) (bool, error) {
 	if !sriovnetworkv1.NeedToUpdateSriov(iface, ifaceStatus) {
+		// the devlink parameters and the ethtool settings are not reported in the status, check them on the host
+		if !iface.ExternallyManaged && len(iface.GetDevlinkParams()) > 0 {
+			params, err := s.GetDevlinkParamsToUpdate(iface)
+			if err != nil {
+				return false, err
+			}
+			if len(params) > 0 {
+				return false, nil
+			}
+		}
+		if iface.HasEthtoolSettings() {
+			needUpdate, err := s.NeedToUpdateEthtoolSettings(iface)
+			if err != nil {
+				return false, err
+			}
+			if needUpdate {
+				return false, nil
+			}
+		}
+		// a drift of the VF administrative attributes is fixed in place, without resetting the PF
+		if vfAttrs := sriovnetworkv1.GetVfAttributesToUpdate(iface, ifaceStatus); len(vfAttrs) > 0 {
+			if err := s.updateVfAdminAttributes(iface, vfAttrs); err != nil {
+				return false, types.NewInterfaceError(iface.PciAddress, consts.InterfaceSyncReasonVFConfigFailed, err)
+			}
+		}
 		log.Log.V(2).Info("ConfigSriovInterfaces(): no need update interface", "address", iface.PciAddress)
 
 		// Save the PF status to the host
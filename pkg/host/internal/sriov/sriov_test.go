@@ -23,14 +23,17 @@ import (
 	"syscall"
 
 	"github.com/jaypipes/ghw/pkg/pci"
+	"github.com/jaypipes/ghw/pkg/topology"
 	"github.com/jaypipes/pcidb"
 	"github.com/vishvananda/netlink"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	dputilsMockPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/lib/dputils/mock"
 	ghwMockPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/lib/ghw/mock"
 	netlinkMockPkg "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/internal/lib/netlink/mock"
@@ -85,7 +88,17 @@ var _ = Describe("SRIOV", func() {
 		})
 
 		It("discovered", func() {
-			ghwLibMock.EXPECT().PCI().Return(getTestPCIDevices(), nil)
+			helpers.GinkgoConfigureFakeFS(&fakefilesystem.FS{
+				Dirs: []string{"/sys/bus/pci/devices/0000:d8:00.0", "/sys/bus/pci/slots/3", "/sys/bus/pci/slots/4"},
+				Files: map[string][]byte{
+					"/sys/bus/pci/devices/0000:d8:00.0/label": []byte("NIC1 Port1\n"),
+					"/sys/bus/pci/slots/3/address":            []byte("0000:3b:00\n"),
+					"/sys/bus/pci/slots/4/address":            []byte("0000:d8:00\n"),
+				},
+			})
+			devices := getTestPCIDevices()
+			devices.Devices[0].Node = &topology.Node{ID: 1}
+			ghwLibMock.EXPECT().PCI().Return(devices, nil)
 			dputilsLibMock.EXPECT().IsSriovVF("0000:d8:00.0").Return(false)
 			dputilsLibMock.EXPECT().IsSriovVF("0000:d8:00.2").Return(true)
 			dputilsLibMock.EXPECT().IsSriovVF("0000:3b:00.0").Return(false)
@@ -103,6 +116,7 @@ var _ = Describe("SRIOV", func() {
 			}).MinTimes(1)
 			hostMock.EXPECT().GetNetDevLinkSpeed("enp216s0f0np0").Return("100000 Mb/s")
 			hostMock.EXPECT().GetNetDevLinkAdminState("enp216s0f0np0").Return("up")
+			hostMock.EXPECT().GetNetDevFirmwareVersion("enp216s0f0np0").Return("22.39.1002")
 			hostMock.EXPECT().GetNetDevNodeGUID("0000:d8:00.2").Return("guid1")
 			storeManagerMode.EXPECT().LoadPfsStatus("0000:d8:00.0").Return(nil, false, nil)
 
@@ -147,6 +161,10 @@ var _ = Describe("SRIOV", func() {
 				EswitchMode:       "switchdev",
 				ExternallyManaged: false,
 				TotalVfs:          1,
+				NumaNode:          ptr.To(1),
+				FirmwareVersion:   "22.39.1002",
+				PhysicalSlot:      "4",
+				PciLabel:          "NIC1 Port1",
 				VFs: []sriovnetworkv1.VirtualFunction{{
 					Name:            "enp216s0f0v0",
 					Mac:             "4e:fd:3d:08:59:b1",
@@ -293,6 +311,93 @@ var _ = Describe("SRIOV", func() {
 			helpers.GinkgoAssertFileContentsEquals("/sys/bus/pci/devices/0000:d8:00.0/sriov_numvfs", "2")
 		})
 
+		It("should configure VF admin attributes", func() {
+			helpers.GinkgoConfigureFakeFS(&fakefilesystem.FS{
+				Dirs:  []string{"/sys/bus/pci/devices/0000:d8:00.0"},
+				Files: map[string][]byte{"/sys/bus/pci/devices/0000:d8:00.0/sriov_numvfs": {}},
+			})
+
+			dputilsLibMock.EXPECT().GetSriovVFcapacity("0000:d8:00.0").Return(2)
+			dputilsLibMock.EXPECT().GetVFconfigured("0000:d8:00.0").Return(0)
+			dputilsLibMock.EXPECT().GetDriverName("0000:d8:00.0").Return("mlx5_core", nil)
+			netlinkLibMock.EXPECT().DevLinkGetDeviceByName("pci", "0000:d8:00.0").Return(&netlink.DevlinkDevice{
+				Attrs: netlink.DevlinkDevAttrs{Eswitch: netlink.DevlinkDevEswitchAttr{Mode: "legacy"}}}, nil)
+			hostMock.EXPECT().RemoveDisableNMUdevRule("0000:d8:00.0").Return(nil)
+			hostMock.EXPECT().RemovePersistPFNameUdevRule("0000:d8:00.0").Return(nil)
+			hostMock.EXPECT().RemoveVfRepresentorUdevRule("0000:d8:00.0").Return(nil)
+			hostMock.EXPECT().AddDisableNMUdevRule("0000:d8:00.0").Return(nil)
+			dputilsLibMock.EXPECT().GetVFList("0000:d8:00.0").Return([]string{"0000:d8:00.2", "0000:d8:00.3"}, nil)
+			pfLinkMock := netlinkMockPkg.NewMockLink(testCtrl)
+			netlinkLibMock.EXPECT().LinkByName("enp216s0f0np0").Return(pfLinkMock, nil).Times(3)
+			pfLinkMock.EXPECT().Attrs().Return(&netlink.LinkAttrs{Flags: 0, EncapType: "ether"})
+			pfLinkMock.EXPECT().Attrs().Return(&netlink.LinkAttrs{Vfs: []netlink.VfInfo{{ID: 1, MinTxRate: 10, MaxTxRate: 0}}})
+			netlinkLibMock.EXPECT().IsLinkAdminStateUp(pfLinkMock).Return(false)
+			netlinkLibMock.EXPECT().LinkSetUp(pfLinkMock).Return(nil)
+
+			dputilsLibMock.EXPECT().GetVFID("0000:d8:00.2").Return(0, nil).Times(2)
+			hostMock.EXPECT().HasDriver("0000:d8:00.2").Return(false, "")
+			hostMock.EXPECT().BindDefaultDriver("0000:d8:00.2").Return(nil)
+			hostMock.EXPECT().HasDriver("0000:d8:00.2").Return(true, "test")
+			hostMock.EXPECT().UnbindDriverIfNeeded("0000:d8:00.2", true).Return(nil)
+			hostMock.EXPECT().BindDefaultDriver("0000:d8:00.2").Return(nil)
+			hostMock.EXPECT().SetNetdevMTU("0000:d8:00.2", 2000).Return(nil)
+			hostMock.EXPECT().GetInterfaceIndex("0000:d8:00.2").Return(42, nil)
+			vf0LinkMock := netlinkMockPkg.NewMockLink(testCtrl)
+			vf0Mac, _ := net.ParseMAC("02:42:19:51:2f:af")
+			vf0LinkMock.EXPECT().Attrs().Return(&netlink.LinkAttrs{Name: "enp216s0f0_0", HardwareAddr: vf0Mac}).AnyTimes()
+			netlinkLibMock.EXPECT().LinkByIndex(42).Return(vf0LinkMock, nil)
+			netlinkLibMock.EXPECT().LinkSetVfHardwareAddr(vf0LinkMock, 0, vf0Mac).Return(nil)
+
+			dputilsLibMock.EXPECT().GetVFID("0000:d8:00.3").Return(1, nil)
+			hostMock.EXPECT().HasDriver("0000:d8:00.3").Return(true, "vfio-pci").Times(2)
+			netlinkLibMock.EXPECT().LinkSetVfVlanQos(pfLinkMock, 1, 100, 3).Return(nil)
+			netlinkLibMock.EXPECT().LinkSetVfSpoofchk(pfLinkMock, 1, false).Return(nil)
+			netlinkLibMock.EXPECT().LinkSetVfTrust(pfLinkMock, 1, true).Return(nil)
+			netlinkLibMock.EXPECT().LinkSetVfRate(pfLinkMock, 1, 10, 1000).Return(nil)
+			hostMock.EXPECT().UnbindDriverIfNeeded("0000:d8:00.3", false).Return(nil)
+			hostMock.EXPECT().BindDpdkDriver("0000:d8:00.3", "vfio-pci").Return(nil)
+			hostMock.EXPECT().RemoveDisableNMUdevRule("0000:d8:00.1").Return(nil)
+			hostMock.EXPECT().RemoveVfRepresentorUdevRule("0000:d8:00.1").Return(nil)
+			hostMock.EXPECT().RemovePersistPFNameUdevRule("0000:d8:00.1").Return(nil)
+
+			storeManagerMode.EXPECT().SaveLastPfAppliedStatus(gomock.Any()).Return(nil)
+			storeManagerMode.EXPECT().RemovePfAppliedStatus(gomock.Any()).Return(nil)
+			storeManagerMode.EXPECT().LoadPfsStatus("0000:d8:00.1").Return(&sriovnetworkv1.Interface{ExternallyManaged: false}, true, nil)
+
+			Expect(s.ConfigSriovInterfaces(storeManagerMode,
+				[]sriovnetworkv1.Interface{{
+					Name:       "enp216s0f0np0",
+					PciAddress: "0000:d8:00.0",
+					NumVfs:     2,
+					VfGroups: []sriovnetworkv1.VfGroup{
+						{
+							VfRange:      "0-0",
+							ResourceName: "test-resource0",
+							PolicyName:   "test-policy0",
+							Mtu:          2000,
+							IsRdma:       true,
+						},
+						{
+							VfRange:      "1-1",
+							ResourceName: "test-resource1",
+							PolicyName:   "test-policy1",
+							Mtu:          1600,
+							IsRdma:       false,
+							DeviceType:   "vfio-pci",
+							VfAttributes: &sriovnetworkv1.VfAttributes{
+								Vlan:      ptr.To(100),
+								VlanQoS:   ptr.To(3),
+								SpoofChk:  ptr.To(false),
+								Trust:     ptr.To(true),
+								MaxTxRate: ptr.To(1000),
+							},
+						}},
+				}},
+				[]sriovnetworkv1.InterfaceExt{{PciAddress: "0000:d8:00.0"}, {PciAddress: "0000:d8:00.1"}},
+				false)).NotTo(HaveOccurred())
+			helpers.GinkgoAssertFileContentsEquals("/sys/bus/pci/devices/0000:d8:00.0/sriov_numvfs", "2")
+		})
+
 		It("should configure in parallel", func() {
 			vars.ParallelNicConfig = true
 			defer func() {
@@ -827,7 +932,7 @@ var _ = Describe("SRIOV", func() {
 		It("externally managed - wrong VF count", func() {
 			dputilsLibMock.EXPECT().GetVFconfigured("0000:d8:00.0").Return(0)
 
-			Expect(s.ConfigSriovInterfaces(storeManagerMode,
+			err := s.ConfigSriovInterfaces(storeManagerMode,
 				[]sriovnetworkv1.Interface{{
 					Name:              "enp216s0f0np0",
 					PciAddress:        "0000:d8:00.0",
@@ -843,7 +948,12 @@ var _ = Describe("SRIOV", func() {
 						}},
 				}},
 				[]sriovnetworkv1.InterfaceExt{{PciAddress: "0000:d8:00.0"}},
-				false)).To(HaveOccurred())
+				false)
+			Expect(err).To(HaveOccurred())
+			ifaceErrs := types.GetInterfaceErrors(err)
+			Expect(ifaceErrs).To(HaveLen(1))
+			Expect(ifaceErrs[0].PciAddress).To(Equal("0000:d8:00.0"))
+			Expect(ifaceErrs[0].Reason).To(Equal(consts.InterfaceSyncReasonExternallyManagedPF))
 		})
 
 		It("externally managed - wrong MTU", func() {
@@ -1034,6 +1144,208 @@ var _ = Describe("SRIOV", func() {
 				true)).NotTo(HaveOccurred())
 			helpers.GinkgoAssertFileContentsEquals("/sys/bus/pci/devices/0000:d8:00.0/sriov_numvfs", "2")
 		})
+
+		It("should set the devlink params and reload the device for the driverinit params", func() {
+			helpers.GinkgoConfigureFakeFS(&fakefilesystem.FS{
+				Dirs:  []string{"/sys/bus/pci/devices/0000:d8:00.0"},
+				Files: map[string][]byte{"/sys/bus/pci/devices/0000:d8:00.0/sriov_numvfs": {}},
+			})
+
+			dputilsLibMock.EXPECT().GetSriovVFcapacity("0000:d8:00.0").Return(2)
+			hostMock.EXPECT().GetDevlinkDeviceParamByCmode("0000:d8:00.0", "max_macs", "driverinit").Return("64", nil)
+			hostMock.EXPECT().GetDevlinkDeviceParamByCmode("0000:d8:00.0", "enable_roce", "runtime").Return("true", nil)
+			gomock.InOrder(
+				hostMock.EXPECT().SetDevlinkDeviceParamByCmode("0000:d8:00.0", "max_macs", "driverinit", "32").Return(nil),
+				dputilsLibMock.EXPECT().GetVFconfigured("0000:d8:00.0").Return(0),
+				hostMock.EXPECT().ReloadDevlinkDevice("0000:d8:00.0").Return(nil),
+				dputilsLibMock.EXPECT().GetVFconfigured("0000:d8:00.0").Return(0),
+			)
+			dputilsLibMock.EXPECT().GetDriverName("0000:d8:00.0").Return("mlx5_core", nil)
+			netlinkLibMock.EXPECT().DevLinkGetDeviceByName("pci", "0000:d8:00.0").Return(
+				&netlink.DevlinkDevice{Attrs: netlink.DevlinkDevAttrs{Eswitch: netlink.DevlinkDevEswitchAttr{Mode: "legacy"}}},
+				nil)
+			hostMock.EXPECT().RemoveDisableNMUdevRule("0000:d8:00.0").Return(nil)
+			hostMock.EXPECT().RemovePersistPFNameUdevRule("0000:d8:00.0").Return(nil)
+			hostMock.EXPECT().RemoveVfRepresentorUdevRule("0000:d8:00.0").Return(nil)
+			hostMock.EXPECT().AddDisableNMUdevRule("0000:d8:00.0").Return(nil)
+			dputilsLibMock.EXPECT().GetVFList("0000:d8:00.0").Return([]string{"0000:d8:00.2"}, nil)
+			hostMock.EXPECT().Unbind("0000:d8:00.2").Return(nil)
+
+			storeManagerMode.EXPECT().SaveLastPfAppliedStatus(gomock.Any()).Return(nil)
+
+			Expect(s.ConfigSriovInterfaces(storeManagerMode,
+				[]sriovnetworkv1.Interface{{
+					Name:       "enp216s0f0np0",
+					PciAddress: "0000:d8:00.0",
+					NumVfs:     1,
+					VfGroups: []sriovnetworkv1.VfGroup{{
+						VfRange:      "0-0",
+						ResourceName: "test-resource0",
+						PolicyName:   "test-policy0",
+					}},
+					DevlinkParams: []sriovnetworkv1.DevlinkParam{
+						{Name: "max_macs", Value: "32", Cmode: "driverinit"},
+						{Name: "enable_roce", Value: "1"},
+					},
+				}},
+				[]sriovnetworkv1.InterfaceExt{{PciAddress: "0000:d8:00.0"}},
+				true)).NotTo(HaveOccurred())
+			helpers.GinkgoAssertFileContentsEquals("/sys/bus/pci/devices/0000:d8:00.0/sriov_numvfs", "1")
+		})
+
+		It("should remove the VFs, reload the device and create the VFs again for the MSI-X vectors of the Intel settings", func() {
+			helpers.GinkgoConfigureFakeFS(&fakefilesystem.FS{
+				Dirs:  []string{"/sys/bus/pci/devices/0000:d8:00.0"},
+				Files: map[string][]byte{"/sys/bus/pci/devices/0000:d8:00.0/sriov_numvfs": []byte("4")},
+			})
+
+			dputilsLibMock.EXPECT().GetSriovVFcapacity("0000:d8:00.0").Return(8)
+			hostMock.EXPECT().GetDevlinkDeviceParamByCmode("0000:d8:00.0", "msix_vec_per_pf_max", "driverinit").Return("64", nil)
+			gomock.InOrder(
+				hostMock.EXPECT().SetDevlinkDeviceParamByCmode("0000:d8:00.0", "msix_vec_per_pf_max", "driverinit", "128").Return(nil),
+				dputilsLibMock.EXPECT().GetVFconfigured("0000:d8:00.0").Return(4),
+				hostMock.EXPECT().ReloadDevlinkDevice("0000:d8:00.0").DoAndReturn(func(_ string) error {
+					// the VFs are removed before the reload
+					helpers.GinkgoAssertFileContentsEquals("/sys/bus/pci/devices/0000:d8:00.0/sriov_numvfs", "0")
+					return nil
+				}),
+				dputilsLibMock.EXPECT().GetVFconfigured("0000:d8:00.0").Return(0),
+			)
+			dputilsLibMock.EXPECT().GetDriverName("0000:d8:00.0").Return("ice", nil).Times(2)
+			netlinkLibMock.EXPECT().DevLinkGetDeviceByName("pci", "0000:d8:00.0").Return(
+				&netlink.DevlinkDevice{Attrs: netlink.DevlinkDevAttrs{Eswitch: netlink.DevlinkDevEswitchAttr{Mode: "legacy"}}},
+				nil).Times(2)
+			hostMock.EXPECT().RemoveDisableNMUdevRule("0000:d8:00.0").Return(nil)
+			hostMock.EXPECT().RemovePersistPFNameUdevRule("0000:d8:00.0").Return(nil)
+			hostMock.EXPECT().RemoveVfRepresentorUdevRule("0000:d8:00.0").Return(nil)
+			hostMock.EXPECT().AddDisableNMUdevRule("0000:d8:00.0").Return(nil)
+			dputilsLibMock.EXPECT().GetVFList("0000:d8:00.0").Return([]string{"0000:d8:01.0", "0000:d8:01.1"}, nil)
+			hostMock.EXPECT().Unbind("0000:d8:01.0").Return(nil)
+			hostMock.EXPECT().Unbind("0000:d8:01.1").Return(nil)
+
+			storeManagerMode.EXPECT().SaveLastPfAppliedStatus(gomock.Any()).Return(nil)
+
+			Expect(s.ConfigSriovInterfaces(storeManagerMode,
+				[]sriovnetworkv1.Interface{{
+					Name:       "ens785f0",
+					PciAddress: "0000:d8:00.0",
+					NumVfs:     2,
+					VfGroups: []sriovnetworkv1.VfGroup{{
+						VfRange:      "0-1",
+						ResourceName: "test-resource0",
+						PolicyName:   "test-policy0",
+					}},
+					Intel: &sriovnetworkv1.IntelConfig{MsixVecPerPfMax: ptr.To(128)},
+				}},
+				[]sriovnetworkv1.InterfaceExt{{PciAddress: "0000:d8:00.0"}},
+				true)).NotTo(HaveOccurred())
+			helpers.GinkgoAssertFileContentsEquals("/sys/bus/pci/devices/0000:d8:00.0/sriov_numvfs", "2")
+		})
+
+		It("should configure when only the devlink params need update", func() {
+			hostMock.EXPECT().GetDevlinkDeviceParamByCmode("0000:d8:00.0", "max_macs", "runtime").Return("64", nil).Times(2)
+			hostMock.EXPECT().SetDevlinkDeviceParamByCmode("0000:d8:00.0", "max_macs", "runtime", "32").Return(testError)
+
+			iface := sriovnetworkv1.Interface{
+				Name:       "enp216s0f0np0",
+				PciAddress: "0000:d8:00.0",
+				NumVfs:     1,
+				VfGroups: []sriovnetworkv1.VfGroup{{
+					VfRange:      "0-0",
+					ResourceName: "test-resource0",
+					PolicyName:   "test-policy0",
+				}},
+				DevlinkParams: []sriovnetworkv1.DevlinkParam{{Name: "max_macs", Value: "32"}},
+			}
+			ifaceStatus := sriovnetworkv1.InterfaceExt{
+				PciAddress: "0000:d8:00.0", NumVfs: 1, TotalVfs: 2, LinkAdminState: "up",
+				VFs: []sriovnetworkv1.VirtualFunction{{PciAddress: "0000:d8:00.2", VfID: 0, Driver: "mlx5_core"}},
+			}
+			dputilsLibMock.EXPECT().GetSriovVFcapacity("0000:d8:00.0").Return(2)
+			storeManagerMode.EXPECT().SaveLastPfAppliedStatus(gomock.Any()).Return(nil).AnyTimes()
+			hostMock.EXPECT().RemoveDisableNMUdevRule("0000:d8:00.0").Return(nil).AnyTimes()
+			hostMock.EXPECT().RemovePersistPFNameUdevRule("0000:d8:00.0").Return(nil).AnyTimes()
+			hostMock.EXPECT().RemoveVfRepresentorUdevRule("0000:d8:00.0").Return(nil).AnyTimes()
+			dputilsLibMock.EXPECT().GetVFconfigured("0000:d8:00.0").Return(1).AnyTimes()
+			dputilsLibMock.EXPECT().GetDriverName("0000:d8:00.0").Return("mlx5_core", nil).AnyTimes()
+			netlinkLibMock.EXPECT().DevLinkGetDeviceByName("pci", "0000:d8:00.0").Return(
+				&netlink.DevlinkDevice{Attrs: netlink.DevlinkDevAttrs{Eswitch: netlink.DevlinkDevEswitchAttr{Mode: "legacy"}}},
+				nil).AnyTimes()
+
+			Expect(s.ConfigSriovInterfaces(storeManagerMode, []sriovnetworkv1.Interface{iface},
+				[]sriovnetworkv1.InterfaceExt{ifaceStatus}, false)).To(MatchError(ContainSubstring("failed to set devlink param max_macs")))
+		})
+		It("should configure when only the ethtool settings need update", func() {
+			pfSettings := &sriovnetworkv1.EthtoolSettings{Features: map[string]bool{"rx-gro-hw": false}}
+			vfSettings := &sriovnetworkv1.EthtoolSettings{Rings: &sriovnetworkv1.EthtoolRings{Rx: ptr.To(1024)}}
+			hostMock.EXPECT().NeedToUpdateNetDevEthtoolSettings("enp216s0f0np0", pfSettings).Return(false, nil)
+			dputilsLibMock.EXPECT().GetVFList("0000:d8:00.0").Return([]string{"0000:d8:00.2"}, nil)
+			dputilsLibMock.EXPECT().GetVFID("0000:d8:00.2").Return(0, nil)
+			hostMock.EXPECT().TryGetInterfaceName("0000:d8:00.2").Return("enp216s0f0v0")
+			hostMock.EXPECT().NeedToUpdateNetDevEthtoolSettings("enp216s0f0v0", vfSettings).Return(true, nil)
+			hostMock.EXPECT().SetNetDevEthtoolSettings("enp216s0f0np0", pfSettings).Return(testError)
+
+			iface := sriovnetworkv1.Interface{
+				Name:       "enp216s0f0np0",
+				PciAddress: "0000:d8:00.0",
+				NumVfs:     1,
+				VfGroups: []sriovnetworkv1.VfGroup{{
+					VfRange:      "0-0",
+					ResourceName: "test-resource0",
+					PolicyName:   "test-policy0",
+					Ethtool:      vfSettings,
+				}},
+				Ethtool: pfSettings,
+			}
+			ifaceStatus := sriovnetworkv1.InterfaceExt{
+				PciAddress: "0000:d8:00.0", NumVfs: 1, TotalVfs: 2, LinkAdminState: "up",
+				VFs: []sriovnetworkv1.VirtualFunction{{PciAddress: "0000:d8:00.2", VfID: 0, Driver: "mlx5_core"}},
+			}
+			dputilsLibMock.EXPECT().GetSriovVFcapacity("0000:d8:00.0").Return(2)
+			storeManagerMode.EXPECT().SaveLastPfAppliedStatus(gomock.Any()).Return(nil).AnyTimes()
+			hostMock.EXPECT().RemoveDisableNMUdevRule("0000:d8:00.0").Return(nil).AnyTimes()
+			hostMock.EXPECT().RemovePersistPFNameUdevRule("0000:d8:00.0").Return(nil).AnyTimes()
+			hostMock.EXPECT().RemoveVfRepresentorUdevRule("0000:d8:00.0").Return(nil).AnyTimes()
+			hostMock.EXPECT().AddDisableNMUdevRule("0000:d8:00.0").Return(nil).AnyTimes()
+			dputilsLibMock.EXPECT().GetVFconfigured("0000:d8:00.0").Return(1).AnyTimes()
+			dputilsLibMock.EXPECT().GetDriverName("0000:d8:00.0").Return("mlx5_core", nil).AnyTimes()
+			netlinkLibMock.EXPECT().DevLinkGetDeviceByName("pci", "0000:d8:00.0").Return(
+				&netlink.DevlinkDevice{Attrs: netlink.DevlinkDevAttrs{Eswitch: netlink.DevlinkDevEswitchAttr{Mode: "legacy"}}},
+				nil).AnyTimes()
+
+			Expect(s.ConfigSriovInterfaces(storeManagerMode, []sriovnetworkv1.Interface{iface},
+				[]sriovnetworkv1.InterfaceExt{ifaceStatus}, false)).To(MatchError(ContainSubstring(testError.Error())))
+		})
+
+		It("should re-apply drifted VF attributes in place without resetting the PF", func() {
+			pfLinkMock := netlinkMockPkg.NewMockLink(testCtrl)
+			netlinkLibMock.EXPECT().LinkByName("enp216s0f0np0").Return(pfLinkMock, nil)
+			netlinkLibMock.EXPECT().LinkSetVfVlanQos(pfLinkMock, 1, 100, 0).Return(nil)
+			netlinkLibMock.EXPECT().LinkSetVfTrust(pfLinkMock, 1, true).Return(nil)
+			storeManagerMode.EXPECT().SaveLastPfAppliedStatus(gomock.Any()).Return(nil)
+
+			Expect(s.ConfigSriovInterfaces(storeManagerMode,
+				[]sriovnetworkv1.Interface{{
+					Name:       "enp216s0f0np0",
+					PciAddress: "0000:d8:00.0",
+					NumVfs:     2,
+					VfGroups: []sriovnetworkv1.VfGroup{{
+						VfRange:      "0-1",
+						ResourceName: "test-resource0",
+						PolicyName:   "test-policy0",
+						DeviceType:   "vfio-pci",
+						VfAttributes: &sriovnetworkv1.VfAttributes{Vlan: ptr.To(100), Trust: ptr.To(true)},
+					}},
+				}},
+				[]sriovnetworkv1.InterfaceExt{{
+					Name: "enp216s0f0np0", PciAddress: "0000:d8:00.0", NumVfs: 2, TotalVfs: 2, LinkAdminState: "up",
+					VFs: []sriovnetworkv1.VirtualFunction{
+						{PciAddress: "0000:d8:00.2", VfID: 0, Driver: "vfio-pci", Vlan: 100, Trust: ptr.To(true)},
+						{PciAddress: "0000:d8:00.3", VfID: 1, Driver: "vfio-pci", Vlan: 200, Trust: ptr.To(false)},
+					},
+				}},
+				false)).NotTo(HaveOccurred())
+		})
 	})
 
 	Context("VfIsReady", func() {
@@ -86,7 +86,7 @@ func NewHostManager(utilsInterface utils.CmdInterface) (HostManagerInterface, er
 	if err != nil {
 		return nil, err
 	}
-	br := bridge.New()
+	br := bridge.New(utilsInterface, netlinkLib)
 	sr := sriov.New(utilsInterface, k, n, u, v, ib, netlinkLib, dpUtils, sriovnetLib, ghwLib, br)
 	cpuInfoProvider := cpu.New(ghwLib)
 	s := systemd.New()
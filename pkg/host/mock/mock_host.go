@@ -416,6 +416,51 @@ func (mr *MockHostManagerInterfaceMockRecorder) GetDevlinkDeviceParam(pciAddr, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevlinkDeviceParam", reflect.TypeOf((*MockHostManagerInterface)(nil).GetDevlinkDeviceParam), pciAddr, paramName)
 }
 
+// GetDevlinkDeviceParamByCmode mocks base method.
+func (m *MockHostManagerInterface) GetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevlinkDeviceParamByCmode", pciAddr, paramName, cmode)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevlinkDeviceParamByCmode indicates an expected call of GetDevlinkDeviceParamByCmode.
+func (mr *MockHostManagerInterfaceMockRecorder) GetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevlinkDeviceParamByCmode", reflect.TypeOf((*MockHostManagerInterface)(nil).GetDevlinkDeviceParamByCmode), pciAddr, paramName, cmode)
+}
+
+// GetDevlinkDeviceSerialNumber mocks base method.
+func (m *MockHostManagerInterface) GetDevlinkDeviceSerialNumber(pciAddr string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevlinkDeviceSerialNumber", pciAddr)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevlinkDeviceSerialNumber indicates an expected call of GetDevlinkDeviceSerialNumber.
+func (mr *MockHostManagerInterfaceMockRecorder) GetDevlinkDeviceSerialNumber(pciAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevlinkDeviceSerialNumber", reflect.TypeOf((*MockHostManagerInterface)(nil).GetDevlinkDeviceSerialNumber), pciAddr)
+}
+
+// GetDevlinkParamsToUpdate mocks base method.
+func (m *MockHostManagerInterface) GetDevlinkParamsToUpdate(iface *v1.Interface) ([]v1.DevlinkParam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevlinkParamsToUpdate", iface)
+	ret0, _ := ret[0].([]v1.DevlinkParam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevlinkParamsToUpdate indicates an expected call of GetDevlinkParamsToUpdate.
+func (mr *MockHostManagerInterfaceMockRecorder) GetDevlinkParamsToUpdate(iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevlinkParamsToUpdate", reflect.TypeOf((*MockHostManagerInterface)(nil).GetDevlinkParamsToUpdate), iface)
+}
+
 // GetDriverByBusAndDevice mocks base method.
 func (m *MockHostManagerInterface) GetDriverByBusAndDevice(bus, device string) (string, error) {
 	m.ctrl.T.Helper()
@@ -460,6 +505,20 @@ func (mr *MockHostManagerInterfaceMockRecorder) GetLinkType(name any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinkType", reflect.TypeOf((*MockHostManagerInterface)(nil).GetLinkType), name)
 }
 
+// GetNetDevFirmwareVersion mocks base method.
+func (m *MockHostManagerInterface) GetNetDevFirmwareVersion(ifaceName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetDevFirmwareVersion", ifaceName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetNetDevFirmwareVersion indicates an expected call of GetNetDevFirmwareVersion.
+func (mr *MockHostManagerInterfaceMockRecorder) GetNetDevFirmwareVersion(ifaceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetDevFirmwareVersion", reflect.TypeOf((*MockHostManagerInterface)(nil).GetNetDevFirmwareVersion), ifaceName)
+}
+
 // GetNetDevLinkAdminState mocks base method.
 func (m *MockHostManagerInterface) GetNetDevLinkAdminState(ifaceName string) string {
 	m.ctrl.T.Helper()
@@ -516,6 +575,21 @@ func (mr *MockHostManagerInterfaceMockRecorder) GetNetDevNodeGUID(pciAddr any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetDevNodeGUID", reflect.TypeOf((*MockHostManagerInterface)(nil).GetNetDevNodeGUID), pciAddr)
 }
 
+// GetNetDevPrivFlags mocks base method.
+func (m *MockHostManagerInterface) GetNetDevPrivFlags(ifaceName string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetDevPrivFlags", ifaceName)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetDevPrivFlags indicates an expected call of GetNetDevPrivFlags.
+func (mr *MockHostManagerInterfaceMockRecorder) GetNetDevPrivFlags(ifaceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetDevPrivFlags", reflect.TypeOf((*MockHostManagerInterface)(nil).GetNetDevPrivFlags), ifaceName)
+}
+
 // GetNetdevMTU mocks base method.
 func (m *MockHostManagerInterface) GetNetdevMTU(pciAddr string) int {
 	m.ctrl.T.Helper()
@@ -724,6 +798,36 @@ func (mr *MockHostManagerInterfaceMockRecorder) LoadUdevRules() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadUdevRules", reflect.TypeOf((*MockHostManagerInterface)(nil).LoadUdevRules))
 }
 
+// NeedToUpdateEthtoolSettings mocks base method.
+func (m *MockHostManagerInterface) NeedToUpdateEthtoolSettings(iface *v1.Interface) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedToUpdateEthtoolSettings", iface)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NeedToUpdateEthtoolSettings indicates an expected call of NeedToUpdateEthtoolSettings.
+func (mr *MockHostManagerInterfaceMockRecorder) NeedToUpdateEthtoolSettings(iface any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedToUpdateEthtoolSettings", reflect.TypeOf((*MockHostManagerInterface)(nil).NeedToUpdateEthtoolSettings), iface)
+}
+
+// NeedToUpdateNetDevEthtoolSettings mocks base method.
+func (m *MockHostManagerInterface) NeedToUpdateNetDevEthtoolSettings(ifaceName string, settings *v1.EthtoolSettings) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedToUpdateNetDevEthtoolSettings", ifaceName, settings)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NeedToUpdateNetDevEthtoolSettings indicates an expected call of NeedToUpdateNetDevEthtoolSettings.
+func (mr *MockHostManagerInterfaceMockRecorder) NeedToUpdateNetDevEthtoolSettings(ifaceName, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedToUpdateNetDevEthtoolSettings", reflect.TypeOf((*MockHostManagerInterface)(nil).NeedToUpdateNetDevEthtoolSettings), ifaceName, settings)
+}
+
 // PrepareNMUdevRule mocks base method.
 func (m *MockHostManagerInterface) PrepareNMUdevRule() error {
 	m.ctrl.T.Helper()
@@ -856,6 +960,20 @@ func (mr *MockHostManagerInterfaceMockRecorder) RebindVfToDefaultDriver(pciAddr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebindVfToDefaultDriver", reflect.TypeOf((*MockHostManagerInterface)(nil).RebindVfToDefaultDriver), pciAddr)
 }
 
+// ReloadDevlinkDevice mocks base method.
+func (m *MockHostManagerInterface) ReloadDevlinkDevice(pciAddr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReloadDevlinkDevice", pciAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReloadDevlinkDevice indicates an expected call of ReloadDevlinkDevice.
+func (mr *MockHostManagerInterfaceMockRecorder) ReloadDevlinkDevice(pciAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReloadDevlinkDevice", reflect.TypeOf((*MockHostManagerInterface)(nil).ReloadDevlinkDevice), pciAddr)
+}
+
 // RemoveDisableNMUdevRule mocks base method.
 func (m *MockHostManagerInterface) RemoveDisableNMUdevRule(pfPciAddress string) error {
 	m.ctrl.T.Helper()
@@ -940,6 +1058,48 @@ func (mr *MockHostManagerInterfaceMockRecorder) SetDevlinkDeviceParam(pciAddr, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDevlinkDeviceParam", reflect.TypeOf((*MockHostManagerInterface)(nil).SetDevlinkDeviceParam), pciAddr, paramName, value)
 }
 
+// SetDevlinkDeviceParamByCmode mocks base method.
+func (m *MockHostManagerInterface) SetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode, value string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDevlinkDeviceParamByCmode", pciAddr, paramName, cmode, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDevlinkDeviceParamByCmode indicates an expected call of SetDevlinkDeviceParamByCmode.
+func (mr *MockHostManagerInterfaceMockRecorder) SetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDevlinkDeviceParamByCmode", reflect.TypeOf((*MockHostManagerInterface)(nil).SetDevlinkDeviceParamByCmode), pciAddr, paramName, cmode, value)
+}
+
+// SetNetDevEthtoolSettings mocks base method.
+func (m *MockHostManagerInterface) SetNetDevEthtoolSettings(ifaceName string, settings *v1.EthtoolSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNetDevEthtoolSettings", ifaceName, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNetDevEthtoolSettings indicates an expected call of SetNetDevEthtoolSettings.
+func (mr *MockHostManagerInterfaceMockRecorder) SetNetDevEthtoolSettings(ifaceName, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetDevEthtoolSettings", reflect.TypeOf((*MockHostManagerInterface)(nil).SetNetDevEthtoolSettings), ifaceName, settings)
+}
+
+// SetNetDevPrivFlags mocks base method.
+func (m *MockHostManagerInterface) SetNetDevPrivFlags(ifaceName string, flags map[string]bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNetDevPrivFlags", ifaceName, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNetDevPrivFlags indicates an expected call of SetNetDevPrivFlags.
+func (mr *MockHostManagerInterfaceMockRecorder) SetNetDevPrivFlags(ifaceName, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNetDevPrivFlags", reflect.TypeOf((*MockHostManagerInterface)(nil).SetNetDevPrivFlags), ifaceName, flags)
+}
+
 // SetNetdevMTU mocks base method.
 func (m *MockHostManagerInterface) SetNetdevMTU(pciAddr string, mtu int) error {
 	m.ctrl.T.Helper()
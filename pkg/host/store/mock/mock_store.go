@@ -69,6 +69,22 @@ func (mr *MockManagerInterfaceMockRecorder) GetCheckPointNodeState() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckPointNodeState", reflect.TypeOf((*MockManagerInterface)(nil).GetCheckPointNodeState))
 }
 
+// LoadKnownGoodNodeState mocks base method.
+func (m *MockManagerInterface) LoadKnownGoodNodeState() (*v1.SriovNetworkNodeState, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadKnownGoodNodeState")
+	ret0, _ := ret[0].(*v1.SriovNetworkNodeState)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LoadKnownGoodNodeState indicates an expected call of LoadKnownGoodNodeState.
+func (mr *MockManagerInterfaceMockRecorder) LoadKnownGoodNodeState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadKnownGoodNodeState", reflect.TypeOf((*MockManagerInterface)(nil).LoadKnownGoodNodeState))
+}
+
 // LoadPfsStatus mocks base method.
 func (m *MockManagerInterface) LoadPfsStatus(pciAddress string) (*v1.Interface, bool, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +101,22 @@ func (mr *MockManagerInterfaceMockRecorder) LoadPfsStatus(pciAddress any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadPfsStatus", reflect.TypeOf((*MockManagerInterface)(nil).LoadPfsStatus), pciAddress)
 }
 
+// LoadSyncFailures mocks base method.
+func (m *MockManagerInterface) LoadSyncFailures() (int64, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadSyncFailures")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// LoadSyncFailures indicates an expected call of LoadSyncFailures.
+func (mr *MockManagerInterfaceMockRecorder) LoadSyncFailures() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadSyncFailures", reflect.TypeOf((*MockManagerInterface)(nil).LoadSyncFailures))
+}
+
 // RemovePfAppliedStatus mocks base method.
 func (m *MockManagerInterface) RemovePfAppliedStatus(pciAddress string) error {
 	m.ctrl.T.Helper()
@@ -99,6 +131,34 @@ func (mr *MockManagerInterfaceMockRecorder) RemovePfAppliedStatus(pciAddress any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePfAppliedStatus", reflect.TypeOf((*MockManagerInterface)(nil).RemovePfAppliedStatus), pciAddress)
 }
 
+// SaveKnownGoodNodeState mocks base method.
+func (m *MockManagerInterface) SaveKnownGoodNodeState(arg0 *v1.SriovNetworkNodeState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveKnownGoodNodeState", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveKnownGoodNodeState indicates an expected call of SaveKnownGoodNodeState.
+func (mr *MockManagerInterfaceMockRecorder) SaveKnownGoodNodeState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveKnownGoodNodeState", reflect.TypeOf((*MockManagerInterface)(nil).SaveKnownGoodNodeState), arg0)
+}
+
+// SaveSyncFailures mocks base method.
+func (m *MockManagerInterface) SaveSyncFailures(generation int64, attempts int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSyncFailures", generation, attempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSyncFailures indicates an expected call of SaveSyncFailures.
+func (mr *MockManagerInterfaceMockRecorder) SaveSyncFailures(generation, attempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSyncFailures", reflect.TypeOf((*MockManagerInterface)(nil).SaveSyncFailures), generation, attempts)
+}
+
 // SaveLastPfAppliedStatus mocks base method.
 func (m *MockManagerInterface) SaveLastPfAppliedStatus(PfInfo *v1.Interface) error {
 	m.ctrl.T.Helper()
@@ -41,6 +41,12 @@ type ManagerInterface interface {
 
 	GetCheckPointNodeState() (*sriovnetworkv1.SriovNetworkNodeState, error)
 	WriteCheckpointFile(*sriovnetworkv1.SriovNetworkNodeState) error
+
+	SaveKnownGoodNodeState(*sriovnetworkv1.SriovNetworkNodeState) error
+	LoadKnownGoodNodeState() (*sriovnetworkv1.SriovNetworkNodeState, bool, error)
+
+	SaveSyncFailures(generation int64, attempts int) error
+	LoadSyncFailures() (int64, int, error)
 }
 
 type manager struct{}
@@ -205,3 +211,81 @@ func (s *manager) WriteCheckpointFile(ns *sriovnetworkv1.SriovNetworkNodeState)
 	}
 	return nil
 }
+
+// SaveKnownGoodNodeState saves the generation and the spec of the last successfully applied nodeState
+// as a json into /etc/sriov-operator/known-good-node-state.json
+func (s *manager) SaveKnownGoodNodeState(ns *sriovnetworkv1.SriovNetworkNodeState) error {
+	knownGood := &sriovnetworkv1.SriovNetworkNodeState{}
+	knownGood.Name = ns.Name
+	knownGood.Generation = ns.Generation
+	knownGood.Spec = *ns.Spec.DeepCopy()
+	data, err := json.Marshal(knownGood)
+	if err != nil {
+		log.Log.Error(err, "failed to marshal known-good nodeState")
+		return err
+	}
+
+	pathFile := filepath.Join(utils.GetHostExtension(), consts.KnownGoodNodeStatePath)
+	return os.WriteFile(pathFile, data, 0o644)
+}
+
+// LoadKnownGoodNodeState reads the last successfully applied nodeState saved by SaveKnownGoodNodeState,
+// only the name, the generation and the spec are set. Returns false if the file doesn't exist.
+func (s *manager) LoadKnownGoodNodeState() (*sriovnetworkv1.SriovNetworkNodeState, bool, error) {
+	pathFile := filepath.Join(utils.GetHostExtension(), consts.KnownGoodNodeStatePath)
+	data, err := os.ReadFile(pathFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+		log.Log.Error(err, "failed to read known-good nodeState", "path", pathFile)
+		return nil, false, err
+	}
+
+	knownGood := &sriovnetworkv1.SriovNetworkNodeState{}
+	if err = json.Unmarshal(data, knownGood); err != nil {
+		log.Log.Error(err, "failed to unmarshal known-good nodeState", "data", string(data))
+		return nil, false, err
+	}
+	return knownGood, true, nil
+}
+
+// syncFailures is the number of failed attempts to sync a nodeState generation
+type syncFailures struct {
+	Generation int64 `json:"generation"`
+	Attempts   int   `json:"attempts"`
+}
+
+// SaveSyncFailures saves the number of failed attempts to sync the nodeState generation
+// as a json into /etc/sriov-operator/sync-failures.json, so the count survives a restart of the daemon
+func (s *manager) SaveSyncFailures(generation int64, attempts int) error {
+	data, err := json.Marshal(&syncFailures{Generation: generation, Attempts: attempts})
+	if err != nil {
+		log.Log.Error(err, "failed to marshal sync failures")
+		return err
+	}
+
+	pathFile := filepath.Join(utils.GetHostExtension(), consts.SyncFailuresPath)
+	return os.WriteFile(pathFile, data, 0o644)
+}
+
+// LoadSyncFailures reads the generation and the number of failed attempts saved by SaveSyncFailures,
+// returns zero values if the file doesn't exist
+func (s *manager) LoadSyncFailures() (int64, int, error) {
+	pathFile := filepath.Join(utils.GetHostExtension(), consts.SyncFailuresPath)
+	data, err := os.ReadFile(pathFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, 0, nil
+		}
+		log.Log.Error(err, "failed to read sync failures", "path", pathFile)
+		return 0, 0, err
+	}
+
+	failures := &syncFailures{}
+	if err = json.Unmarshal(data, failures); err != nil {
+		log.Log.Error(err, "failed to unmarshal sync failures", "data", string(data))
+		return 0, 0, err
+	}
+	return failures.Generation, failures.Attempts, nil
+}
@@ -330,4 +330,55 @@ var _ = Describe("Store", func() {
 			Expect(ns.Name).To(Equal("worker-0"))
 		})
 	})
+
+	Context("KnownGoodNodeState", func() {
+		It("should return false if the file doesn't exist", func() {
+			ns, exist, err := m.LoadKnownGoodNodeState()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exist).To(BeFalse())
+			Expect(ns).To(BeNil())
+		})
+
+		It("should save only the generation and the spec of the nodeState", func() {
+			knownGood := testNodeState.DeepCopy()
+			knownGood.Generation = 3
+			knownGood.Spec.Interfaces = sriovnetworkv1.Interfaces{*testInterface}
+
+			err = m.SaveKnownGoodNodeState(knownGood)
+			Expect(err).ToNot(HaveOccurred())
+
+			ns, exist, err := m.LoadKnownGoodNodeState()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exist).To(BeTrue())
+			Expect(ns.Name).To(Equal("worker-0"))
+			Expect(ns.Generation).To(Equal(int64(3)))
+			Expect(equality.Semantic.DeepEqual(ns.Spec, knownGood.Spec)).To(BeTrue())
+			Expect(ns.Status.Interfaces).To(BeEmpty())
+		})
+
+		It("should fail if the file is not valid", func() {
+			err = os.WriteFile(utils.GetHostExtensionPath(consts.KnownGoodNodeStatePath), []byte("test"), 0644)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = m.LoadKnownGoodNodeState()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("SyncFailures", func() {
+		It("should return zero values if the file doesn't exist", func() {
+			generation, attempts, err := m.LoadSyncFailures()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(generation).To(BeZero())
+			Expect(attempts).To(BeZero())
+		})
+
+		It("should save and load the failed attempts", func() {
+			Expect(m.SaveSyncFailures(4, 2)).To(Succeed())
+			generation, attempts, err := m.LoadSyncFailures()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(generation).To(Equal(int64(4)))
+			Expect(attempts).To(Equal(2))
+		})
+	})
 })
@@ -100,10 +100,30 @@ type NetworkInterface interface {
 	// as a string. Automatically set CMODE for the parameter and converts the value to the right
 	// type before submitting it.
 	SetDevlinkDeviceParam(pciAddr, paramName, value string) error
+	// GetDevlinkDeviceParamByCmode returns the value of the devlink parameter for the device in the configuration mode
+	// (runtime, driverinit or permanent) as a string
+	GetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode string) (string, error)
+	// SetDevlinkDeviceParamByCmode sets the devlink parameter for the device in the configuration mode
+	// (runtime, driverinit or permanent), accepts the value as a string
+	SetDevlinkDeviceParamByCmode(pciAddr, paramName, cmode, value string) error
+	// GetDevlinkDeviceSerialNumber returns the serial number (DSN) of the device reported by devlink
+	GetDevlinkDeviceSerialNumber(pciAddr string) (string, error)
+	// ReloadDevlinkDevice reloads the driver of the device to apply the devlink parameters with the driverinit cmode
+	ReloadDevlinkDevice(pciAddr string) error
+	// GetNetDevPrivFlags returns the ethtool private flags of the interface
+	GetNetDevPrivFlags(ifaceName string) (map[string]bool, error)
+	// SetNetDevPrivFlags sets the ethtool private flags of the interface, the flags not in the map are left unchanged
+	SetNetDevPrivFlags(ifaceName string, flags map[string]bool) error
 	// EnableHwTcOffload make sure that hw-tc-offload feature is enabled if device supports it
 	EnableHwTcOffload(ifaceName string) error
+	// NeedToUpdateNetDevEthtoolSettings returns true if the ethtool settings of the network device don't have the desired values
+	NeedToUpdateNetDevEthtoolSettings(ifaceName string, settings *sriovnetworkv1.EthtoolSettings) (bool, error)
+	// SetNetDevEthtoolSettings sets the offload features, the ring sizes and the number of channels of the network device
+	SetNetDevEthtoolSettings(ifaceName string, settings *sriovnetworkv1.EthtoolSettings) error
 	// GetNetDevLinkAdminState returns the admin state of the interface.
 	GetNetDevLinkAdminState(ifaceName string) string
+	// GetNetDevFirmwareVersion returns the firmware version of the network interface
+	GetNetDevFirmwareVersion(ifaceName string) string
 	// GetPciAddressFromInterfaceName parses sysfs to get pci address of an interface by name
 	GetPciAddressFromInterfaceName(interfaceName string) (string, error)
 	// DiscoverRDMASubsystem returns RDMA subsystem mode
@@ -150,6 +170,11 @@ type SriovInterface interface {
 	GetLinkType(name string) string
 	// ResetSriovDevice resets the number of virtual function for the specific physical function to zero
 	ResetSriovDevice(ifaceStatus sriovnetworkv1.InterfaceExt) error
+	// GetDevlinkParamsToUpdate returns the devlink parameters of the PF that don't have the desired value on the host
+	GetDevlinkParamsToUpdate(iface *sriovnetworkv1.Interface) ([]sriovnetworkv1.DevlinkParam, error)
+	// NeedToUpdateEthtoolSettings returns true if the ethtool settings of the PF or of its VFs in the host
+	// network namespace don't have the desired values
+	NeedToUpdateEthtoolSettings(iface *sriovnetworkv1.Interface) (bool, error)
 	// DiscoverSriovDevices returns a list of all the available SR-IOV capable network interfaces on the system
 	DiscoverSriovDevices(storeManager store.ManagerInterface) ([]sriovnetworkv1.InterfaceExt, error)
 	// DiscoverSriovVirtualDevices returns a list of all the available SR-IOV VF network interfaces on the system.
@@ -17,6 +17,9 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 )
@@ -63,4 +66,73 @@ type SriovConfig struct {
 type SriovResult struct {
 	SyncStatus    string `yaml:"syncStatus"`
 	LastSyncError string `yaml:"lastSyncError"`
+	// contains the interfaces the service failed to configure
+	FailedInterfaces []sriovnetworkv1.InterfaceSyncResult `yaml:"failedInterfaces,omitempty"`
+}
+
+// InterfaceError is returned when the configuration of a specific PF fails,
+// it allows the daemon to report the result per interface in the SriovNetworkNodeState status
+type InterfaceError struct {
+	PciAddress string
+	// Reason contains one of the consts.InterfaceSyncReason* codes
+	Reason string
+	Err    error
+}
+
+// NewInterfaceError wraps the error with the PF pci address and the reason code,
+// an error that is already an InterfaceError for the same PF is returned as is
+func NewInterfaceError(pciAddress, reason string, err error) error {
+	var ifaceErr *InterfaceError
+	if errors.As(err, &ifaceErr) && ifaceErr.PciAddress == pciAddress {
+		return err
+	}
+	return &InterfaceError{PciAddress: pciAddress, Reason: reason, Err: err}
+}
+
+func (e *InterfaceError) Error() string {
+	return fmt.Sprintf("%s: %s: %v", e.PciAddress, e.Reason, e.Err)
+}
+
+func (e *InterfaceError) Unwrap() error {
+	return e.Err
+}
+
+// GetInterfaceErrors returns all the InterfaceErrors from the error tree, errors joined with errors.Join
+// or kerrors.NewAggregate are inspected as well
+func GetInterfaceErrors(err error) []*InterfaceError {
+	if err == nil {
+		return nil
+	}
+	if ifaceErr, ok := err.(*InterfaceError); ok {
+		return []*InterfaceError{ifaceErr}
+	}
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		var result []*InterfaceError
+		for _, inner := range e.Unwrap() {
+			result = append(result, GetInterfaceErrors(inner)...)
+		}
+		return result
+	case interface{ Errors() []error }:
+		var result []*InterfaceError
+		for _, inner := range e.Errors() {
+			result = append(result, GetInterfaceErrors(inner)...)
+		}
+		return result
+	}
+	return GetInterfaceErrors(errors.Unwrap(err))
+}
+
+// InterfaceSyncFailures converts the InterfaceErrors from the error tree to failed InterfaceSyncResults
+func InterfaceSyncFailures(err error) []sriovnetworkv1.InterfaceSyncResult {
+	var result []sriovnetworkv1.InterfaceSyncResult
+	for _, ifaceErr := range GetInterfaceErrors(err) {
+		result = append(result, sriovnetworkv1.InterfaceSyncResult{
+			PciAddress: ifaceErr.PciAddress,
+			Phase:      consts.InterfaceSyncPhaseFailed,
+			Reason:     ifaceErr.Reason,
+			Message:    ifaceErr.Err.Error(),
+		})
+	}
+	return result
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/helper"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/orchestrator"
 	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
+	externalplugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins/external"
 	genericplugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins/generic"
 	intelplugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins/intel"
 	k8splugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins/k8s"
@@ -30,6 +31,8 @@ var VendorPluginMap = map[string]func(helpers helper.HostHelpersInterface) (plug
 // It handles SR-IOV device discovery and plugin loading for physical hardware.
 type Baremetal struct {
 	hostHelpers helper.HostHelpersInterface
+	// loader of the external vendor plugins, nil if the external plugins are disabled
+	externalPlugins *externalplugin.Loader
 }
 
 // New creates a new Baremetal platform instance.
@@ -92,6 +95,24 @@ func (bm *Baremetal) GetVendorPlugins(ns *sriovnetworkv1.SriovNetworkNodeState)
 	return genericPlugin, additionalPlugins, nil
 }
 
+// LoadNewExternalPlugins returns the external vendor plugins started since the plugins were loaded,
+// nothing if the external plugins are disabled.
+func (bm *Baremetal) LoadNewExternalPlugins() ([]plugin.VendorPlugin, error) {
+	if bm.externalPlugins == nil {
+		return nil, nil
+	}
+	return bm.externalPlugins.Load()
+}
+
+// RejectExternalPlugin closes the connection to an external vendor plugin returned by LoadNewExternalPlugins
+// that is not used by the daemon
+func (bm *Baremetal) RejectExternalPlugin(p plugin.VendorPlugin) {
+	if bm.externalPlugins == nil {
+		return
+	}
+	bm.externalPlugins.Reject(p)
+}
+
 // SystemdGetVendorPlugin returns the appropriate plugin for systemd mode based on the phase.
 // For PhasePre, returns a generic plugin that skips VF and bridge configuration.
 // For PhasePost, returns a full generic plugin.
@@ -109,7 +130,8 @@ func (bm *Baremetal) SystemdGetVendorPlugin(phase string) (plugin.VendorPlugin,
 }
 
 // loadVendorPlugins loads vendor-specific plugins based on the detected device vendors.
-// Scans the node state interfaces and loads the appropriate vendor plugin for each unique vendor.
+// Scans the node state interfaces and loads the appropriate vendor plugin for each unique vendor,
+// then loads the external vendor plugins listening on the sockets of vars.VendorPluginsDir.
 func (bm *Baremetal) loadVendorPlugins(ns *sriovnetworkv1.SriovNetworkNodeState) ([]plugin.VendorPlugin, error) {
 	loadedPluginsMap := map[string]plugin.VendorPlugin{}
 
@@ -129,6 +151,22 @@ func (bm *Baremetal) loadVendorPlugins(ns *sriovnetworkv1.SriovNetworkNodeState)
 		}
 	}
 
+	if vars.VendorPluginsDir != "" {
+		bm.externalPlugins = externalplugin.NewLoader(vars.VendorPluginsDir, vars.VendorPluginsTimeout)
+		externalPlugins, err := bm.externalPlugins.Load()
+		if err != nil {
+			return nil, fmt.Errorf("loadVendorPlugins(): failed to load external vendor plugins error: %v", err)
+		}
+		for _, plug := range externalPlugins {
+			if _, ok := loadedPluginsMap[plug.Name()]; ok {
+				log.Log.Error(nil, "loadVendorPlugins(): external vendor plugin conflicts with an already loaded plugin, skipping", "plugin-name", plug.Name())
+				bm.externalPlugins.Reject(plug)
+				continue
+			}
+			loadedPluginsMap[plug.Name()] = plug
+		}
+	}
+
 	vendorPlugins := []plugin.VendorPlugin{}
 	for _, val := range loadedPluginsMap {
 		vendorPlugins = append(vendorPlugins, val)
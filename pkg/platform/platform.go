@@ -54,6 +54,16 @@ type Interface interface {
 	SystemdGetVendorPlugin(phase string) (plugin.VendorPlugin, error)
 }
 
+// ExternalPluginsLoader is implemented by the platforms loading external vendor plugins
+type ExternalPluginsLoader interface {
+	// LoadNewExternalPlugins returns the external vendor plugins started since the last call.
+	// This is called on every reconcile of the daemon to load the plugins started after it.
+	LoadNewExternalPlugins() ([]plugin.VendorPlugin, error)
+	// RejectExternalPlugin closes the connection to a plugin returned by LoadNewExternalPlugins that is not used,
+	// e.g. because it is disabled or its name conflicts with a loaded plugin.
+	RejectExternalPlugin(p plugin.VendorPlugin)
+}
+
 // New creates a new platform interface based on the provided platform type.
 // Returns the platform interface for the detected platform, or an error if the platform is unsupported.
 func New(platformType consts.PlatformTypes, hostHelpers helper.HostHelpersInterface) (Interface, error) {
@@ -0,0 +1,321 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
+)
+
+// connectTimeout is the timeout of the handshake with an external plugin, a plugin that doesn't reply
+// in time is skipped until the next scan of the plugins directory
+const connectTimeout = 10 * time.Second
+
+// externalPlugin is a plugin.VendorPlugin calling an external vendor plugin over its unix socket
+type externalPlugin struct {
+	name    string
+	timeout time.Duration
+
+	lock       sync.Mutex
+	socketPath string
+	// connection to the plugin, nil once the Loader closed it
+	conn *grpc.ClientConn
+	// a call failed because the plugin didn't listen on its socket, the Loader connects to the socket again
+	unavailable bool
+}
+
+// Connect connects to the external vendor plugin listening on the unix socket and checks it
+// supports the protocol version of the config daemon, every call to the plugin fails after the timeout
+func Connect(socketPath string, timeout time.Duration) (plugin.VendorPlugin, error) {
+	p, err := connect(socketPath, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// connect connects to the external vendor plugin listening on the unix socket, see Connect
+func connect(socketPath string, timeout time.Duration) (*externalPlugin, error) {
+	conn, err := grpc.NewClient("unix://"+socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(codecName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for external plugin socket %s: %w", socketPath, err)
+	}
+
+	p := &externalPlugin{socketPath: socketPath, timeout: timeout, conn: conn}
+	info := &GetInfoResponse{}
+	if err := p.call(min(timeout, connectTimeout), "GetInfo", &GetInfoRequest{ProtocolVersion: ProtocolVersion}, info); err != nil {
+		conn.Close()
+		return nil, p.callError("GetInfo", err)
+	}
+	if info.ProtocolVersion != ProtocolVersion {
+		conn.Close()
+		return nil, fmt.Errorf("external plugin on socket %s uses protocol version %q, expected %q",
+			socketPath, info.ProtocolVersion, ProtocolVersion)
+	}
+	if info.Name == "" {
+		conn.Close()
+		return nil, fmt.Errorf("external plugin on socket %s returned an empty name", socketPath)
+	}
+	p.name = info.Name
+	return p, nil
+}
+
+// Loader loads the external vendor plugins listening on the sockets of a directory.
+// Every Load scans the directory again, so the plugins started after the config daemon
+// and the plugins that failed to connect are loaded by a later Load. The connection to a plugin
+// is closed when its socket is removed or recreated, or when the plugin stopped replying, and the
+// plugin is connected again once it listens on its socket.
+type Loader struct {
+	dir     string
+	timeout time.Duration
+	// sockets connected by the Loader, by socket name
+	sockets map[string]*loadedSocket
+	// plugins returned by Load, by plugin name
+	plugins map[string]*externalPlugin
+}
+
+// loadedSocket is a socket of the directory connected by the Loader
+type loadedSocket struct {
+	plugin *externalPlugin
+	// the socket is recreated when the plugin restarts
+	modTime time.Time
+}
+
+// NewLoader returns a Loader for the sockets of the directory, the calls to the plugins fail after the timeout
+func NewLoader(dir string, timeout time.Duration) *Loader {
+	return &Loader{dir: dir, timeout: timeout, sockets: map[string]*loadedSocket{}, plugins: map[string]*externalPlugin{}}
+}
+
+// Load connects to the external vendor plugins listening on the sockets not loaded yet and returns the new plugins,
+// the plugins failing to connect are skipped. A plugin already returned is connected again in place,
+// it is not returned again.
+func (l *Loader) Load() ([]plugin.VendorPlugin, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read external plugins directory %s: %w", l.dir, err)
+	}
+	modTimes := map[string]time.Time{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), SocketSuffix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			// the socket was removed since the directory was read
+			continue
+		}
+		modTimes[entry.Name()] = info.ModTime()
+	}
+
+	for name, socket := range l.sockets {
+		modTime, exists := modTimes[name]
+		if exists && modTime.Equal(socket.modTime) && !socket.plugin.isUnavailable() {
+			continue
+		}
+		log.Log.Info("Load(): closing the connection to external vendor plugin", "plugin-name", socket.plugin.Name(),
+			"socket", name, "socket-exists", exists)
+		socket.plugin.disconnect()
+		delete(l.sockets, name)
+	}
+
+	plugins := []plugin.VendorPlugin{}
+	for name, modTime := range modTimes {
+		if _, ok := l.sockets[name]; ok {
+			continue
+		}
+		p, err := connect(filepath.Join(l.dir, name), l.timeout)
+		if err != nil {
+			log.Log.Error(err, "Load(): failed to connect to external vendor plugin, skipping", "socket", name)
+			continue
+		}
+		loaded, ok := l.plugins[p.Name()]
+		switch {
+		case !ok:
+			log.Log.Info("Load(): loaded external vendor plugin", "plugin-name", p.Name(), "socket", name)
+			l.plugins[p.Name()] = p
+			plugins = append(plugins, p)
+		case loaded.isConnected():
+			log.Log.Error(nil, "Load(): external vendor plugin is already loaded from another socket, skipping",
+				"plugin-name", p.Name(), "socket", name)
+			p.disconnect()
+		default:
+			log.Log.Info("Load(): reconnected external vendor plugin", "plugin-name", p.Name(), "socket", name)
+			loaded.reconnect(p)
+			p = loaded
+		}
+		// the socket is not connected again until it is recreated
+		l.sockets[name] = &loadedSocket{plugin: p, modTime: modTime}
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name() < plugins[j].Name() })
+	return plugins, nil
+}
+
+// Reject closes the connection to a plugin returned by Load that is not used, e.g. because its name conflicts
+// with another plugin. The plugin is loaded again only once its socket is recreated.
+func (l *Loader) Reject(p plugin.VendorPlugin) {
+	ext, ok := p.(*externalPlugin)
+	if !ok || l.plugins[ext.Name()] != ext {
+		return
+	}
+	ext.disconnect()
+	delete(l.plugins, ext.Name())
+}
+
+// Load connects to the external vendor plugins listening on the sockets of the directory,
+// the plugins failing to connect are skipped
+func Load(dir string, timeout time.Duration) ([]plugin.VendorPlugin, error) {
+	return NewLoader(dir, timeout).Load()
+}
+
+// Name returns the name of the external plugin
+func (p *externalPlugin) Name() string {
+	return p.name
+}
+
+// OnNodeStateChange calls OnNodeStateChange of the external plugin
+func (p *externalPlugin) OnNodeStateChange(ns *sriovnetworkv1.SriovNetworkNodeState) (bool, bool, error) {
+	resp := &OnNodeStateChangeResponse{}
+	if err := p.invoke("OnNodeStateChange", &NodeStateRequest{NodeState: ns}, resp); err != nil {
+		return false, false, err
+	}
+	return resp.NeedDrain, resp.NeedReboot, nil
+}
+
+// Apply calls Apply of the external plugin
+func (p *externalPlugin) Apply() error {
+	return p.invoke("Apply", &ApplyRequest{}, &ApplyResponse{})
+}
+
+// CheckStatusChanges calls CheckStatusChanges of the external plugin
+func (p *externalPlugin) CheckStatusChanges(ns *sriovnetworkv1.SriovNetworkNodeState) (bool, error) {
+	resp := &CheckStatusChangesResponse{}
+	if err := p.invoke("CheckStatusChanges", &NodeStateRequest{NodeState: ns}, resp); err != nil {
+		return false, err
+	}
+	return resp.Changed, nil
+}
+
+// PlanNodeStateChange calls PlanNodeStateChange of the external plugin,
+// plugin.ErrPlanningNotSupported is returned if the plugin doesn't implement it
+func (p *externalPlugin) PlanNodeStateChange(ns *sriovnetworkv1.SriovNetworkNodeState) (*plugin.NodeStatePlan, error) {
+	resp := &PlanNodeStateChangeResponse{}
+	err := p.call(p.timeout, "PlanNodeStateChange", &NodeStateRequest{NodeState: ns}, resp)
+	if status.Code(err) == codes.Unimplemented {
+		return nil, plugin.ErrPlanningNotSupported
+	}
+	if err != nil {
+		return nil, p.callError("PlanNodeStateChange", err)
+	}
+	return &plugin.NodeStatePlan{
+		NeedDrain:          resp.NeedDrain,
+		NeedReboot:         resp.NeedReboot,
+		KernelArgsToAdd:    resp.KernelArgsToAdd,
+		KernelArgsToRemove: resp.KernelArgsToRemove,
+	}, nil
+}
+
+// invoke calls the method of the external plugin, the call fails after the timeout of the plugin
+func (p *externalPlugin) invoke(method string, req, resp any) error {
+	if err := p.call(p.timeout, method, req, resp); err != nil {
+		return p.callError(method, err)
+	}
+	return nil
+}
+
+// call calls the method of the external plugin and returns the gRPC error, the call fails
+// right away if the plugin doesn't listen on its socket, e.g. when its container is restarting
+func (p *externalPlugin) call(timeout time.Duration, method string, req, resp any) error {
+	p.lock.Lock()
+	conn := p.conn
+	p.lock.Unlock()
+	if conn == nil {
+		return status.Error(codes.Unavailable, "plugin is not connected")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := conn.Invoke(ctx, fullMethodName(method), req, resp)
+	if status.Code(err) == codes.Unavailable {
+		p.lock.Lock()
+		p.unavailable = true
+		p.lock.Unlock()
+	}
+	return err
+}
+
+// callError returns the error of a failed call to the method of the external plugin
+func (p *externalPlugin) callError(method string, err error) error {
+	p.lock.Lock()
+	socketPath := p.socketPath
+	p.lock.Unlock()
+	return fmt.Errorf("external plugin %s on socket %s: %s failed: %s", p.name, socketPath, method, status.Convert(err).Message())
+}
+
+// isConnected returns true if the connection to the plugin is open
+func (p *externalPlugin) isConnected() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.conn != nil
+}
+
+// isUnavailable returns true if a call failed because the plugin didn't listen on its socket
+func (p *externalPlugin) isUnavailable() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.unavailable
+}
+
+// disconnect closes the connection to the plugin, the calls fail until the plugin is reconnected
+func (p *externalPlugin) disconnect() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.conn == nil {
+		return
+	}
+	if err := p.conn.Close(); err != nil {
+		log.Log.Error(err, "disconnect(): failed to close the connection to external vendor plugin", "plugin-name", p.name)
+	}
+	p.conn = nil
+}
+
+// reconnect replaces the connection to the plugin with the connection of the same plugin listening again
+func (p *externalPlugin) reconnect(connected *externalPlugin) {
+	connected.lock.Lock()
+	conn, socketPath := connected.conn, connected.socketPath
+	connected.conn = nil
+	connected.lock.Unlock()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.conn, p.socketPath, p.unavailable = conn, socketPath, false
+}
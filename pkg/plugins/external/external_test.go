@@ -0,0 +1,266 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
+	mock_plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins/mock"
+)
+
+// planningPlugin is a plugin supporting the planning
+type planningPlugin struct {
+	*mock_plugin.MockVendorPlugin
+	*mock_plugin.MockPlanningPlugin
+}
+
+// oldVersionServer is a plugin implementing another version of the protocol
+type oldVersionServer struct {
+	VendorPluginServer
+}
+
+func (s *oldVersionServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return &GetInfoResponse{Name: "old", ProtocolVersion: "v0"}, nil
+}
+
+var _ = Describe("External vendor plugins", func() {
+	var (
+		testCtrl   *gomock.Controller
+		vendorMock *mock_plugin.MockVendorPlugin
+		dir        string
+		cancel     context.CancelFunc
+		nodeState  *sriovnetworkv1.SriovNetworkNodeState
+	)
+
+	serve := func(name string) {
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		socketPath := filepath.Join(dir, name+SocketSuffix)
+		go func() {
+			defer GinkgoRecover()
+			Expect(Serve(ctx, socketPath, vendorMock)).To(Succeed())
+		}()
+		Eventually(socketPath).Should(BeAnExistingFile())
+	}
+
+	BeforeEach(func() {
+		testCtrl = gomock.NewController(GinkgoT())
+		vendorMock = mock_plugin.NewMockVendorPlugin(testCtrl)
+		vendorMock.EXPECT().Name().Return("vendor").AnyTimes()
+		// the unix socket paths are limited to 108 characters, the temporary directory of ginkgo may be too long
+		var err error
+		dir, err = os.MkdirTemp("", "plugins")
+		Expect(err).ToNot(HaveOccurred())
+		cancel = func() {}
+		nodeState = &sriovnetworkv1.SriovNetworkNodeState{ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Namespace: "test"}}
+	})
+
+	AfterEach(func() {
+		cancel()
+		Expect(os.RemoveAll(dir)).To(Succeed())
+		testCtrl.Finish()
+	})
+
+	It("should load the plugins of the directory and forward the calls", func() {
+		serve("vendor")
+		Expect(os.WriteFile(filepath.Join(dir, "README"), []byte("not a socket"), 0644)).To(Succeed())
+
+		plugins, err := Load(dir, time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(HaveLen(1))
+		p := plugins[0]
+		Expect(p.Name()).To(Equal("vendor"))
+
+		vendorMock.EXPECT().OnNodeStateChange(gomock.Any()).DoAndReturn(func(ns *sriovnetworkv1.SriovNetworkNodeState) (bool, bool, error) {
+			Expect(ns.Name).To(Equal("worker-0"))
+			return true, false, nil
+		})
+		needDrain, needReboot, err := p.OnNodeStateChange(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(needDrain).To(BeTrue())
+		Expect(needReboot).To(BeFalse())
+
+		vendorMock.EXPECT().CheckStatusChanges(gomock.Any()).Return(true, nil)
+		changed, err := p.CheckStatusChanges(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		vendorMock.EXPECT().Apply().Return(fmt.Errorf("failed to configure the firmware"))
+		Expect(p.Apply()).To(MatchError(ContainSubstring("Apply failed: failed to configure the firmware")))
+	})
+
+	It("should skip the plugins that fail to connect and load them on a later scan", func() {
+		// a socket left by a plugin that is not running
+		Expect(os.WriteFile(filepath.Join(dir, "stale"+SocketSuffix), []byte{}, 0644)).To(Succeed())
+		loader := NewLoader(dir, time.Second)
+		plugins, err := loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(BeEmpty())
+
+		serve("vendor")
+		plugins, err = loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(HaveLen(1))
+		Expect(plugins[0].Name()).To(Equal("vendor"))
+
+		// the loaded plugins are not returned again
+		plugins, err = loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(BeEmpty())
+	})
+
+	It("should reconnect a plugin restarted on its socket", func() {
+		socketPath := filepath.Join(dir, "vendor"+SocketSuffix)
+		serve("vendor")
+		loader := NewLoader(dir, time.Second)
+		plugins, err := loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(HaveLen(1))
+		p := plugins[0]
+
+		cancel()
+		Eventually(socketPath).ShouldNot(BeAnExistingFile())
+		Expect(p.Apply()).To(MatchError(ContainSubstring("Apply failed")))
+		plugins, err = loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(BeEmpty())
+		Expect(p.Apply()).To(MatchError(ContainSubstring("plugin is not connected")))
+
+		// the plugin is connected again in place, it is not returned again
+		serve("vendor")
+		plugins, err = loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(BeEmpty())
+		vendorMock.EXPECT().Apply().Return(nil)
+		Expect(p.Apply()).To(Succeed())
+	})
+
+	It("should reconnect a plugin which recreated its socket between two scans", func() {
+		serve("vendor")
+		loader := NewLoader(dir, time.Second)
+		plugins, err := loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		p := plugins[0]
+		connected := p.(*externalPlugin).conn
+
+		cancel()
+		Eventually(filepath.Join(dir, "vendor"+SocketSuffix)).ShouldNot(BeAnExistingFile())
+		serve("vendor")
+		plugins, err = loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(BeEmpty())
+		Expect(p.(*externalPlugin).conn).ToNot(BeIdenticalTo(connected))
+		vendorMock.EXPECT().Apply().Return(nil)
+		Expect(p.Apply()).To(Succeed())
+	})
+
+	It("should close the connection to a rejected plugin until its socket is recreated", func() {
+		serve("vendor")
+		loader := NewLoader(dir, time.Second)
+		plugins, err := loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		loader.Reject(plugins[0])
+		Expect(plugins[0].Apply()).To(MatchError(ContainSubstring("plugin is not connected")))
+
+		plugins, err = loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(BeEmpty())
+
+		cancel()
+		Eventually(filepath.Join(dir, "vendor"+SocketSuffix)).ShouldNot(BeAnExistingFile())
+		serve("vendor")
+		plugins, err = loader.Load()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(HaveLen(1))
+	})
+
+	It("should forward the planning to the plugins supporting it", func() {
+		planningMock := mock_plugin.NewMockPlanningPlugin(testCtrl)
+		vendorMock = mock_plugin.NewMockVendorPlugin(testCtrl)
+		vendorMock.EXPECT().Name().Return("vendor").AnyTimes()
+		server := NewServer(&planningPlugin{vendorMock, planningMock})
+
+		planningMock.EXPECT().PlanNodeStateChange(gomock.Any()).Return(&plugin.NodeStatePlan{NeedReboot: true, KernelArgsToAdd: []string{"iommu=pt"}}, nil)
+		resp, err := server.PlanNodeStateChange(context.Background(), &NodeStateRequest{NodeState: nodeState})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.NeedReboot).To(BeTrue())
+		Expect(resp.KernelArgsToAdd).To(Equal([]string{"iommu=pt"}))
+	})
+
+	It("should report the plugins not supporting the planning", func() {
+		serve("vendor")
+		p, err := Connect(filepath.Join(dir, "vendor"+SocketSuffix), time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = p.(plugin.PlanningPlugin).PlanNodeStateChange(nodeState)
+		Expect(err).To(MatchError(plugin.ErrPlanningNotSupported))
+	})
+
+	It("should not fail if the directory doesn't exist", func() {
+		plugins, err := Load(filepath.Join(dir, "missing"), time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plugins).To(BeEmpty())
+	})
+
+	It("should fail if the plugin doesn't reply before the timeout", func() {
+		serve("vendor")
+		p, err := Connect(filepath.Join(dir, "vendor"+SocketSuffix), 100*time.Millisecond)
+		Expect(err).ToNot(HaveOccurred())
+
+		vendorMock.EXPECT().Apply().DoAndReturn(func() error {
+			time.Sleep(300 * time.Millisecond)
+			return nil
+		})
+		Expect(p.Apply()).To(MatchError(ContainSubstring("deadline exceeded")))
+	})
+
+	It("should fail if no plugin listens on the socket", func() {
+		_, err := Connect(filepath.Join(dir, "vendor"+SocketSuffix), 100*time.Millisecond)
+		Expect(err).To(MatchError(ContainSubstring("GetInfo failed")))
+	})
+
+	It("should fail if the plugin uses another protocol version", func() {
+		socketPath := filepath.Join(dir, "old"+SocketSuffix)
+		listener, err := net.Listen("unix", socketPath)
+		Expect(err).ToNot(HaveOccurred())
+		server := grpc.NewServer()
+		server.RegisterService(&serviceDesc, &oldVersionServer{})
+		go func() { _ = server.Serve(listener) }()
+		defer server.Stop()
+
+		_, err = Connect(socketPath, time.Second)
+		Expect(err).To(MatchError(ContainSubstring(`uses protocol version "v0", expected "v1"`)))
+	})
+
+	It("should refuse the config daemon using another protocol version", func() {
+		_, err := NewServer(vendorMock).GetInfo(context.Background(), &GetInfoRequest{ProtocolVersion: "v2"})
+		Expect(err).To(MatchError(ContainSubstring(`unsupported protocol version "v2"`)))
+	})
+})
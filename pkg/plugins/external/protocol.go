@@ -0,0 +1,153 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+)
+
+// The external vendor plugins are gRPC servers listening on a unix socket, the messages are encoded in JSON
+// (content-subtype "json") so the plugins can be written in any language without generating code from a proto file.
+const (
+	// ProtocolVersion is the version of the protocol between the config daemon and the external vendor plugins,
+	// the plugin must reply to GetInfo with the same version
+	ProtocolVersion = "v1"
+	// ServiceName is the name of the gRPC service implemented by the external vendor plugins
+	ServiceName = "sriovnetwork.openshift.io.vendorplugin.v1.VendorPlugin"
+	// SocketSuffix is the suffix of the sockets of the external vendor plugins in the plugins directory
+	SocketSuffix = ".sock"
+
+	codecName = "json"
+)
+
+// GetInfoRequest is sent by the config daemon when it connects to the plugin
+type GetInfoRequest struct {
+	// ProtocolVersion is the version of the protocol used by the config daemon
+	ProtocolVersion string `json:"protocolVersion"`
+}
+
+// GetInfoResponse describes the plugin
+type GetInfoResponse struct {
+	// Name is the name of the plugin, it must be unique on the node
+	Name string `json:"name"`
+	// ProtocolVersion is the version of the protocol implemented by the plugin
+	ProtocolVersion string `json:"protocolVersion"`
+}
+
+// NodeStateRequest carries the SriovNetworkNodeState to evaluate
+type NodeStateRequest struct {
+	NodeState *sriovnetworkv1.SriovNetworkNodeState `json:"nodeState"`
+}
+
+// OnNodeStateChangeResponse is the result of OnNodeStateChange
+type OnNodeStateChangeResponse struct {
+	NeedDrain  bool `json:"needDrain"`
+	NeedReboot bool `json:"needReboot"`
+}
+
+// ApplyRequest is the request of Apply
+type ApplyRequest struct{}
+
+// ApplyResponse is the result of Apply
+type ApplyResponse struct{}
+
+// CheckStatusChangesResponse is the result of CheckStatusChanges
+type CheckStatusChangesResponse struct {
+	Changed bool `json:"changed"`
+}
+
+// PlanNodeStateChangeResponse is the result of PlanNodeStateChange, the changes the plugin
+// would do on the node to apply the SriovNetworkNodeState
+type PlanNodeStateChangeResponse struct {
+	NeedDrain          bool     `json:"needDrain"`
+	NeedReboot         bool     `json:"needReboot"`
+	KernelArgsToAdd    []string `json:"kernelArgsToAdd,omitempty"`
+	KernelArgsToRemove []string `json:"kernelArgsToRemove,omitempty"`
+}
+
+// VendorPluginServer is the server API of the external vendor plugins.
+// PlanNodeStateChange is optional, the plugins not supporting it return the Unimplemented code.
+type VendorPluginServer interface {
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	OnNodeStateChange(context.Context, *NodeStateRequest) (*OnNodeStateChangeResponse, error)
+	Apply(context.Context, *ApplyRequest) (*ApplyResponse, error)
+	CheckStatusChanges(context.Context, *NodeStateRequest) (*CheckStatusChangesResponse, error)
+	PlanNodeStateChange(context.Context, *NodeStateRequest) (*PlanNodeStateChangeResponse, error)
+}
+
+// jsonCodec encodes the gRPC messages in JSON
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return codecName
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// serviceDesc describes the VendorPlugin gRPC service
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*VendorPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryMethod("GetInfo", VendorPluginServer.GetInfo),
+		unaryMethod("OnNodeStateChange", VendorPluginServer.OnNodeStateChange),
+		unaryMethod("Apply", VendorPluginServer.Apply),
+		unaryMethod("CheckStatusChanges", VendorPluginServer.CheckStatusChanges),
+		unaryMethod("PlanNodeStateChange", VendorPluginServer.PlanNodeStateChange),
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+// unaryMethod returns the description of a unary method of the VendorPlugin service
+func unaryMethod[Req, Resp any](name string, call func(VendorPluginServer, context.Context, *Req) (*Resp, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			in := new(Req)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(srv.(VendorPluginServer), ctx, in)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethodName(name)}
+			return interceptor(ctx, in, info, func(ctx context.Context, req any) (any, error) {
+				return call(srv.(VendorPluginServer), ctx, req.(*Req))
+			})
+		},
+	}
+}
+
+func fullMethodName(name string) string {
+	return "/" + ServiceName + "/" + name
+}
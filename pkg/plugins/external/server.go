@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
+)
+
+// pluginServer exposes a plugin.VendorPlugin as a VendorPluginServer
+type pluginServer struct {
+	// the calls are serialized, the config daemon never calls a plugin concurrently
+	lock   sync.Mutex
+	plugin plugin.VendorPlugin
+}
+
+// NewServer returns a VendorPluginServer calling the given plugin
+func NewServer(p plugin.VendorPlugin) VendorPluginServer {
+	return &pluginServer{plugin: p}
+}
+
+// GetInfo returns the name of the plugin and fails if the config daemon uses another version of the protocol
+func (s *pluginServer) GetInfo(_ context.Context, req *GetInfoRequest) (*GetInfoResponse, error) {
+	if req.ProtocolVersion != ProtocolVersion {
+		return nil, status.Errorf(codes.FailedPrecondition, "unsupported protocol version %q, plugin %s supports %q",
+			req.ProtocolVersion, s.plugin.Name(), ProtocolVersion)
+	}
+	return &GetInfoResponse{Name: s.plugin.Name(), ProtocolVersion: ProtocolVersion}, nil
+}
+
+// OnNodeStateChange calls OnNodeStateChange of the plugin
+func (s *pluginServer) OnNodeStateChange(_ context.Context, req *NodeStateRequest) (*OnNodeStateChangeResponse, error) {
+	if req.NodeState == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing node state")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	needDrain, needReboot, err := s.plugin.OnNodeStateChange(req.NodeState)
+	if err != nil {
+		return nil, err
+	}
+	return &OnNodeStateChangeResponse{NeedDrain: needDrain, NeedReboot: needReboot}, nil
+}
+
+// Apply calls Apply of the plugin
+func (s *pluginServer) Apply(_ context.Context, _ *ApplyRequest) (*ApplyResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.plugin.Apply(); err != nil {
+		return nil, err
+	}
+	return &ApplyResponse{}, nil
+}
+
+// CheckStatusChanges calls CheckStatusChanges of the plugin
+func (s *pluginServer) CheckStatusChanges(_ context.Context, req *NodeStateRequest) (*CheckStatusChangesResponse, error) {
+	if req.NodeState == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing node state")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	changed, err := s.plugin.CheckStatusChanges(req.NodeState)
+	if err != nil {
+		return nil, err
+	}
+	return &CheckStatusChangesResponse{Changed: changed}, nil
+}
+
+// PlanNodeStateChange calls PlanNodeStateChange of the plugin, Unimplemented is returned
+// if the plugin doesn't implement plugin.PlanningPlugin
+func (s *pluginServer) PlanNodeStateChange(_ context.Context, req *NodeStateRequest) (*PlanNodeStateChangeResponse, error) {
+	if req.NodeState == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing node state")
+	}
+	planningPlugin, ok := s.plugin.(plugin.PlanningPlugin)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "plugin %s doesn't support planning", s.plugin.Name())
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	plan, err := planningPlugin.PlanNodeStateChange(req.NodeState)
+	if err != nil {
+		return nil, err
+	}
+	return &PlanNodeStateChangeResponse{
+		NeedDrain:          plan.NeedDrain,
+		NeedReboot:         plan.NeedReboot,
+		KernelArgsToAdd:    plan.KernelArgsToAdd,
+		KernelArgsToRemove: plan.KernelArgsToRemove,
+	}, nil
+}
+
+// Serve serves the plugin on the unix socket until the context is done,
+// a stale socket left by a previous instance of the plugin is removed
+func Serve(ctx context.Context, socketPath string, p plugin.VendorPlugin) error {
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove stale socket %s: %w", socketPath, err)
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to listen on socket %s: %w", socketPath, err)
+	}
+
+	server := grpc.NewServer()
+	server.RegisterService(&serviceDesc, NewServer(p))
+
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+
+	log.Log.Info("Serve(): serving external vendor plugin", "plugin-name", p.Name(), "socket", socketPath)
+	if err := server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return err
+	}
+	return nil
+}
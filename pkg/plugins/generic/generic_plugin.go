@@ -19,6 +19,8 @@ package generic
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"sort"
 	"syscall"
 
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -167,12 +169,68 @@ func (p *GenericPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeSt
 		return needDrain, needReboot, err
 	}
 
+	_, devlinkNeedDrain, devlinkNeedReboot, err := p.needToUpdateDevlinkParams(new)
+	if err != nil {
+		return needDrain, needReboot, err
+	}
+	needDrain = needDrain || devlinkNeedDrain
+	needReboot = needReboot || devlinkNeedReboot
+
 	if needReboot {
 		needDrain = true
 	}
 	return
 }
 
+// PlanNodeStateChange returns if the node would need drain and/or reboot to apply the SriovNetworkNodeState
+// and the kernel arguments that would change, without editing the kernel arguments or the plugin state
+func (p *GenericPlugin) PlanNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState) (*plugin.NodeStatePlan, error) {
+	log.Log.Info("generic plugin PlanNodeStateChange()")
+	result := &plugin.NodeStatePlan{
+		NeedDrain: p.needDrainNode(new.Spec, new.Status),
+	}
+
+	// evaluate the kernel arguments on a copy of the desired ones to keep the plugin state unchanged
+	desiredKernelArgs := maps.Clone(p.DesiredKernelArgs)
+	p.addVfioKernelArgs(new, desiredKernelArgs)
+	if err := setRdmaKernelArgs(new, desiredKernelArgs); err != nil {
+		return nil, err
+	}
+
+	kargs, err := p.helpers.GetCurrentKernelArgs()
+	if err != nil {
+		return nil, err
+	}
+	for karg, kargState := range desiredKernelArgs {
+		isSet := p.helpers.IsKernelArgsSet(kargs, karg)
+		if kargState && !isSet {
+			result.KernelArgsToAdd = append(result.KernelArgsToAdd, karg)
+		}
+		if !kargState && isSet {
+			result.KernelArgsToRemove = append(result.KernelArgsToRemove, karg)
+		}
+	}
+	sort.Strings(result.KernelArgsToAdd)
+	sort.Strings(result.KernelArgsToRemove)
+
+	if len(result.KernelArgsToAdd) > 0 || len(result.KernelArgsToRemove) > 0 {
+		result.NeedReboot = true
+		result.NeedDrain = true
+	}
+
+	_, devlinkNeedDrain, devlinkNeedReboot, err := p.needToUpdateDevlinkParams(new)
+	if err != nil {
+		return nil, err
+	}
+	if devlinkNeedDrain || devlinkNeedReboot {
+		result.NeedDrain = true
+	}
+	if devlinkNeedReboot {
+		result.NeedReboot = true
+	}
+	return result, nil
+}
+
 // CheckStatusChanges verify whether SriovNetworkNodeState CR status present changes on configured VFs.
 func (p *GenericPlugin) CheckStatusChanges(current *sriovnetworkv1.SriovNetworkNodeState) (bool, error) {
 	log.Log.Info("generic-plugin CheckStatusChanges()")
@@ -187,6 +245,10 @@ func (p *GenericPlugin) CheckStatusChanges(current *sriovnetworkv1.SriovNetworkN
 					log.Log.Info("CheckStatusChanges(): status changed for interface", "address", iface.PciAddress)
 					return true, nil
 				}
+				if sriovnetworkv1.NeedToUpdateVfAttributes(&iface, &ifaceStatus) {
+					log.Log.Info("CheckStatusChanges(): VF attributes drifted, they are applied without drain", "address", iface.PciAddress)
+					return true, nil
+				}
 				break
 			}
 		}
@@ -197,11 +259,35 @@ func (p *GenericPlugin) CheckStatusChanges(current *sriovnetworkv1.SriovNetworkN
 
 	if p.shouldConfigureBridges() {
 		if sriovnetworkv1.NeedToUpdateBridges(&current.Spec.Bridges, &current.Status.Bridges) {
-			log.Log.Info("CheckStatusChanges(): bridge configuration needs to be updated")
+			if sriovnetworkv1.NeedToUpdateBridgesIgnoringFlowsAndQoS(&current.Spec.Bridges, &current.Status.Bridges) {
+				log.Log.Info("CheckStatusChanges(): bridge configuration needs to be updated")
+			} else {
+				log.Log.Info("CheckStatusChanges(): flows or QoS of the bridges drifted from the configuration")
+			}
 			return true, nil
 		}
 	}
 
+	devlinkNeedUpdate, _, _, err := p.needToUpdateDevlinkParams(current)
+	if err != nil {
+		log.Log.Error(err, "generic-plugin CheckStatusChanges(): failed to verify devlink params")
+		return false, err
+	}
+	if devlinkNeedUpdate {
+		log.Log.Info("CheckStatusChanges(): devlink params need to be updated")
+		return true, nil
+	}
+
+	ethtoolNeedUpdate, err := p.needToUpdateEthtoolSettings(current)
+	if err != nil {
+		log.Log.Error(err, "generic-plugin CheckStatusChanges(): failed to verify ethtool settings")
+		return false, err
+	}
+	if ethtoolNeedUpdate {
+		log.Log.Info("CheckStatusChanges(): ethtool settings need to be updated")
+		return true, nil
+	}
+
 	shouldUpdate, err := p.shouldUpdateKernelArgs()
 	if err != nil {
 		log.Log.Error(err, "generic-plugin CheckStatusChanges(): failed to verify missing kernel arguments")
@@ -301,14 +387,19 @@ func editKernelArg(helper helper.HostHelpersInterface, mode, karg string) error
 
 // enableDesiredKernelArgs Should be called to mark a kernel arg as enabled.
 func (p *GenericPlugin) enableDesiredKernelArgs(karg string) {
+	p.DesiredKernelArgs.enable(karg)
+}
+
+// enable marks a kernel arg as enabled.
+func (k KargStateMapType) enable(karg string) {
 	log.Log.Info("generic plugin enableDesiredKernelArgs(): enable kernel arg", "karg", karg)
-	p.DesiredKernelArgs[karg] = true
+	k[karg] = true
 }
 
-// disableDesiredKernelArgs Should be called to mark a kernel arg as disabled.
-func (p *GenericPlugin) disableDesiredKernelArgs(karg string) {
+// disable marks a kernel arg as disabled.
+func (k KargStateMapType) disable(karg string) {
 	log.Log.Info("generic plugin disableDesiredKernelArgs(): disable kernel arg", "karg", karg)
-	p.DesiredKernelArgs[karg] = false
+	k[karg] = false
 }
 
 // shouldUpdateKernelArgs returns true if the DesiredKernelArgs state is not equal to the running kernel args in the system
@@ -372,7 +463,8 @@ func (p *GenericPlugin) needDrainNode(desired sriovnetworkv1.SriovNetworkNodeSta
 	}
 
 	if p.shouldConfigureBridges() {
-		if sriovnetworkv1.NeedToUpdateBridges(&desired.Bridges, &current.Bridges) {
+		// flows and QoS of the bridges are updated in place and don't require drain
+		if sriovnetworkv1.NeedToUpdateBridgesIgnoringFlowsAndQoS(&desired.Bridges, &current.Bridges) {
 			log.Log.V(2).Info("generic plugin needDrainNode(): need drain since bridge configuration needs to be updated")
 			return true
 		}
@@ -431,20 +523,58 @@ func (p *GenericPlugin) needToUpdateVFs(desired sriovnetworkv1.SriovNetworkNodeS
 	return false
 }
 
+// needToUpdateDevlinkParams returns if devlink parameters of the PFs must be updated, and if the node must be drained
+// and/or rebooted to apply them: the driver of the device is reloaded for the driverinit parameters,
+// the permanent parameters are applied by a reboot
+func (p *GenericPlugin) needToUpdateDevlinkParams(state *sriovnetworkv1.SriovNetworkNodeState) (needUpdate, needDrain, needReboot bool, err error) {
+	for _, iface := range state.Spec.Interfaces {
+		if iface.ExternallyManaged || len(iface.GetDevlinkParams()) == 0 {
+			continue
+		}
+		if state.GetInterfaceStateByPciAddress(iface.PciAddress) == nil {
+			continue
+		}
+		params, err := p.helpers.GetDevlinkParamsToUpdate(&iface)
+		if err != nil {
+			return false, false, false, err
+		}
+		for _, param := range params {
+			needUpdate = true
+			switch param.GetCmode() {
+			case consts.DevlinkParamCmodeDriverinit:
+				log.Log.V(2).Info("generic plugin needToUpdateDevlinkParams(): need drain to reload the device",
+					"address", iface.PciAddress, "param", param.Name)
+				needDrain = true
+			case consts.DevlinkParamCmodePermanent:
+				log.Log.V(2).Info("generic plugin needToUpdateDevlinkParams(): need reboot to apply the permanent param",
+					"address", iface.PciAddress, "param", param.Name)
+				needDrain = true
+				needReboot = true
+			}
+		}
+	}
+	return needUpdate, needDrain, needReboot, nil
+}
+
 func (p *GenericPlugin) shouldConfigureBridges() bool {
 	return vars.ManageSoftwareBridges && !p.skipBridgeConfiguration
 }
 
 func (p *GenericPlugin) addVfioDesiredKernelArg(state *sriovnetworkv1.SriovNetworkNodeState) {
+	p.addVfioKernelArgs(state, p.DesiredKernelArgs)
+}
+
+// addVfioKernelArgs marks the kernel args required by the vfio driver in kargs
+func (p *GenericPlugin) addVfioKernelArgs(state *sriovnetworkv1.SriovNetworkNodeState, kargs KargStateMapType) {
 	driverState := p.DriverStateMap[Vfio]
 
 	kernelArgFnByCPUVendor := map[hostTypes.CPUVendor]func(){
 		hostTypes.CPUVendorIntel: func() {
-			p.enableDesiredKernelArgs(consts.KernelArgIntelIommu)
-			p.enableDesiredKernelArgs(consts.KernelArgIommuPt)
+			kargs.enable(consts.KernelArgIntelIommu)
+			kargs.enable(consts.KernelArgIommuPt)
 		},
 		hostTypes.CPUVendorAMD: func() {
-			p.enableDesiredKernelArgs(consts.KernelArgIommuPt)
+			kargs.enable(consts.KernelArgIommuPt)
 		},
 	}
 
@@ -463,22 +593,29 @@ func (p *GenericPlugin) addVfioDesiredKernelArg(state *sriovnetworkv1.SriovNetwo
 }
 
 func (p *GenericPlugin) configRdmaKernelArg(state *sriovnetworkv1.SriovNetworkNodeState) error {
+	if err := setRdmaKernelArgs(state, p.DesiredKernelArgs); err != nil {
+		return err
+	}
+	return p.helpers.SetRDMASubsystem(state.Spec.System.RdmaMode)
+}
+
+// setRdmaKernelArgs marks the kernel args for the rdma subsystem mode requested in the state in kargs
+func setRdmaKernelArgs(state *sriovnetworkv1.SriovNetworkNodeState, kargs KargStateMapType) error {
 	if state.Spec.System.RdmaMode == "" {
-		p.disableDesiredKernelArgs(consts.KernelArgRdmaExclusive)
-		p.disableDesiredKernelArgs(consts.KernelArgRdmaShared)
+		kargs.disable(consts.KernelArgRdmaExclusive)
+		kargs.disable(consts.KernelArgRdmaShared)
 	} else if state.Spec.System.RdmaMode == "shared" {
-		p.enableDesiredKernelArgs(consts.KernelArgRdmaShared)
-		p.disableDesiredKernelArgs(consts.KernelArgRdmaExclusive)
+		kargs.enable(consts.KernelArgRdmaShared)
+		kargs.disable(consts.KernelArgRdmaExclusive)
 	} else if state.Spec.System.RdmaMode == "exclusive" {
-		p.enableDesiredKernelArgs(consts.KernelArgRdmaExclusive)
-		p.disableDesiredKernelArgs(consts.KernelArgRdmaShared)
+		kargs.enable(consts.KernelArgRdmaExclusive)
+		kargs.disable(consts.KernelArgRdmaShared)
 	} else {
 		err := fmt.Errorf("unexpected rdma mode: %s", state.Spec.System.RdmaMode)
 		log.Log.Error(err, "generic-plugin configRdmaKernelArg(): failed to configure kernel arguments for rdma")
 		return err
 	}
-
-	return p.helpers.SetRDMASubsystem(state.Spec.System.RdmaMode)
+	return nil
 }
 
 func (p *GenericPlugin) needRebootNode(state *sriovnetworkv1.SriovNetworkNodeState) (bool, error) {
@@ -516,3 +653,22 @@ func (p *GenericPlugin) loadDriverForTests(state *sriovnetworkv1.SriovNetworkNod
 }
 
 //////////////////////////////////////////////////////////////////
+
+// needToUpdateEthtoolSettings returns if the ethtool settings of the PFs or of their VFs were changed on the host,
+// the settings are applied without drain
+func (p *GenericPlugin) needToUpdateEthtoolSettings(state *sriovnetworkv1.SriovNetworkNodeState) (bool, error) {
+	for _, iface := range state.Spec.Interfaces {
+		if !iface.HasEthtoolSettings() || state.GetInterfaceStateByPciAddress(iface.PciAddress) == nil {
+			continue
+		}
+		needUpdate, err := p.helpers.NeedToUpdateEthtoolSettings(&iface)
+		if err != nil {
+			return false, err
+		}
+		if needUpdate {
+			log.Log.V(2).Info("generic plugin needToUpdateEthtoolSettings(): ethtool settings need update", "address", iface.PciAddress)
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
@@ -116,6 +117,179 @@ var _ = Describe("Generic plugin", func() {
 			Expect(needDrain).To(BeFalse())
 		})
 
+		Context("Devlink params", func() {
+			newNodeState := func(params ...sriovnetworkv1.DevlinkParam) *sriovnetworkv1.SriovNetworkNodeState {
+				return &sriovnetworkv1.SriovNetworkNodeState{
+					Spec: sriovnetworkv1.SriovNetworkNodeStateSpec{
+						Interfaces: sriovnetworkv1.Interfaces{{
+							PciAddress:    "0000:00:00.0",
+							NumVfs:        1,
+							DevlinkParams: params,
+							VfGroups: []sriovnetworkv1.VfGroup{{
+								DeviceType:   "netdevice",
+								PolicyName:   "policy-1",
+								ResourceName: "resource-1",
+								VfRange:      "0-0",
+							}}}},
+					},
+					Status: sriovnetworkv1.SriovNetworkNodeStateStatus{
+						Interfaces: sriovnetworkv1.InterfaceExts{{
+							PciAddress:     "0000:00:00.0",
+							NumVfs:         1,
+							TotalVfs:       1,
+							Name:           "sriovif1",
+							Driver:         "mlx5_core",
+							EswitchMode:    "legacy",
+							LinkAdminState: "up",
+							VFs: []sriovnetworkv1.VirtualFunction{{
+								PciAddress: "0000:00:00.1",
+								VfID:       0,
+								Name:       "sriovif1v0",
+								Driver:     "mlx5_core",
+							}},
+						}},
+					},
+				}
+			}
+
+			It("should not drain for runtime params", func() {
+				state := newNodeState(sriovnetworkv1.DevlinkParam{Name: "max_macs", Value: "32", Cmode: "runtime"})
+				hostHelper.EXPECT().GetDevlinkParamsToUpdate(&state.Spec.Interfaces[0]).Return(state.Spec.Interfaces[0].DevlinkParams, nil)
+				needDrain, needReboot, err := genericPlugin.OnNodeStateChange(state)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(needDrain).To(BeFalse())
+				Expect(needReboot).To(BeFalse())
+			})
+
+			It("should drain for driverinit params", func() {
+				state := newNodeState(sriovnetworkv1.DevlinkParam{Name: "max_macs", Value: "32", Cmode: "driverinit"})
+				hostHelper.EXPECT().GetDevlinkParamsToUpdate(&state.Spec.Interfaces[0]).Return(state.Spec.Interfaces[0].DevlinkParams, nil)
+				needDrain, needReboot, err := genericPlugin.OnNodeStateChange(state)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(needDrain).To(BeTrue())
+				Expect(needReboot).To(BeFalse())
+			})
+
+			It("should drain for the MSI-X vectors of the Intel settings", func() {
+				state := newNodeState()
+				state.Spec.Interfaces[0].Intel = &sriovnetworkv1.IntelConfig{MsixVecPerPfMax: ptr.To(128)}
+				hostHelper.EXPECT().GetDevlinkParamsToUpdate(&state.Spec.Interfaces[0]).Return(state.Spec.Interfaces[0].GetDevlinkParams(), nil)
+				needDrain, needReboot, err := genericPlugin.OnNodeStateChange(state)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(needDrain).To(BeTrue())
+				Expect(needReboot).To(BeFalse())
+			})
+
+			It("should drain and reboot for permanent params", func() {
+				state := newNodeState(sriovnetworkv1.DevlinkParam{Name: "enable_sriov", Value: "true", Cmode: "permanent"})
+				hostHelper.EXPECT().GetDevlinkParamsToUpdate(&state.Spec.Interfaces[0]).Return(state.Spec.Interfaces[0].DevlinkParams, nil)
+				plan, err := genericPlugin.(plugin.PlanningPlugin).PlanNodeStateChange(state)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan.NeedDrain).To(BeTrue())
+				Expect(plan.NeedReboot).To(BeTrue())
+			})
+
+			It("should detect a drift of the params on the host", func() {
+				state := newNodeState(sriovnetworkv1.DevlinkParam{Name: "max_macs", Value: "32"})
+				hostHelper.EXPECT().GetDevlinkParamsToUpdate(&state.Spec.Interfaces[0]).Return(nil, nil)
+				changed, err := genericPlugin.CheckStatusChanges(state)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(changed).To(BeFalse())
+
+				hostHelper.EXPECT().GetDevlinkParamsToUpdate(&state.Spec.Interfaces[0]).Return(state.Spec.Interfaces[0].DevlinkParams, nil)
+				changed, err = genericPlugin.CheckStatusChanges(state)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(changed).To(BeTrue())
+			})
+		})
+
+		It("should detect a drift of the ethtool settings without drain", func() {
+			state := &sriovnetworkv1.SriovNetworkNodeState{
+				Spec: sriovnetworkv1.SriovNetworkNodeStateSpec{
+					Interfaces: sriovnetworkv1.Interfaces{{
+						PciAddress: "0000:00:00.0",
+						NumVfs:     1,
+						Ethtool:    &sriovnetworkv1.EthtoolSettings{Features: map[string]bool{"rx-gro-hw": false}},
+						VfGroups: []sriovnetworkv1.VfGroup{{
+							DeviceType:   "netdevice",
+							PolicyName:   "policy-1",
+							ResourceName: "resource-1",
+							VfRange:      "0-0",
+						}}}},
+				},
+				Status: sriovnetworkv1.SriovNetworkNodeStateStatus{
+					Interfaces: sriovnetworkv1.InterfaceExts{{
+						PciAddress:     "0000:00:00.0",
+						NumVfs:         1,
+						TotalVfs:       1,
+						Name:           "sriovif1",
+						Driver:         "mlx5_core",
+						EswitchMode:    "legacy",
+						LinkAdminState: "up",
+						VFs: []sriovnetworkv1.VirtualFunction{{
+							PciAddress: "0000:00:00.1",
+							VfID:       0,
+							Name:       "sriovif1v0",
+							Driver:     "mlx5_core",
+						}},
+					}},
+				},
+			}
+			needDrain, needReboot, err := genericPlugin.OnNodeStateChange(state)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(needDrain).To(BeFalse())
+			Expect(needReboot).To(BeFalse())
+
+			hostHelper.EXPECT().NeedToUpdateEthtoolSettings(&state.Spec.Interfaces[0]).Return(true, nil)
+			changed, err := genericPlugin.CheckStatusChanges(state)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+		})
+
+		It("should detect a drift of the VF attributes without drain", func() {
+			state := &sriovnetworkv1.SriovNetworkNodeState{
+				Spec: sriovnetworkv1.SriovNetworkNodeStateSpec{
+					Interfaces: sriovnetworkv1.Interfaces{{
+						PciAddress: "0000:00:00.0",
+						NumVfs:     1,
+						VfGroups: []sriovnetworkv1.VfGroup{{
+							DeviceType:   "netdevice",
+							PolicyName:   "policy-1",
+							ResourceName: "resource-1",
+							VfRange:      "0-0",
+							VfAttributes: &sriovnetworkv1.VfAttributes{Vlan: ptr.To(100), Trust: ptr.To(true)},
+						}}}},
+				},
+				Status: sriovnetworkv1.SriovNetworkNodeStateStatus{
+					Interfaces: sriovnetworkv1.InterfaceExts{{
+						PciAddress:     "0000:00:00.0",
+						NumVfs:         1,
+						TotalVfs:       1,
+						Name:           "sriovif1",
+						Driver:         "mlx5_core",
+						EswitchMode:    "legacy",
+						LinkAdminState: "up",
+						VFs: []sriovnetworkv1.VirtualFunction{{
+							PciAddress: "0000:00:00.1",
+							VfID:       0,
+							Name:       "sriovif1v0",
+							Driver:     "mlx5_core",
+							Vlan:       200,
+							Trust:      ptr.To(false),
+						}},
+					}},
+				},
+			}
+			needDrain, needReboot, err := genericPlugin.OnNodeStateChange(state)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(needDrain).To(BeFalse())
+			Expect(needReboot).To(BeFalse())
+
+			changed, err := genericPlugin.CheckStatusChanges(state)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+		})
+
 		It("should drain because MTU value has changed on PF", func() {
 			networkNodeState := &sriovnetworkv1.SriovNetworkNodeState{
 				Spec: sriovnetworkv1.SriovNetworkNodeStateSpec{
@@ -944,6 +1118,20 @@ var _ = Describe("Generic plugin", func() {
 				Expect(changed).To(BeTrue())
 			})
 
+			It("should plan the kernel args without changing the desired kernel args", func() {
+				hostHelper.EXPECT().GetCPUVendor().Return(hostTypes.CPUVendorIntel, nil)
+
+				plan, err := genericPlugin.(*GenericPlugin).PlanNodeStateChange(vfioNetworkNodeState)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plan.NeedReboot).To(BeTrue())
+				Expect(plan.NeedDrain).To(BeTrue())
+				Expect(plan.KernelArgsToAdd).To(Equal([]string{consts.KernelArgIntelIommu, consts.KernelArgIommuPt}))
+				Expect(plan.KernelArgsToRemove).To(BeEmpty())
+
+				Expect(genericPlugin.(*GenericPlugin).DesiredKernelArgs[consts.KernelArgIntelIommu]).To(BeFalse())
+				Expect(genericPlugin.(*GenericPlugin).DesiredKernelArgs[consts.KernelArgIommuPt]).To(BeFalse())
+			})
+
 			It("should set the correct kernel args on AMD CPUs", func() {
 				hostHelper.EXPECT().GetCPUVendor().Return(hostTypes.CPUVendorAMD, nil)
 				genericPlugin.(*GenericPlugin).addVfioDesiredKernelArg(vfioNetworkNodeState)
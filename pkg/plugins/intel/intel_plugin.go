@@ -17,24 +17,59 @@
 package intel
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/helper"
 	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
+	intel "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vendors/intel"
 )
 
 var PluginName = "intel"
 
+const (
+	// ethtool private flags
+	privFlagFwLldpAgent          = "fw-lldp-agent"
+	privFlagDisableFwLldp        = "disable-fw-lldp"
+	privFlagVfTruePromiscSupport = "vf-true-promisc-support"
+)
+
 type IntelPlugin struct {
-	PluginName  string
-	DesireState *sriovnetworkv1.SriovNetworkNodeState
-	LastState   *sriovnetworkv1.SriovNetworkNodeState
+	PluginName string
+	helpers    helper.HostHelpersInterface
+	// changes computed by the last OnNodeStateChange and applied by Apply
+	changes []nicChange
+}
+
+// devlinkParam is a devlink parameter of the device to set
+type devlinkParam struct {
+	name  string
+	value string
+}
+
+// nicChange contains the settings to change on an Intel PF
+type nicChange struct {
+	pciAddress    string
+	name          string
+	privFlags     map[string]bool
+	devlinkParams []devlinkParam
+	ddpPackage    string
+	// the node must be rebooted to apply the devlink parameters or the DDP package
+	needReboot bool
+}
+
+func (c *nicChange) isEmpty() bool {
+	return len(c.privFlags) == 0 && len(c.devlinkParams) == 0 && c.ddpPackage == ""
 }
 
 func NewIntelPlugin(helpers helper.HostHelpersInterface) (plugin.VendorPlugin, error) {
 	return &IntelPlugin{
 		PluginName: PluginName,
+		helpers:    helpers,
 	}, nil
 }
 
@@ -44,18 +79,239 @@ func (p *IntelPlugin) Name() string {
 }
 
 // OnNodeStateChange Invoked when SriovNetworkNodeState CR is created or updated, return if need dain and/or reboot node
-func (p *IntelPlugin) OnNodeStateChange(*sriovnetworkv1.SriovNetworkNodeState) (bool, bool, error) {
+func (p *IntelPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState) (bool, bool, error) {
 	log.Log.Info("intel-plugin OnNodeStateChange()")
-	return false, false, nil
+	p.changes = nil
+	changes, err := p.getChanges(new)
+	if err != nil {
+		return false, false, err
+	}
+	p.changes = changes
+	needDrain, needReboot := needDrainAndReboot(changes)
+	log.Log.V(2).Info("intel plugin", "need-drain", needDrain, "need-reboot", needReboot)
+	return needDrain, needReboot, nil
+}
+
+// PlanNodeStateChange returns if the node would need drain and/or reboot to apply the SriovNetworkNodeState.
+// The changes computed for the next Apply are preserved.
+func (p *IntelPlugin) PlanNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState) (*plugin.NodeStatePlan, error) {
+	log.Log.Info("intel-plugin PlanNodeStateChange()")
+	changes, err := p.getChanges(new)
+	if err != nil {
+		return nil, err
+	}
+	needDrain, needReboot := needDrainAndReboot(changes)
+	return &plugin.NodeStatePlan{NeedDrain: needDrain, NeedReboot: needReboot}, nil
 }
 
-// OnNodeStatusChange verify whether SriovNetworkNodeState CR status present changes on configured VFs.
-func (p *IntelPlugin) CheckStatusChanges(*sriovnetworkv1.SriovNetworkNodeState) (bool, error) {
+// CheckStatusChanges returns true if the Intel settings of the host don't match the SriovNetworkNodeState anymore
+func (p *IntelPlugin) CheckStatusChanges(new *sriovnetworkv1.SriovNetworkNodeState) (bool, error) {
+	changes, err := p.getChanges(new)
+	if err != nil {
+		return false, err
+	}
+	if len(changes) > 0 {
+		log.Log.Info("intel-plugin CheckStatusChanges(): Intel settings changed on the host", "device", changes[0].pciAddress)
+		return true, nil
+	}
 	return false, nil
 }
 
 // Apply config change
 func (p *IntelPlugin) Apply() error {
 	log.Log.Info("intel plugin Apply()")
+	for _, c := range p.changes {
+		if len(c.privFlags) > 0 {
+			if err := p.helpers.SetNetDevPrivFlags(c.name, c.privFlags); err != nil {
+				return fmt.Errorf("failed to set the private flags of interface %s: %v", c.name, err)
+			}
+		}
+		for _, param := range c.devlinkParams {
+			if err := p.helpers.SetDevlinkDeviceParam(c.pciAddress, param.name, param.value); err != nil {
+				return fmt.Errorf("failed to set devlink parameter %s of device %s: %v", param.name, c.pciAddress, err)
+			}
+		}
+		if c.ddpPackage != "" {
+			if err := p.helpers.SetDDPPackage(c.pciAddress, c.ddpPackage); err != nil {
+				return fmt.Errorf("failed to select the DDP package of device %s: %v", c.pciAddress, err)
+			}
+		}
+	}
+	p.changes = nil
 	return nil
 }
+
+// getChanges returns the changes required on the Intel PFs to apply the Intel settings of the SriovNetworkNodeState
+func (p *IntelPlugin) getChanges(new *sriovnetworkv1.SriovNetworkNodeState) ([]nicChange, error) {
+	changes := []nicChange{}
+	// the DDP package is selected for the whole NIC, all the ports must request the same one
+	ddpPackages := map[string]string{}
+	for _, ifaceSpec := range new.Spec.Interfaces {
+		if ifaceSpec.Intel == nil {
+			continue
+		}
+		ifaceStatus := new.GetInterfaceStateByPciAddress(ifaceSpec.PciAddress)
+		if ifaceStatus == nil || ifaceStatus.Vendor != intel.IntelVendorID {
+			continue
+		}
+		if ifaceStatus.Driver != intel.DriverIce && ifaceStatus.Driver != intel.DriverI40e {
+			return nil, fmt.Errorf("intel settings are not supported by driver %s of interface %s", ifaceStatus.Driver, ifaceStatus.PciAddress)
+		}
+		c, err := p.getNicChange(ifaceSpec.Intel, ifaceStatus)
+		if err != nil {
+			return nil, err
+		}
+		if ifaceSpec.Intel.DDPPackage != "" {
+			serial, err := p.helpers.GetDevlinkDeviceSerialNumber(ifaceSpec.PciAddress)
+			if err != nil {
+				return nil, err
+			}
+			if ddp, ok := ddpPackages[serial]; ok && ddp != ifaceSpec.Intel.DDPPackage {
+				return nil, fmt.Errorf("conflicting DDP packages %s and %s requested for the ports of the NIC of interface %s",
+					ddp, ifaceSpec.Intel.DDPPackage, ifaceSpec.PciAddress)
+			}
+			ddpPackages[serial] = ifaceSpec.Intel.DDPPackage
+		}
+		if !c.isEmpty() {
+			changes = append(changes, *c)
+		}
+	}
+	return changes, nil
+}
+
+// getNicChange compares the Intel settings requested for the PF with the settings of the host
+func (p *IntelPlugin) getNicChange(config *sriovnetworkv1.IntelConfig, iface *sriovnetworkv1.InterfaceExt) (*nicChange, error) {
+	c := &nicChange{pciAddress: iface.PciAddress, name: iface.Name}
+	isIce := iface.Driver == intel.DriverIce
+
+	if err := p.getPrivFlagsChange(c, config, isIce); err != nil {
+		return nil, err
+	}
+
+	if !isIce {
+		if config.DDPPackage != "" || config.EnableRoce != nil || config.EnableIwarp != nil ||
+			config.TxSchedulingLayers != nil || config.MsixVecPerPfMax != nil {
+			return nil, fmt.Errorf("DDP package and devlink parameters are only supported by the %s driver, interface %s uses %s",
+				intel.DriverIce, iface.PciAddress, iface.Driver)
+		}
+		return c, nil
+	}
+
+	// disable first, RoCE and iWARP can't be enabled at the same time
+	var enable []devlinkParam
+	for _, param := range []struct {
+		name  string
+		value *bool
+	}{
+		{intel.DevlinkParamEnableRoce, config.EnableRoce},
+		{intel.DevlinkParamEnableIwarp, config.EnableIwarp},
+	} {
+		if param.value == nil {
+			continue
+		}
+		changed, err := p.devlinkParamChanged(iface.PciAddress, param.name, strconv.FormatBool(*param.value))
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			continue
+		}
+		if *param.value {
+			enable = append(enable, devlinkParam{name: param.name, value: "true"})
+		} else {
+			c.devlinkParams = append(c.devlinkParams, devlinkParam{name: param.name, value: "false"})
+		}
+	}
+	c.devlinkParams = append(c.devlinkParams, enable...)
+
+	if config.TxSchedulingLayers != nil {
+		value := strconv.Itoa(*config.TxSchedulingLayers)
+		changed, err := p.devlinkParamChanged(iface.PciAddress, intel.DevlinkParamTxSchedulingLayers, value)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			c.devlinkParams = append(c.devlinkParams, devlinkParam{name: intel.DevlinkParamTxSchedulingLayers, value: value})
+			c.needReboot = true
+		}
+	}
+
+	// the MSI-X vectors are a driverinit parameter, they are set with the devlink parameters of the PF by the generic plugin
+	// which removes the VFs, reloads the driver of the device and creates the VFs again
+
+	if config.DDPPackage != "" {
+		current, err := p.helpers.GetDDPPackage(iface.PciAddress)
+		if errors.Is(err, intel.ErrUnmanagedDDPPackage) {
+			log.Log.Info("intel-plugin: the DDP package of the device is not managed by the operator, skip it",
+				"device", iface.PciAddress, "desired", config.DDPPackage)
+			return c, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if current != config.DDPPackage {
+			log.Log.V(2).Info("intel-plugin: DDP package change required", "device", iface.PciAddress,
+				"current", current, "desired", config.DDPPackage)
+			c.ddpPackage = config.DDPPackage
+			c.needReboot = true
+		}
+	}
+	return c, nil
+}
+
+// getPrivFlagsChange adds to the change the ethtool private flags of the PF that don't match the settings
+func (p *IntelPlugin) getPrivFlagsChange(c *nicChange, config *sriovnetworkv1.IntelConfig, isIce bool) error {
+	desired := map[string]bool{}
+	if config.FwLldp != nil {
+		// the i40e driver exposes the opposite flag
+		if isIce {
+			desired[privFlagFwLldpAgent] = *config.FwLldp
+		} else {
+			desired[privFlagDisableFwLldp] = !*config.FwLldp
+		}
+	}
+	if config.VfTruePromiscSupport != nil {
+		desired[privFlagVfTruePromiscSupport] = *config.VfTruePromiscSupport
+	}
+	if len(desired) == 0 {
+		return nil
+	}
+
+	current, err := p.helpers.GetNetDevPrivFlags(c.name)
+	if err != nil {
+		return err
+	}
+	for flag, value := range desired {
+		currentValue, ok := current[flag]
+		if !ok {
+			return fmt.Errorf("private flag %s is not supported by interface %s", flag, c.name)
+		}
+		if currentValue != value {
+			if c.privFlags == nil {
+				c.privFlags = map[string]bool{}
+			}
+			c.privFlags[flag] = value
+		}
+	}
+	return nil
+}
+
+// devlinkParamChanged returns true if the devlink parameter of the device doesn't have the value
+func (p *IntelPlugin) devlinkParamChanged(pciAddress, name, value string) (bool, error) {
+	current, err := p.helpers.GetDevlinkDeviceParam(pciAddress, name)
+	if err != nil {
+		return false, fmt.Errorf("failed to read devlink parameter %s of device %s: %v", name, pciAddress, err)
+	}
+	return current != value, nil
+}
+
+// needDrainAndReboot returns if the node must be drained and rebooted to apply the changes,
+// the private flags and the runtime devlink parameters are applied without disruption
+func needDrainAndReboot(changes []nicChange) (needDrain, needReboot bool) {
+	for _, c := range changes {
+		if c.needReboot {
+			needReboot = true
+		}
+	}
+	return needReboot, needReboot
+}
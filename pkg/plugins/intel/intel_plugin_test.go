@@ -0,0 +1,198 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package intel
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	mock_helper "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/helper/mock"
+	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
+	intel "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vendors/intel"
+)
+
+var _ = Describe("Intel plugin", func() {
+	var (
+		p        plugin.VendorPlugin
+		h        *mock_helper.MockHostHelpersInterface
+		err      error
+		testCtrl *gomock.Controller
+
+		nodeState *sriovnetworkv1.SriovNetworkNodeState
+	)
+
+	newNodeState := func(driver string, config *sriovnetworkv1.IntelConfig) *sriovnetworkv1.SriovNetworkNodeState {
+		return &sriovnetworkv1.SriovNetworkNodeState{ObjectMeta: corev1.ObjectMeta{Name: "worker-0", Namespace: "test"},
+			Spec: sriovnetworkv1.SriovNetworkNodeStateSpec{
+				Interfaces: sriovnetworkv1.Interfaces{{
+					Name:       "ens1f0",
+					PciAddress: "0000:3b:00.0",
+					NumVfs:     4,
+					Intel:      config,
+				}},
+			},
+			Status: sriovnetworkv1.SriovNetworkNodeStateStatus{
+				Interfaces: sriovnetworkv1.InterfaceExts{{
+					Name:       "ens1f0",
+					PciAddress: "0000:3b:00.0",
+					Vendor:     "8086",
+					DeviceID:   "159b",
+					Driver:     driver,
+					TotalVfs:   64,
+				}},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		testCtrl = gomock.NewController(GinkgoT())
+		h = mock_helper.NewMockHostHelpersInterface(testCtrl)
+		p, err = NewIntelPlugin(h)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		testCtrl.Finish()
+	})
+
+	It("should ignore interfaces without intel settings", func() {
+		nodeState = newNodeState("ice", nil)
+		needDrain, needReboot, err := p.OnNodeStateChange(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(needDrain).To(BeFalse())
+		Expect(needReboot).To(BeFalse())
+		Expect(p.Apply()).To(Succeed())
+	})
+
+	It("should set the private flags without drain", func() {
+		nodeState = newNodeState("ice", &sriovnetworkv1.IntelConfig{FwLldp: ptr.To(false), VfTruePromiscSupport: ptr.To(true)})
+		h.EXPECT().GetNetDevPrivFlags("ens1f0").Return(map[string]bool{
+			"fw-lldp-agent": true, "vf-true-promisc-support": true, "link-down-on-close": false}, nil)
+		needDrain, needReboot, err := p.OnNodeStateChange(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(needDrain).To(BeFalse())
+		Expect(needReboot).To(BeFalse())
+
+		h.EXPECT().SetNetDevPrivFlags("ens1f0", map[string]bool{"fw-lldp-agent": false}).Return(nil)
+		Expect(p.Apply()).To(Succeed())
+	})
+
+	It("should use the disable-fw-lldp private flag with the i40e driver", func() {
+		nodeState = newNodeState("i40e", &sriovnetworkv1.IntelConfig{FwLldp: ptr.To(false)})
+		h.EXPECT().GetNetDevPrivFlags("ens1f0").Return(map[string]bool{"disable-fw-lldp": false}, nil)
+		_, _, err := p.OnNodeStateChange(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+
+		h.EXPECT().SetNetDevPrivFlags("ens1f0", map[string]bool{"disable-fw-lldp": true}).Return(nil)
+		Expect(p.Apply()).To(Succeed())
+	})
+
+	It("should fail if the private flag is not supported", func() {
+		nodeState = newNodeState("ice", &sriovnetworkv1.IntelConfig{VfTruePromiscSupport: ptr.To(true)})
+		h.EXPECT().GetNetDevPrivFlags("ens1f0").Return(map[string]bool{}, nil)
+		_, _, err := p.OnNodeStateChange(nodeState)
+		Expect(err).To(MatchError(ContainSubstring("private flag vf-true-promisc-support is not supported")))
+	})
+
+	It("should fail to set devlink parameters with the i40e driver", func() {
+		nodeState = newNodeState("i40e", &sriovnetworkv1.IntelConfig{EnableRoce: ptr.To(true)})
+		_, _, err := p.OnNodeStateChange(nodeState)
+		Expect(err).To(MatchError(ContainSubstring("only supported by the ice driver")))
+	})
+
+	It("should disable iWARP before enabling RoCE and leave the MSI-X vectors to the generic plugin", func() {
+		// the MSI-X vectors are set with the driverinit devlink parameters of the PF by the generic plugin,
+		// which removes the VFs before the reload of the device and creates them again
+		nodeState = newNodeState("ice", &sriovnetworkv1.IntelConfig{
+			EnableRoce: ptr.To(true), EnableIwarp: ptr.To(false), MsixVecPerPfMax: ptr.To(128)})
+		h.EXPECT().GetDevlinkDeviceParam("0000:3b:00.0", "enable_roce").Return("false", nil)
+		h.EXPECT().GetDevlinkDeviceParam("0000:3b:00.0", "enable_iwarp").Return("true", nil)
+		needDrain, needReboot, err := p.OnNodeStateChange(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(needDrain).To(BeFalse())
+		Expect(needReboot).To(BeFalse())
+
+		gomock.InOrder(
+			h.EXPECT().SetDevlinkDeviceParam("0000:3b:00.0", "enable_iwarp", "false").Return(nil),
+			h.EXPECT().SetDevlinkDeviceParam("0000:3b:00.0", "enable_roce", "true").Return(nil),
+		)
+		Expect(p.Apply()).To(Succeed())
+	})
+
+	It("should require a reboot to change the DDP package and the tx scheduling layers", func() {
+		nodeState = newNodeState("ice", &sriovnetworkv1.IntelConfig{
+			DDPPackage: "ice_comms-1.3.45.0.pkg", TxSchedulingLayers: ptr.To(5)})
+		h.EXPECT().GetDevlinkDeviceParam("0000:3b:00.0", "tx_scheduling_layers").Return("9", nil)
+		h.EXPECT().GetDDPPackage("0000:3b:00.0").Return("", nil)
+		h.EXPECT().GetDevlinkDeviceSerialNumber("0000:3b:00.0").Return("00-01-02-ff-ff-03-04-05", nil)
+		needDrain, needReboot, err := p.OnNodeStateChange(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(needDrain).To(BeTrue())
+		Expect(needReboot).To(BeTrue())
+
+		h.EXPECT().SetDevlinkDeviceParam("0000:3b:00.0", "tx_scheduling_layers", "5").Return(nil)
+		h.EXPECT().SetDDPPackage("0000:3b:00.0", "ice_comms-1.3.45.0.pkg").Return(nil)
+		Expect(p.Apply()).To(Succeed())
+	})
+
+	It("should not change a DDP package copied on the host", func() {
+		nodeState = newNodeState("ice", &sriovnetworkv1.IntelConfig{DDPPackage: "ice_comms-1.3.45.0.pkg"})
+		h.EXPECT().GetDDPPackage("0000:3b:00.0").Return("", intel.ErrUnmanagedDDPPackage)
+		h.EXPECT().GetDevlinkDeviceSerialNumber("0000:3b:00.0").Return("00-01-02-ff-ff-03-04-05", nil)
+		needDrain, needReboot, err := p.OnNodeStateChange(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(needDrain).To(BeFalse())
+		Expect(needReboot).To(BeFalse())
+		Expect(p.Apply()).To(Succeed())
+	})
+
+	It("should fail if the ports of the NIC request different DDP packages", func() {
+		nodeState = newNodeState("ice", &sriovnetworkv1.IntelConfig{DDPPackage: "ice_comms-1.3.45.0.pkg"})
+		nodeState.Spec.Interfaces = append(nodeState.Spec.Interfaces, sriovnetworkv1.Interface{
+			Name: "ens1f1", PciAddress: "0000:3b:00.1", NumVfs: 4,
+			Intel: &sriovnetworkv1.IntelConfig{DDPPackage: "ice-1.3.36.0.pkg"}})
+		nodeState.Status.Interfaces = append(nodeState.Status.Interfaces, sriovnetworkv1.InterfaceExt{
+			Name: "ens1f1", PciAddress: "0000:3b:00.1", Vendor: "8086", DeviceID: "159b", Driver: "ice"})
+		h.EXPECT().GetDDPPackage(gomock.Any()).Return("ice_comms-1.3.45.0.pkg", nil).AnyTimes()
+		h.EXPECT().GetDevlinkDeviceSerialNumber(gomock.Any()).Return("00-01-02-ff-ff-03-04-05", nil).Times(2)
+		_, _, err := p.OnNodeStateChange(nodeState)
+		Expect(err).To(MatchError(ContainSubstring("conflicting DDP packages")))
+	})
+
+	It("should report a drift when the settings changed on the host and plan without changing the next apply", func() {
+		nodeState = newNodeState("ice", &sriovnetworkv1.IntelConfig{FwLldp: ptr.To(false)})
+		h.EXPECT().GetNetDevPrivFlags("ens1f0").Return(map[string]bool{"fw-lldp-agent": false}, nil)
+		_, _, err := p.OnNodeStateChange(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+
+		h.EXPECT().GetNetDevPrivFlags("ens1f0").Return(map[string]bool{"fw-lldp-agent": true}, nil).Times(2)
+		changed, err := p.CheckStatusChanges(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		plan, err := p.(plugin.PlanningPlugin).PlanNodeStateChange(nodeState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan.NeedDrain).To(BeFalse())
+
+		// nothing to apply, the last OnNodeStateChange didn't find changes
+		Expect(p.Apply()).To(Succeed())
+	})
+})
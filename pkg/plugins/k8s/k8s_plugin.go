@@ -127,10 +127,16 @@ func (p *K8sPlugin) Name() string {
 // OnNodeStateChange Invoked when SriovNetworkNodeState CR is created or updated, return if need dain and/or reboot node
 func (p *K8sPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState) (needDrain bool, needReboot bool, err error) {
 	log.Log.Info("k8s plugin OnNodeStateChange()")
+	p.updateTarget.reset()
+	return p.checkServicesState(new, p.updateTarget)
+}
+
+// checkServicesState fills the services to update to apply the SriovNetworkNodeState in target,
+// return if need dain and/or reboot node
+func (p *K8sPlugin) checkServicesState(new *sriovnetworkv1.SriovNetworkNodeState, target *k8sUpdateTarget) (needDrain bool, needReboot bool, err error) {
 	needDrain = false
 	needReboot = false
 
-	p.updateTarget.reset()
 	// TODO add check for enableOvsOffload in OperatorConfig later
 	// Update services if switchdev required
 	if !vars.UsingSystemdMode && !sriovnetworkv1.IsSwitchdevModeSpec(new.Spec) {
@@ -139,7 +145,7 @@ func (p *K8sPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState)
 
 	if sriovnetworkv1.IsSwitchdevModeSpec(new.Spec) {
 		// Check services
-		err = p.ovsServiceStateUpdate()
+		err = p.ovsServiceStateUpdate(target)
 		if err != nil {
 			log.Log.Error(err, "k8s plugin OnNodeStateChange(): failed")
 			return
@@ -148,22 +154,33 @@ func (p *K8sPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState)
 
 	if vars.UsingSystemdMode {
 		// Check sriov service
-		err = p.sriovServicesStateUpdate()
+		err = p.sriovServicesStateUpdate(target)
 		if err != nil {
 			log.Log.Error(err, "k8s plugin OnNodeStateChange(): failed")
 			return
 		}
 	}
 
-	if p.updateTarget.needReboot() {
+	if target.needReboot() {
 		needDrain = true
 		needReboot = true
-		log.Log.Info("k8s plugin OnNodeStateChange(): needReboot to update", "target", p.updateTarget)
+		log.Log.Info("k8s plugin OnNodeStateChange(): needReboot to update", "target", target)
 	}
 
 	return
 }
 
+// PlanNodeStateChange returns if the node would need drain and/or reboot to apply the SriovNetworkNodeState,
+// the services to update computed for the next Apply are preserved
+func (p *K8sPlugin) PlanNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState) (*plugins.NodeStatePlan, error) {
+	log.Log.Info("k8s plugin PlanNodeStateChange()")
+	needDrain, needReboot, err := p.checkServicesState(new, &k8sUpdateTarget{})
+	if err != nil {
+		return nil, err
+	}
+	return &plugins.NodeStatePlan{NeedDrain: needDrain, NeedReboot: needReboot}, nil
+}
+
 // TODO: implement - https://github.com/k8snetworkplumbingwg/sriov-network-operator/issues/630
 // OnNodeStatusChange verify whether SriovNetworkNodeState CR status present changes on configured VFs.
 func (p *K8sPlugin) CheckStatusChanges(*sriovnetworkv1.SriovNetworkNodeState) (bool, error) {
@@ -221,13 +238,13 @@ func (p *K8sPlugin) readManifestFiles() error {
 	return nil
 }
 
-func (p *K8sPlugin) sriovServicesStateUpdate() error {
+func (p *K8sPlugin) sriovServicesStateUpdate(target *k8sUpdateTarget) error {
 	for _, s := range []struct {
 		srv    *hostTypes.Service
 		update *updateTargetReq
 	}{
-		{srv: p.sriovService, update: &p.updateTarget.sriovScript},
-		{srv: p.sriovPostNetworkService, update: &p.updateTarget.sriovPostNetworkScript},
+		{srv: p.sriovService, update: &target.sriovScript},
+		{srv: p.sriovPostNetworkService, update: &target.sriovPostNetworkScript},
 	} {
 		isServiceEnabled, err := p.hostHelper.IsServiceEnabled(s.srv.Path)
 		if err != nil {
@@ -263,7 +280,7 @@ func (p *K8sPlugin) updateSriovServices() error {
 	return nil
 }
 
-func (p *K8sPlugin) ovsServiceStateUpdate() error {
+func (p *K8sPlugin) ovsServiceStateUpdate(target *k8sUpdateTarget) error {
 	exist, err := p.hostHelper.IsServiceExist(p.openVSwitchService.Path)
 	if err != nil {
 		return err
@@ -278,9 +295,9 @@ func (p *K8sPlugin) ovsServiceStateUpdate() error {
 		return nil
 	}
 	if p.isOVSHwOffloadingEnabled() {
-		p.updateTarget.openVSwitch.SetNeedUpdate()
+		target.openVSwitch.SetNeedUpdate()
 	} else {
-		p.updateTarget.openVSwitch.SetNeedReboot()
+		target.openVSwitch.SetNeedReboot()
 	}
 	return nil
 }
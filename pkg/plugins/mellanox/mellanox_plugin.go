@@ -37,6 +37,9 @@ type MellanoxPlugin struct {
 }
 
 var pciAddressesToReset []string
+
+// the firmware of the BlueField devices changing mode is always reset, a reboot doesn't reload it in DPU mode
+var blueFieldPciAddressesToReset []string
 var attributesToChange map[string]mlx.MlxNic
 var mellanoxNicsStatus map[string]map[string]sriovnetworkv1.InterfaceExt
 var mellanoxNicsSpec map[string]sriovnetworkv1.Interface
@@ -59,49 +62,67 @@ func (p *MellanoxPlugin) Name() string {
 // OnNodeStateChange Invoked when SriovNetworkNodeState CR is created or updated, return if need dain and/or reboot node
 func (p *MellanoxPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState) (needDrain bool, needReboot bool, err error) {
 	log.Log.Info("mellanox plugin OnNodeStateChange()")
+	c, needDrain, needReboot, err := p.getNicChanges(new)
+	pciAddressesToReset, blueFieldPciAddressesToReset = c.pciAddressesToReset, c.blueFieldPciAddressesToReset
+	attributesToChange = c.attributesToChange
+	mellanoxNicsStatus, mellanoxNicsSpec = c.nicsStatus, c.nicsSpec
+	return
+}
 
-	needDrain = false
-	needReboot = false
-	err = nil
-	pciAddressesToReset = []string{}
-	attributesToChange = map[string]mlx.MlxNic{}
-	mellanoxNicsStatus = map[string]map[string]sriovnetworkv1.InterfaceExt{}
-	mellanoxNicsSpec = map[string]sriovnetworkv1.Interface{}
+// nicChanges holds the firmware changes required on the Mellanox NICs to apply a SriovNetworkNodeState
+type nicChanges struct {
+	pciAddressesToReset          []string
+	blueFieldPciAddressesToReset []string
+	attributesToChange           map[string]mlx.MlxNic
+	nicsStatus                   map[string]map[string]sriovnetworkv1.InterfaceExt
+	nicsSpec                     map[string]sriovnetworkv1.Interface
+}
+
+// getNicChanges computes the firmware changes required to apply the SriovNetworkNodeState and
+// returns if they need drain and/or reboot node. The firmware is only queried.
+func (p *MellanoxPlugin) getNicChanges(new *sriovnetworkv1.SriovNetworkNodeState) (c *nicChanges, needDrain bool, needReboot bool, err error) {
+	c = &nicChanges{
+		pciAddressesToReset:          []string{},
+		blueFieldPciAddressesToReset: []string{},
+		attributesToChange:           map[string]mlx.MlxNic{},
+		nicsStatus:                   map[string]map[string]sriovnetworkv1.InterfaceExt{},
+		nicsSpec:                     map[string]sriovnetworkv1.Interface{},
+	}
 	processedNics := map[string]bool{}
 
-	// fill mellanoxNicsStatus
+	// fill nicsStatus
 	for _, iface := range new.Status.Interfaces {
 		if iface.Vendor != mlx.MellanoxVendorID {
 			continue
 		}
 
 		pciPrefix := mlx.GetPciAddressPrefix(iface.PciAddress)
-		if ifaces, ok := mellanoxNicsStatus[pciPrefix]; ok {
+		if ifaces, ok := c.nicsStatus[pciPrefix]; ok {
 			ifaces[iface.PciAddress] = iface
 		} else {
-			mellanoxNicsStatus[pciPrefix] = map[string]sriovnetworkv1.InterfaceExt{iface.PciAddress: iface}
+			c.nicsStatus[pciPrefix] = map[string]sriovnetworkv1.InterfaceExt{iface.PciAddress: iface}
 		}
 	}
 
 	// Add only mellanox cards that required changes in the map, to help track dual port NICs
 	for _, iface := range new.Spec.Interfaces {
 		pciPrefix := mlx.GetPciAddressPrefix(iface.PciAddress)
-		if _, ok := mellanoxNicsStatus[pciPrefix]; !ok {
+		if _, ok := c.nicsStatus[pciPrefix]; !ok {
 			continue
 		}
-		mellanoxNicsSpec[iface.PciAddress] = iface
+		c.nicsSpec[iface.PciAddress] = iface
 	}
 
 	if p.helpers.IsKernelLockdownMode() {
-		if len(mellanoxNicsSpec) > 0 {
+		if len(c.nicsSpec) > 0 {
 			log.Log.Info("Lockdown mode detected, failing on interface update for mellanox devices")
-			return false, false, fmt.Errorf("mellanox device detected when in lockdown mode")
+			return c, false, false, fmt.Errorf("mellanox device detected when in lockdown mode")
 		}
 		log.Log.Info("Lockdown mode detected, skpping mellanox nic processing")
 		return
 	}
 
-	for _, ifaceSpec := range mellanoxNicsSpec {
+	for _, ifaceSpec := range c.nicsSpec {
 		pciPrefix := mlx.GetPciAddressPrefix(ifaceSpec.PciAddress)
 		// skip processed nics, help not running the same logic 2 times for dual port NICs
 		if _, ok := processedNics[pciPrefix]; ok {
@@ -110,48 +131,67 @@ func (p *MellanoxPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeS
 		processedNics[pciPrefix] = true
 		fwCurrent, fwNext, err := p.helpers.GetMlxNicFwData(ifaceSpec.PciAddress)
 		if err != nil {
-			return false, false, err
+			return c, false, false, err
 		}
 
-		isDualPort := mlx.IsDualPort(ifaceSpec.PciAddress, mellanoxNicsStatus)
+		isDualPort := mlx.IsDualPort(ifaceSpec.PciAddress, c.nicsStatus)
 		// Attributes to change
 		attrs := &mlx.MlxNic{TotalVfs: -1}
 		var changeWithoutReboot bool
 
-		totalVfs, totalVfsNeedReboot, totalVfsChangeWithoutReboot := mlx.HandleTotalVfs(fwCurrent, fwNext, attrs, ifaceSpec, isDualPort, mellanoxNicsSpec)
+		totalVfs, totalVfsNeedReboot, totalVfsChangeWithoutReboot := mlx.HandleTotalVfs(fwCurrent, fwNext, attrs, ifaceSpec, isDualPort, c.nicsSpec)
 		sriovEnNeedReboot, sriovEnChangeWithoutReboot := mlx.HandleEnableSriov(totalVfs, fwCurrent, fwNext, attrs)
 		needReboot = totalVfsNeedReboot || sriovEnNeedReboot
 		changeWithoutReboot = totalVfsChangeWithoutReboot || sriovEnChangeWithoutReboot
 
-		needLinkChange, err := mlx.HandleLinkType(pciPrefix, fwCurrent, attrs, mellanoxNicsSpec, mellanoxNicsStatus)
+		needLinkChange, err := mlx.HandleLinkType(pciPrefix, fwCurrent, attrs, c.nicsSpec, c.nicsStatus)
 		if err != nil {
-			return false, false, err
+			return c, false, false, err
 		}
 		needReboot = needReboot || needLinkChange
 
+		fwParamsNeedReboot, fwParamsChangeWithoutReboot, err := mlx.HandleFwParams(pciPrefix, fwCurrent, fwNext, attrs, c.nicsSpec)
+		if err != nil {
+			return c, false, false, err
+		}
+		needReboot = needReboot || fwParamsNeedReboot
+		changeWithoutReboot = changeWithoutReboot || fwParamsChangeWithoutReboot
+
+		blueFieldModeNeedReboot, err := p.handleBlueFieldMode(pciPrefix, attrs, c)
+		if err != nil {
+			return c, false, false, err
+		}
+		if blueFieldModeNeedReboot {
+			needReboot = true
+			c.blueFieldPciAddressesToReset = append(c.blueFieldPciAddressesToReset, ifaceSpec.PciAddress)
+		}
+
 		// no FW changes allowed when NIC is externally managed
 		if ifaceSpec.ExternallyManaged {
 			if totalVfsNeedReboot || totalVfsChangeWithoutReboot {
-				return false, false, fmt.Errorf(
+				return c, false, false, fmt.Errorf(
 					"interface %s required a change in the TotalVfs but the policy is externally managed failing: firmware TotalVf %d requested TotalVf %d",
 					ifaceSpec.PciAddress, fwCurrent.TotalVfs, totalVfs)
 			}
 			if needLinkChange {
-				return false, false, fmt.Errorf("change required for link type but the policy is externally managed, failing")
+				return c, false, false, fmt.Errorf("change required for link type but the policy is externally managed, failing")
+			}
+			if fwParamsNeedReboot || fwParamsChangeWithoutReboot {
+				return c, false, false, fmt.Errorf("change required for firmware parameters but the policy is externally managed, failing")
 			}
 		}
 
 		if needReboot || changeWithoutReboot {
-			attributesToChange[ifaceSpec.PciAddress] = *attrs
+			c.attributesToChange[ifaceSpec.PciAddress] = *attrs
 		}
 
 		if needReboot {
-			pciAddressesToReset = append(pciAddressesToReset, ifaceSpec.PciAddress)
+			c.pciAddressesToReset = append(c.pciAddressesToReset, ifaceSpec.PciAddress)
 		}
 	}
 
 	// Set total VFs to 0 for mellanox interfaces with no spec
-	for pciPrefix, portsMap := range mellanoxNicsStatus {
+	for pciPrefix, portsMap := range c.nicsStatus {
 		if _, ok := processedNics[pciPrefix]; ok {
 			continue
 		}
@@ -163,7 +203,7 @@ func (p *MellanoxPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeS
 		// Skip devices not configured by the operator
 		isConfigured, err := p.nicConfiguredByOperator(portsMap)
 		if err != nil {
-			return false, false, err
+			return c, false, false, err
 		}
 		if !isConfigured {
 			log.Log.V(2).Info("None of the ports are configured by the operator skipping firmware reset",
@@ -174,7 +214,7 @@ func (p *MellanoxPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeS
 		// Skip externally managed NICs
 		hasExternally, err := p.nicHasExternallyManagedPFs(portsMap)
 		if err != nil {
-			return false, false, err
+			return c, false, false, err
 		}
 		if hasExternally {
 			log.Log.V(2).Info("One of the ports is configured as externally managed skipping firmware reset",
@@ -189,11 +229,11 @@ func (p *MellanoxPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeS
 
 		_, fwNext, err := p.helpers.GetMlxNicFwData(pciAddress)
 		if err != nil {
-			return false, false, err
+			return c, false, false, err
 		}
 
 		if fwNext.TotalVfs > 0 || fwNext.EnableSriov {
-			attributesToChange[pciAddress] = mlx.MlxNic{TotalVfs: 0}
+			c.attributesToChange[pciAddress] = mlx.MlxNic{TotalVfs: 0}
 			log.Log.V(2).Info("Changing TotalVfs to 0, doesn't require rebooting", "fwNext.totalVfs", fwNext.TotalVfs)
 		}
 	}
@@ -205,6 +245,17 @@ func (p *MellanoxPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeS
 	return
 }
 
+// PlanNodeStateChange returns if the node would need drain and/or reboot to apply the SriovNetworkNodeState.
+// The firmware is only queried, the changes computed for the next Apply are preserved.
+func (p *MellanoxPlugin) PlanNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState) (*plugin.NodeStatePlan, error) {
+	log.Log.Info("mellanox plugin PlanNodeStateChange()")
+	_, needDrain, needReboot, err := p.getNicChanges(new)
+	if err != nil {
+		return nil, err
+	}
+	return &plugin.NodeStatePlan{NeedDrain: needDrain, NeedReboot: needReboot}, nil
+}
+
 // TODO: implement - https://github.com/k8snetworkplumbingwg/sriov-network-operator/issues/631
 // OnNodeStatusChange verify whether SriovNetworkNodeState CR status present changes on configured VFs.
 func (p *MellanoxPlugin) CheckStatusChanges(*sriovnetworkv1.SriovNetworkNodeState) (bool, error) {
@@ -224,9 +275,37 @@ func (p *MellanoxPlugin) Apply() error {
 	if vars.FeatureGate.IsEnabled(consts.MellanoxFirmwareResetFeatureGate) {
 		return p.helpers.MlxResetFW(pciAddressesToReset, mellanoxNicsStatus)
 	}
+	if len(blueFieldPciAddressesToReset) > 0 {
+		return p.helpers.MlxResetFW(blueFieldPciAddressesToReset, mellanoxNicsStatus)
+	}
 	return nil
 }
 
+// handleBlueFieldMode sets the BlueField mode to configure on the NIC and returns true if the mode must change,
+// the change is applied with a firmware reset and a reboot of the node
+func (p *MellanoxPlugin) handleBlueFieldMode(pciPrefix string, attrs *mlx.MlxNic, c *nicChanges) (bool, error) {
+	mode, err := mlx.GetRequestedBlueFieldMode(pciPrefix, c.nicsSpec)
+	if err != nil || mode == "" {
+		return false, err
+	}
+	for _, iface := range c.nicsStatus[pciPrefix] {
+		if !mlx.IsBlueField(iface.DeviceID) {
+			return false, fmt.Errorf("BlueField mode %s requested for device %s with device ID %s that is not a BlueField",
+				mode, iface.PciAddress, iface.DeviceID)
+		}
+	}
+	current, err := p.helpers.GetMellanoxBlueFieldMode(pciPrefix + "0")
+	if err != nil {
+		return false, err
+	}
+	if current.String() == mode {
+		return false, nil
+	}
+	log.Log.V(2).Info("Changing BlueField mode, needs firmware reset and reboot", "from", current.String(), "to", mode)
+	attrs.BlueFieldMode = mode
+	return true, nil
+}
+
 // nicHasExternallyManagedPFs returns true if one of the ports(interface) of the NIC is marked as externally managed
 // in StoreManagerInterface.
 func (p *MellanoxPlugin) nicHasExternallyManagedPFs(nicPortsMap map[string]sriovnetworkv1.InterfaceExt) (bool, error) {
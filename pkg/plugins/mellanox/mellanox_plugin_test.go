@@ -172,6 +172,107 @@ var _ = Describe("SRIOV", Ordered, func() {
 			Expect(needReboot).To(BeTrue())
 		})
 
+		It("should return true on reboot and apply the firmware parameters that changed", func() {
+			h.EXPECT().IsKernelLockdownMode().Return(false).Times(2)
+			h.EXPECT().GetMlxNicFwData("0000:d8:00.0").Return(
+				&mlx.MlxNic{TotalVfs: 10, EnableSriov: true, FwParams: map[string]string{"ATS_ENABLED": "False(0)", "MAX_ACC_OUT_READ": "32"}},
+				&mlx.MlxNic{TotalVfs: 10, EnableSriov: true, FwParams: map[string]string{"ATS_ENABLED": "False(0)", "MAX_ACC_OUT_READ": "32"}}, nil)
+			sriovNetworkNodeState.Spec.Interfaces = sriovnetworkv1.Interfaces{
+				{Name: "eno1",
+					NumVfs:     10,
+					PciAddress: "0000:d8:00.0", VfGroups: []sriovnetworkv1.VfGroup{
+						{ResourceName: "test",
+							PolicyName: "test",
+							VfRange:    "eno1#0-9"},
+					},
+					Mellanox: &sriovnetworkv1.MellanoxConfig{
+						FirmwareParams: map[string]string{"ATS_ENABLED": "True", "MAX_ACC_OUT_READ": "32"}},
+				},
+			}
+			sriovNetworkNodeState.Status.Interfaces = sriovnetworkv1.InterfaceExts{
+				{
+					Name:       "eno1",
+					NumVfs:     10,
+					PciAddress: "0000:d8:00.0",
+					Vendor:     "15b3",
+				},
+			}
+
+			needDrain, needReboot, err := m.OnNodeStateChange(sriovNetworkNodeState)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(needDrain).To(BeTrue())
+			Expect(needReboot).To(BeTrue())
+
+			h.EXPECT().MlxConfigFW(map[string]mlx.MlxNic{
+				"0000:d8:00.0": {TotalVfs: -1, FwParams: map[string]string{"ATS_ENABLED": "True"}}}).Return(nil)
+			Expect(m.Apply()).To(Succeed())
+		})
+
+		It("should return true on reboot and switch the BlueField mode", func() {
+			h.EXPECT().IsKernelLockdownMode().Return(false).Times(2)
+			h.EXPECT().GetMlxNicFwData("0000:d8:00.0").Return(&mlx.MlxNic{TotalVfs: 10, EnableSriov: true}, &mlx.MlxNic{TotalVfs: 10, EnableSriov: true}, nil)
+			h.EXPECT().GetMellanoxBlueFieldMode("0000:d8:00.0").Return(mlx.BluefieldDpu, nil)
+			sriovNetworkNodeState.Spec.Interfaces = sriovnetworkv1.Interfaces{
+				{Name: "p0",
+					NumVfs:     10,
+					PciAddress: "0000:d8:00.0", VfGroups: []sriovnetworkv1.VfGroup{
+						{ResourceName: "test",
+							PolicyName: "test",
+							VfRange:    "p0#0-9"},
+					},
+					Mellanox: &sriovnetworkv1.MellanoxConfig{BlueFieldMode: consts.BlueFieldModeNIC},
+				},
+			}
+			sriovNetworkNodeState.Status.Interfaces = sriovnetworkv1.InterfaceExts{
+				{
+					Name:       "p0",
+					NumVfs:     10,
+					PciAddress: "0000:d8:00.0",
+					Vendor:     "15b3",
+					DeviceID:   "a2d6",
+				},
+			}
+
+			needDrain, needReboot, err := m.OnNodeStateChange(sriovNetworkNodeState)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(needDrain).To(BeTrue())
+			Expect(needReboot).To(BeTrue())
+
+			vars.FeatureGate.Init(nil)
+			h.EXPECT().MlxConfigFW(map[string]mlx.MlxNic{
+				"0000:d8:00.0": {TotalVfs: -1, BlueFieldMode: consts.BlueFieldModeNIC}}).Return(nil)
+			h.EXPECT().MlxResetFW([]string{"0000:d8:00.0"}, gomock.Any()).Return(nil)
+			Expect(m.Apply()).To(Succeed())
+		})
+
+		It("should fail if the BlueField mode is requested for a device that is not a BlueField", func() {
+			h.EXPECT().IsKernelLockdownMode().Return(false)
+			h.EXPECT().GetMlxNicFwData("0000:d8:00.0").Return(&mlx.MlxNic{TotalVfs: 10, EnableSriov: true}, &mlx.MlxNic{TotalVfs: 10, EnableSriov: true}, nil)
+			sriovNetworkNodeState.Spec.Interfaces = sriovnetworkv1.Interfaces{
+				{Name: "eno1",
+					NumVfs:     10,
+					PciAddress: "0000:d8:00.0", VfGroups: []sriovnetworkv1.VfGroup{
+						{ResourceName: "test",
+							PolicyName: "test",
+							VfRange:    "eno1#0-9"},
+					},
+					Mellanox: &sriovnetworkv1.MellanoxConfig{BlueFieldMode: consts.BlueFieldModeNIC},
+				},
+			}
+			sriovNetworkNodeState.Status.Interfaces = sriovnetworkv1.InterfaceExts{
+				{
+					Name:       "eno1",
+					NumVfs:     10,
+					PciAddress: "0000:d8:00.0",
+					Vendor:     "15b3",
+					DeviceID:   "1013",
+				},
+			}
+
+			_, _, err := m.OnNodeStateChange(sriovNetworkNodeState)
+			Expect(err).To(MatchError(ContainSubstring("is not a BlueField")))
+		})
+
 		It("should return true on reboot adding vfs for one PF and removing for the other", func() {
 			h.EXPECT().IsKernelLockdownMode().Return(false)
 			h.EXPECT().GetMlxNicFwData("0000:d8:00.0").Return(&mlx.MlxNic{TotalVfs: 0}, &mlx.MlxNic{TotalVfs: 0}, nil)
@@ -440,9 +541,25 @@ var _ = Describe("SRIOV", Ordered, func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should reset the firmware of the BlueField changing mode if feature flag is disabled", func() {
+			vars.FeatureGate.Init(nil)
+
+			pciAddressesToReset = []string{"0000:d8:00.0", "0000:d9:00.0"}
+			blueFieldPciAddressesToReset = []string{"0000:d9:00.0"}
+
+			h.EXPECT().IsKernelLockdownMode().Return(false)
+			h.EXPECT().MlxConfigFW(gomock.Any()).Return(nil)
+
+			h.EXPECT().MlxResetFW([]string{"0000:d9:00.0"}, gomock.Any()).Return(nil)
+
+			err := m.Apply()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		BeforeEach(func() {
 			// Reset global state before each test
 			pciAddressesToReset = []string{}
+			blueFieldPciAddressesToReset = []string{}
 			mellanoxNicsStatus = map[string]map[string]sriovnetworkv1.InterfaceExt{}
 			mellanoxNicsSpec = map[string]sriovnetworkv1.Interface{}
 		})
@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 
 	v1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	plugin "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/plugins"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -98,3 +99,42 @@ func (mr *MockVendorPluginMockRecorder) OnNodeStateChange(arg0 any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnNodeStateChange", reflect.TypeOf((*MockVendorPlugin)(nil).OnNodeStateChange), arg0)
 }
+
+// MockPlanningPlugin is a mock of PlanningPlugin interface.
+type MockPlanningPlugin struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlanningPluginMockRecorder
+	isgomock struct{}
+}
+
+// MockPlanningPluginMockRecorder is the mock recorder for MockPlanningPlugin.
+type MockPlanningPluginMockRecorder struct {
+	mock *MockPlanningPlugin
+}
+
+// NewMockPlanningPlugin creates a new mock instance.
+func NewMockPlanningPlugin(ctrl *gomock.Controller) *MockPlanningPlugin {
+	mock := &MockPlanningPlugin{ctrl: ctrl}
+	mock.recorder = &MockPlanningPluginMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlanningPlugin) EXPECT() *MockPlanningPluginMockRecorder {
+	return m.recorder
+}
+
+// PlanNodeStateChange mocks base method.
+func (m *MockPlanningPlugin) PlanNodeStateChange(arg0 *v1.SriovNetworkNodeState) (*plugin.NodeStatePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanNodeStateChange", arg0)
+	ret0, _ := ret[0].(*plugin.NodeStatePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanNodeStateChange indicates an expected call of PlanNodeStateChange.
+func (mr *MockPlanningPluginMockRecorder) PlanNodeStateChange(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanNodeStateChange", reflect.TypeOf((*MockPlanningPlugin)(nil).PlanNodeStateChange), arg0)
+}
@@ -17,6 +17,8 @@
 package plugin
 
 import (
+	"errors"
+
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 )
 
@@ -31,3 +33,22 @@ type VendorPlugin interface {
 	// CheckStatusChanges checks status changes on the SriovNetworkNodeState CR for configured VFs.
 	CheckStatusChanges(*sriovnetworkv1.SriovNetworkNodeState) (bool, error)
 }
+
+// ErrPlanningNotSupported is returned by the PlanningPlugins that can't evaluate the SriovNetworkNodeState,
+// e.g. the external plugins that don't implement the planning
+var ErrPlanningNotSupported = errors.New("the plugin doesn't support planning")
+
+// PlanningPlugin is implemented by the plugins that can evaluate a SriovNetworkNodeState
+// without changing the host or the internal state of the plugin
+type PlanningPlugin interface {
+	// PlanNodeStateChange returns the changes the plugin would do on the node to apply the SriovNetworkNodeState
+	PlanNodeStateChange(*sriovnetworkv1.SriovNetworkNodeState) (*NodeStatePlan, error)
+}
+
+// NodeStatePlan is the result of the evaluation of a SriovNetworkNodeState by a PlanningPlugin
+type NodeStatePlan struct {
+	NeedDrain          bool
+	NeedReboot         bool
+	KernelArgsToAdd    []string
+	KernelArgsToRemove []string
+}
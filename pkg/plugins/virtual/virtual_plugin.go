@@ -72,6 +72,12 @@ func (p *VirtualPlugin) OnNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeSt
 	return p.needDrainNode(new.Spec, new.Status), false, nil
 }
 
+// PlanNodeStateChange returns if the node would need drain to apply the SriovNetworkNodeState
+func (p *VirtualPlugin) PlanNodeStateChange(new *sriovnetworkv1.SriovNetworkNodeState) (*plugin.NodeStatePlan, error) {
+	log.Log.Info("virtual plugin PlanNodeStateChange()")
+	return &plugin.NodeStatePlan{NeedDrain: p.needDrainNode(new.Spec, new.Status)}, nil
+}
+
 func (p *VirtualPlugin) needDrainNode(desired sriovnetworkv1.SriovNetworkNodeStateSpec, current sriovnetworkv1.SriovNetworkNodeStateStatus) bool {
 	log.Log.V(2).Info("virtual plugin needDrainNode()", "current", current, "desired", desired)
 	for _, ifaceStatus := range current.Interfaces {
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	// Wednesday
+	base := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	DescribeTable("Next",
+		func(spec string, from, expected time.Time) {
+			s, err := Parse(spec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Next(from)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", base, base.Add(time.Minute)),
+		Entry("daily later the same day", "0 22 * * *", base, time.Date(2025, time.January, 15, 22, 0, 0, 0, time.UTC)),
+		Entry("daily on the next day", "0 2 * * *", base, time.Date(2025, time.January, 16, 2, 0, 0, 0, time.UTC)),
+		Entry("saturdays", "0 2 * * 6", base, time.Date(2025, time.January, 18, 2, 0, 0, 0, time.UTC)),
+		Entry("sunday as 7", "0 2 * * 7", base, time.Date(2025, time.January, 19, 2, 0, 0, 0, time.UTC)),
+		Entry("steps", "*/20 * * * *", base, time.Date(2025, time.January, 15, 10, 40, 0, 0, time.UTC)),
+		Entry("ranges and lists", "0 1-3,23 * * 1-5", base, time.Date(2025, time.January, 15, 23, 0, 0, 0, time.UTC)),
+		Entry("day of month or day of week", "0 0 1 * 5", base, time.Date(2025, time.January, 17, 0, 0, 0, 0, time.UTC)),
+		Entry("day of month step and day of week", "0 0 */2 * 1", base, time.Date(2025, time.January, 27, 0, 0, 0, 0, time.UTC)),
+		Entry("day of month and day of week step", "0 0 15 * */2", base, time.Date(2025, time.February, 15, 0, 0, 0, 0, time.UTC)),
+		Entry("next year", "0 0 1 1 *", base, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("never", "0 0 30 2 *", base, time.Time{}),
+	)
+
+	It("should keep the location of the reference time", func() {
+		loc, err := time.LoadLocation("America/New_York")
+		Expect(err).ToNot(HaveOccurred())
+		s, err := Parse("0 2 * * *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Next(base.In(loc))).To(Equal(time.Date(2025, time.January, 16, 2, 0, 0, 0, loc)))
+	})
+
+	DescribeTable("Parse errors",
+		func(spec string) {
+			_, err := Parse(spec)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("missing fields", "0 2 * *"),
+		Entry("out of range", "60 * * * *"),
+		Entry("invalid range", "0 5-2 * * *"),
+		Entry("invalid step", "*/0 * * * *"),
+		Entry("not a number", "a * * * *"),
+	)
+})
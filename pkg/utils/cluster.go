@@ -21,10 +21,13 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	constants "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 )
 
@@ -86,6 +89,41 @@ func AnnotateNode(ctx context.Context, nodeName string, key, value string, c cli
 	return AnnotateObject(ctx, node, key, value, c)
 }
 
+// SetNodeStateDrainCondition mirrors a drain state transition in the Drain condition of the SriovNetworkNodeState.
+// The condition is true while the node is not idle. It returns true if the condition changed.
+func SetNodeStateDrainCondition(ctx context.Context, nodeState *sriovnetworkv1.SriovNetworkNodeState, reason, message string, c client.Client) (bool, error) {
+	condition := metav1.Condition{
+		Type:               constants.ConditionDrain,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: nodeState.Generation,
+	}
+	if reason == constants.ConditionReasonDrainIdle {
+		condition.Status = metav1.ConditionFalse
+	}
+
+	changed := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &sriovnetworkv1.SriovNetworkNodeState{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(nodeState), current); err != nil {
+			return err
+		}
+		updated := current.DeepCopy()
+		changed = meta.SetStatusCondition(&updated.Status.Conditions, condition)
+		if !changed {
+			return nil
+		}
+		return c.Status().Patch(ctx, updated, client.MergeFromWithOptions(current, client.MergeFromWithOptimisticLock{}))
+	})
+	if err != nil {
+		log.Log.Error(err, "SetNodeStateDrainCondition(): Failed to update the drain condition", "name", nodeState.Name)
+		return false, err
+	}
+	meta.SetStatusCondition(&nodeState.Status.Conditions, condition)
+	return changed, nil
+}
+
 // labelObject adds label to a kubernetes object
 func labelObject(ctx context.Context, obj client.Object, key, value string, c client.Client) error {
 	newObj := obj.DeepCopyObject().(client.Object)
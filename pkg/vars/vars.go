@@ -90,12 +90,22 @@ var (
 	// ResourcePrefix is the device plugin prefix we use to expose the devices to the nodes
 	ResourcePrefix = ""
 
+	// VendorPluginsDir is the directory of the sockets of the external vendor plugins, external plugins are not loaded when empty
+	VendorPluginsDir = ""
+
+	// VendorPluginsTimeout is the timeout of the calls to the external vendor plugins
+	VendorPluginsTimeout = consts.VendorPluginsDefaultTimeout
+
 	// DisableablePlugins contains which plugins can be disabled in sriov config daemon
 	DisableablePlugins = map[string]struct{}{"mellanox": {}}
 
 	// DisableDrain controls if the daemon will drain the node before configuration
 	DisableDrain = false
 
+	// AutoRollbackAfterFailures is the number of failed attempts to apply a generation
+	// before the daemon re-applies the last known-good configuration, 0 disables the rollback
+	AutoRollbackAfterFailures = 0
+
 	// FeatureGates interface to interact with feature gates
 	FeatureGate featuregate.FeatureGate
 
@@ -0,0 +1,147 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package intelutils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+)
+
+const (
+	IntelVendorID = "8086"
+	DriverIce     = "ice"
+	DriverI40e    = "i40e"
+
+	// DDP packages shipped on the host and the directory the ice driver looks into first
+	// for a package named after the serial number of the device
+	ddpPackagesPath = "/lib/firmware/intel/ice/ddp"
+	ddpUpdatesPath  = "/lib/firmware/updates/intel/ice/ddp"
+)
+
+// devlink parameters of the ice driver managed from the IntelConfig of the policy
+const (
+	DevlinkParamEnableRoce         = "enable_roce"
+	DevlinkParamEnableIwarp        = "enable_iwarp"
+	DevlinkParamTxSchedulingLayers = "tx_scheduling_layers"
+	DevlinkParamMsixVecPerPfMax    = consts.DevlinkParamIntelMsixVecPerPfMax
+)
+
+// ErrUnmanagedDDPPackage is returned when the DDP package of the device is a file copied on the host
+// instead of a package selected by the operator, the file is left untouched
+var ErrUnmanagedDDPPackage = errors.New("the DDP package of the device is not managed by the operator")
+
+//go:generate ../../../bin/mockgen -destination mock/mock_intel.go -source intel.go
+type IntelInterface interface {
+	// GetDDPPackage returns the DDP package selected for the device, empty if the driver loads the default package,
+	// ErrUnmanagedDDPPackage if the package was not selected by the operator
+	GetDDPPackage(pciAddress string) (string, error)
+	// SetDDPPackage selects the DDP package loaded by the ice driver for the device, the package is loaded
+	// by the driver at the next probe of the device, ErrUnmanagedDDPPackage if the package was not selected by the operator
+	SetDDPPackage(pciAddress, ddpPackage string) error
+}
+
+type intelHelper struct {
+	hostHelper host.HostManagerInterface
+}
+
+func New(hostHelper host.HostManagerInterface) IntelInterface {
+	return &intelHelper{
+		hostHelper: hostHelper,
+	}
+}
+
+// GetDDPPackage returns the DDP package selected for the device, empty if the driver loads the default package
+func (i *intelHelper) GetDDPPackage(pciAddress string) (string, error) {
+	path, err := i.ddpPackagePath(pciAddress)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Lstat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		log.Log.Error(err, "GetDDPPackage(): failed to read the DDP package of the device", "device", pciAddress)
+		return "", err
+	}
+	// a package copied by the administrator, not selected by the operator
+	if info.Mode()&os.ModeSymlink == 0 {
+		return "", ErrUnmanagedDDPPackage
+	}
+	target, err := os.Readlink(path)
+	if err != nil {
+		log.Log.Error(err, "GetDDPPackage(): failed to read the DDP package link of the device", "device", pciAddress)
+		return "", err
+	}
+	return filepath.Base(target), nil
+}
+
+// SetDDPPackage selects the DDP package loaded by the ice driver for the device, the package is loaded
+// by the driver at the next probe of the device
+func (i *intelHelper) SetDDPPackage(pciAddress, ddpPackage string) error {
+	log.Log.Info("SetDDPPackage(): select DDP package", "device", pciAddress, "package", ddpPackage)
+	target := filepath.Join(ddpPackagesPath, ddpPackage)
+	if _, err := os.Stat(filepath.Join(vars.FilesystemRoot, consts.Host, target)); err != nil {
+		log.Log.Error(err, "SetDDPPackage(): DDP package not found on the host", "package", target)
+		return fmt.Errorf("DDP package %s not found on the host: %v", target, err)
+	}
+	path, err := i.ddpPackagePath(pciAddress)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), os.ModeDir|0755); err != nil {
+		log.Log.Error(err, "SetDDPPackage(): failed to create the DDP updates directory")
+		return err
+	}
+	if info, err := os.Lstat(path); err == nil && info.Mode()&os.ModeSymlink == 0 {
+		log.Log.Info("SetDDPPackage(): the DDP package of the device was copied on the host, leave it", "device", pciAddress, "path", path)
+		return ErrUnmanagedDDPPackage
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Log.Error(err, "SetDDPPackage(): failed to remove the previous DDP package of the device", "device", pciAddress)
+		return err
+	}
+	// the link target is resolved on the host by the driver
+	if err := os.Symlink(target, path); err != nil {
+		log.Log.Error(err, "SetDDPPackage(): failed to select the DDP package", "device", pciAddress)
+		return err
+	}
+	return nil
+}
+
+// ddpPackagePath returns the path of the DDP package loaded by the ice driver for the device,
+// the driver expects the serial number of the device in lower case hex without the dashes
+func (i *intelHelper) ddpPackagePath(pciAddress string) (string, error) {
+	serial, err := i.hostHelper.GetDevlinkDeviceSerialNumber(pciAddress)
+	if err != nil {
+		return "", err
+	}
+	if serial == "" {
+		return "", fmt.Errorf("no serial number reported for device %s", pciAddress)
+	}
+	serial = strings.ToLower(strings.ReplaceAll(serial, "-", ""))
+	return filepath.Join(vars.FilesystemRoot, consts.Host, ddpUpdatesPath, fmt.Sprintf("ice-%s.pkg", serial)), nil
+}
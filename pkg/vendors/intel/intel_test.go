@@ -0,0 +1,97 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package intelutils
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.uber.org/mock/gomock"
+
+	mock_host "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/mock"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/test/util/fakefilesystem"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/test/util/helpers"
+)
+
+var _ = Describe("Intel", func() {
+	var (
+		i        IntelInterface
+		h        *mock_host.MockHostManagerInterface
+		testCtrl *gomock.Controller
+	)
+
+	const ddpLink = "/host/lib/firmware/updates/intel/ice/ddp/ice-000102ffff030405.pkg"
+
+	BeforeEach(func() {
+		testCtrl = gomock.NewController(GinkgoT())
+		h = mock_host.NewMockHostManagerInterface(testCtrl)
+		h.EXPECT().GetDevlinkDeviceSerialNumber("0000:3b:00.0").Return("00-01-02-FF-FF-03-04-05", nil).AnyTimes()
+		i = New(h)
+		helpers.GinkgoConfigureFakeFS(&fakefilesystem.FS{
+			Dirs: []string{"/host/lib/firmware/intel/ice/ddp"},
+			Files: map[string][]byte{
+				"/host/lib/firmware/intel/ice/ddp/ice_comms-1.3.45.0.pkg": {},
+			},
+		})
+	})
+
+	AfterEach(func() {
+		testCtrl.Finish()
+	})
+
+	It("should return an empty package when no package is selected for the device", func() {
+		ddp, err := i.GetDDPPackage("0000:3b:00.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ddp).To(BeEmpty())
+	})
+
+	It("should select the package for the serial number of the device", func() {
+		Expect(i.SetDDPPackage("0000:3b:00.0", "ice_comms-1.3.45.0.pkg")).To(Succeed())
+		target, err := os.Readlink(filepath.Join(vars.FilesystemRoot, ddpLink))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(target).To(Equal("/lib/firmware/intel/ice/ddp/ice_comms-1.3.45.0.pkg"))
+
+		ddp, err := i.GetDDPPackage("0000:3b:00.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ddp).To(Equal("ice_comms-1.3.45.0.pkg"))
+
+		// selecting the package again replaces the link
+		Expect(i.SetDDPPackage("0000:3b:00.0", "ice_comms-1.3.45.0.pkg")).To(Succeed())
+	})
+
+	It("should fail if the package doesn't exist on the host", func() {
+		Expect(i.SetDDPPackage("0000:3b:00.0", "missing.pkg")).To(MatchError(ContainSubstring("not found on the host")))
+		helpers.GinkgoAssertFileDoesNotExist(ddpLink)
+	})
+
+	It("should leave alone a package copied on the host for the device", func() {
+		Expect(os.MkdirAll(filepath.Join(vars.FilesystemRoot, filepath.Dir(ddpLink)), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(vars.FilesystemRoot, ddpLink), []byte("custom"), 0644)).To(Succeed())
+
+		_, err := i.GetDDPPackage("0000:3b:00.0")
+		Expect(err).To(MatchError(ErrUnmanagedDDPPackage))
+		Expect(i.SetDDPPackage("0000:3b:00.0", "ice_comms-1.3.45.0.pkg")).To(MatchError(ErrUnmanagedDDPPackage))
+
+		content, err := os.ReadFile(filepath.Join(vars.FilesystemRoot, ddpLink))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("custom"))
+	})
+})
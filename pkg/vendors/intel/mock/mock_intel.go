@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: intel.go
+//
+// Generated by this command:
+//
+//	mockgen -destination mock/mock_intel.go -source intel.go
+//
+
+// Package mock_intelutils is a generated GoMock package.
+package mock_intelutils
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIntelInterface is a mock of IntelInterface interface.
+type MockIntelInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockIntelInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockIntelInterfaceMockRecorder is the mock recorder for MockIntelInterface.
+type MockIntelInterfaceMockRecorder struct {
+	mock *MockIntelInterface
+}
+
+// NewMockIntelInterface creates a new mock instance.
+func NewMockIntelInterface(ctrl *gomock.Controller) *MockIntelInterface {
+	mock := &MockIntelInterface{ctrl: ctrl}
+	mock.recorder = &MockIntelInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntelInterface) EXPECT() *MockIntelInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetDDPPackage mocks base method.
+func (m *MockIntelInterface) GetDDPPackage(pciAddress string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDDPPackage", pciAddress)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDDPPackage indicates an expected call of GetDDPPackage.
+func (mr *MockIntelInterfaceMockRecorder) GetDDPPackage(pciAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDDPPackage", reflect.TypeOf((*MockIntelInterface)(nil).GetDDPPackage), pciAddress)
+}
+
+// SetDDPPackage mocks base method.
+func (m *MockIntelInterface) SetDDPPackage(pciAddress, ddpPackage string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDDPPackage", pciAddress, ddpPackage)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDDPPackage indicates an expected call of SetDDPPackage.
+func (mr *MockIntelInterfaceMockRecorder) SetDDPPackage(pciAddress, ddpPackage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDDPPackage", reflect.TypeOf((*MockIntelInterface)(nil).SetDDPPackage), pciAddress, ddpPackage)
+}
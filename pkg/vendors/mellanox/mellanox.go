@@ -18,7 +18,9 @@ package mlxutils
 
 import (
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -29,6 +31,7 @@ import (
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host"
+	hosttypes "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/types"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/utils"
 )
 
@@ -56,6 +59,7 @@ const (
 	DeviceBF2      = "a2d6"
 	DeviceBF3      = "a2dc"
 
+	UnknownBlueFieldMode  = "unknown"
 	PreconfiguredLinkType = "Preconfigured"
 	UnknownLinkType       = "Unknown"
 	TotalVfs              = "NUM_OF_VFS"
@@ -65,13 +69,37 @@ const (
 	MellanoxVendorID      = "15b3"
 )
 
+// String returns the name of the BlueField mode used in the policies
+func (m BlueFieldMode) String() string {
+	switch m {
+	case BluefieldDpu:
+		return consts.BlueFieldModeDPU
+	case BluefieldConnectXMode:
+		return consts.BlueFieldModeNIC
+	default:
+		return UnknownBlueFieldMode
+	}
+}
+
 type MlxNic struct {
 	EnableSriov bool
 	TotalVfs    int
 	LinkTypeP1  string
 	LinkTypeP2  string
+	// FwParams are the other firmware parameters of the NIC, by name
+	FwParams map[string]string
+	// BlueFieldMode is the mode to configure on a BlueField device, empty to keep the current mode
+	BlueFieldMode string
 }
 
+// FwParamsManagedByPolicy are the firmware parameters configured from the other fields of the policy
+var FwParamsManagedByPolicy = []string{EnableSriov, TotalVfs, LinkTypeP1, LinkTypeP2}
+
+var (
+	mstconfigAttributeRegex = regexp.MustCompile(`^\*?\s+([A-Z0-9_]+)\s+(\S+)\s+(\S+)\s+(\S+)\s*$`)
+	fwParamValueRegex       = regexp.MustCompile(`^(.*)\((\d+)\)$`)
+)
+
 //go:generate ../../../bin/mockgen -destination mock/mock_mellanox.go -source mellanox.go
 type MellanoxInterface interface {
 	MstConfigReadData(string) (string, string, error)
@@ -170,7 +198,7 @@ func (m *mellanoxHelper) MlxResetFW(pciAddresses []string, mellanoxNicsStatus ma
 		err := m.hostHelper.SetSriovNumVfs(pciAddress, 0)
 		if err != nil {
 			log.Log.Error(err, "failed to set SR-IOV number of VFs to 0 before firmware reset", "pciAddress", pciAddress)
-			return err
+			return hosttypes.NewInterfaceError(pciAddress, consts.InterfaceSyncReasonFirmwareResetFailed, err)
 		}
 
 		if IsDualPort(pciAddress, mellanoxNicsStatus) {
@@ -178,7 +206,7 @@ func (m *mellanoxHelper) MlxResetFW(pciAddresses []string, mellanoxNicsStatus ma
 			err := m.hostHelper.SetSriovNumVfs(otherPortPCIAddress, 0)
 			if err != nil {
 				log.Log.Error(err, "failed to set SR-IOV number of VFs to 0 before firmware reset", "pciAddress", otherPortPCIAddress)
-				return err
+				return hosttypes.NewInterfaceError(otherPortPCIAddress, consts.InterfaceSyncReasonFirmwareResetFailed, err)
 			}
 		}
 
@@ -188,7 +216,7 @@ func (m *mellanoxHelper) MlxResetFW(pciAddresses []string, mellanoxNicsStatus ma
 		_, stderr, err := m.utils.RunCommand("mstfwreset", cmdArgs...)
 		if err != nil {
 			log.Log.Error(err, "mellanox-plugin resetFW(): failed", "stderr", stderr)
-			errs = append(errs, err)
+			errs = append(errs, hosttypes.NewInterfaceError(pciAddress, consts.InterfaceSyncReasonFirmwareResetFailed, err))
 		}
 	}
 
@@ -203,11 +231,23 @@ func (m *mellanoxHelper) MlxConfigFW(attributesToChange map[string]MlxNic) error
 			// NIC is not a DPU or mstconfig failed. It's safe to continue FW configuration
 			log.Log.V(2).Info("mellanox-plugin: configFW(): can't get DPU mode for NIC", "pciAddress", pciAddr)
 		}
+		cmdArgs := []string{"-d", pciAddr, "-y", "set"}
 		if bfMode == BluefieldDpu {
 			// Host reboot won't re-load NIC firmware in DPU mode. To apply FW changes power cycle is required or mstfwreset could be used.
-			return errors.Errorf("NIC %s is in DPU mode. Firmware configuration changes are not supported in this mode.", pciAddr)
+			if fwArgs.BlueFieldMode != consts.BlueFieldModeNIC {
+				return hosttypes.NewInterfaceError(pciAddr, consts.InterfaceSyncReasonFirmwareConfigFailed,
+					errors.Errorf("NIC %s is in DPU mode. Firmware configuration changes are not supported in this mode.", pciAddr))
+			}
+			// only the switch to NIC mode is applied, the other changes are applied once the device is in NIC mode
+			cmdArgs = append(cmdArgs, blueFieldModeFwParams(consts.BlueFieldModeNIC)...)
+			log.Log.V(2).Info("mellanox-plugin: configFW(): switch BlueField to NIC mode", "cmd-args", cmdArgs)
+			_, strerr, err := m.utils.RunCommand("mstconfig", cmdArgs...)
+			if err != nil {
+				log.Log.Error(err, "mellanox-plugin configFW(): failed", "stderr", strerr)
+				return hosttypes.NewInterfaceError(pciAddr, consts.InterfaceSyncReasonFirmwareConfigFailed, err)
+			}
+			continue
 		}
-		cmdArgs := []string{"-d", pciAddr, "-y", "set"}
 		if fwArgs.EnableSriov {
 			cmdArgs = append(cmdArgs, fmt.Sprintf("%s=True", EnableSriov))
 		} else if fwArgs.TotalVfs == 0 {
@@ -222,6 +262,12 @@ func (m *mellanoxHelper) MlxConfigFW(attributesToChange map[string]MlxNic) error
 		if len(fwArgs.LinkTypeP2) > 0 {
 			cmdArgs = append(cmdArgs, fmt.Sprintf("%s=%s", LinkTypeP2, fwArgs.LinkTypeP2))
 		}
+		for _, name := range slices.Sorted(maps.Keys(fwArgs.FwParams)) {
+			cmdArgs = append(cmdArgs, fmt.Sprintf("%s=%s", name, fwArgs.FwParams[name]))
+		}
+		if fwArgs.BlueFieldMode != "" {
+			cmdArgs = append(cmdArgs, blueFieldModeFwParams(fwArgs.BlueFieldMode)...)
+		}
 
 		log.Log.V(2).Info("mellanox-plugin: configFW()", "cmd-args", cmdArgs)
 		if len(cmdArgs) <= 4 {
@@ -230,7 +276,7 @@ func (m *mellanoxHelper) MlxConfigFW(attributesToChange map[string]MlxNic) error
 		_, strerr, err := m.utils.RunCommand("mstconfig", cmdArgs...)
 		if err != nil {
 			log.Log.Error(err, "mellanox-plugin configFW(): failed", "stderr", strerr)
-			return err
+			return hosttypes.NewInterfaceError(pciAddr, consts.InterfaceSyncReasonFirmwareConfigFailed, err)
 		}
 	}
 	return nil
@@ -254,6 +300,23 @@ func (m *mellanoxHelper) GetMlxNicFwData(pciAddress string) (current, next *MlxN
 	next, err = mlnxNicFromMap(mstNextData)
 	if err != nil {
 		log.Log.Error(err, "mellanox-plugin mlnxNicFromMap() for next mstconfig data failed")
+		return
+	}
+	current.FwParams, next.FwParams = parseMstconfigAttributes(out)
+	return
+}
+
+// parseMstconfigAttributes returns the current and next boot values of all the firmware parameters of the mstconfig output
+func parseMstconfigAttributes(mstOutput string) (fwCurrent, fwNext map[string]string) {
+	fwCurrent = map[string]string{}
+	fwNext = map[string]string{}
+	for _, line := range strings.Split(mstOutput, "\n") {
+		regexResult := mstconfigAttributeRegex.FindStringSubmatch(line)
+		if regexResult == nil {
+			continue
+		}
+		fwCurrent[regexResult[1]] = regexResult[3]
+		fwNext[regexResult[1]] = regexResult[4]
 	}
 	return
 }
@@ -392,6 +455,102 @@ func HandleLinkType(pciPrefix string, fwData, attr *MlxNic,
 	return needReboot, nil
 }
 
+// HandleFwParams compares the firmware parameters requested for the ports of the NIC with the current and next boot values,
+// the parameters to change are added to attr. A reboot is needed if the current value is not the requested one.
+func HandleFwParams(pciPrefix string, fwCurrent, fwNext, attr *MlxNic,
+	mellanoxNicsSpec map[string]sriovnetworkv1.Interface) (needReboot, changeWithoutReboot bool, err error) {
+	desired := map[string]string{}
+	for _, pciAddress := range []string{pciPrefix + "0", pciPrefix + "1"} {
+		ifaceSpec, ok := mellanoxNicsSpec[pciAddress]
+		if !ok || ifaceSpec.Mellanox == nil {
+			continue
+		}
+		for name, value := range ifaceSpec.Mellanox.FirmwareParams {
+			if slices.Contains(FwParamsManagedByPolicy, name) {
+				return false, false, fmt.Errorf("firmware parameter %s of device %s is managed by the operator and can't be set", name, pciAddress)
+			}
+			if other, exist := desired[name]; exist && !strings.EqualFold(other, value) {
+				return false, false, fmt.Errorf("conflicting values %s and %s requested for the firmware parameter %s of the ports of NIC %s",
+					other, value, name, pciPrefix)
+			}
+			desired[name] = value
+		}
+	}
+
+	for name, value := range desired {
+		current, exist := fwCurrent.FwParams[name]
+		if !exist {
+			return false, false, fmt.Errorf("firmware parameter %s is not supported by NIC %s", name, pciPrefix)
+		}
+		switch {
+		case !FwParamValueEqual(current, value):
+			log.Log.V(2).Info("Changing firmware parameter, needs reboot", "name", name, "from", current, "to", value)
+			needReboot = true
+		case !FwParamValueEqual(fwNext.FwParams[name], value):
+			log.Log.V(2).Info("Changing firmware parameter to same as current value, doesn't require rebooting",
+				"name", name, "next", fwNext.FwParams[name], "requested", value)
+			changeWithoutReboot = true
+		default:
+			continue
+		}
+		if attr.FwParams == nil {
+			attr.FwParams = map[string]string{}
+		}
+		attr.FwParams[name] = value
+	}
+	return needReboot, changeWithoutReboot, nil
+}
+
+// IsBlueField returns true if the device ID is the one of a BlueField-2 or BlueField-3 device
+func IsBlueField(deviceID string) bool {
+	return deviceID == DeviceBF2 || deviceID == DeviceBF3
+}
+
+// GetRequestedBlueFieldMode returns the BlueField mode requested for the ports of the NIC, empty if no mode is requested
+func GetRequestedBlueFieldMode(pciPrefix string, mellanoxNicsSpec map[string]sriovnetworkv1.Interface) (string, error) {
+	mode := ""
+	for _, pciAddress := range []string{pciPrefix + "0", pciPrefix + "1"} {
+		ifaceSpec, ok := mellanoxNicsSpec[pciAddress]
+		if !ok || ifaceSpec.Mellanox == nil || ifaceSpec.Mellanox.BlueFieldMode == "" {
+			continue
+		}
+		if mode != "" && mode != ifaceSpec.Mellanox.BlueFieldMode {
+			return "", fmt.Errorf("conflicting BlueField modes %s and %s requested for the ports of NIC %s",
+				mode, ifaceSpec.Mellanox.BlueFieldMode, pciPrefix)
+		}
+		mode = ifaceSpec.Mellanox.BlueFieldMode
+	}
+	return mode, nil
+}
+
+// blueFieldModeFwParams returns the INTERNAL_CPU_* firmware parameters of the BlueField mode
+func blueFieldModeFwParams(mode string) []string {
+	owner, offloadEngine := extHostPf, disabled
+	if mode == consts.BlueFieldModeDPU {
+		owner, offloadEngine = ecpf, enabled
+	}
+	return []string{
+		fmt.Sprintf("%s=%s", internalCPUModel, embeddedCPU),
+		fmt.Sprintf("%s=%s", internalCPUPageSupplier, owner),
+		fmt.Sprintf("%s=%s", internalCPUEswitchManager, owner),
+		fmt.Sprintf("%s=%s", internalCPUIbVporto, owner),
+		fmt.Sprintf("%s=%s", internalCPUOffloadEngine, offloadEngine),
+	}
+}
+
+// FwParamValueEqual compares a firmware parameter value reported by mstconfig, for example True(1),
+// with a requested value that can be the name or the number of the value
+func FwParamValueEqual(fwValue, value string) bool {
+	if strings.EqualFold(fwValue, value) {
+		return true
+	}
+	regexResult := fwParamValueRegex.FindStringSubmatch(fwValue)
+	if regexResult == nil {
+		return false
+	}
+	return strings.EqualFold(regexResult[1], value) || regexResult[2] == value
+}
+
 func mlnxNicFromMap(mstData map[string]string) (*MlxNic, error) {
 	log.Log.Info("mellanox-plugin mlnxNicFromMap()", "data", mstData)
 	fwData := &MlxNic{}
@@ -25,6 +25,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	mock_host "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/host/mock"
 	mock_utils "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/utils/mock"
 )
@@ -312,6 +313,44 @@ var _ = Describe("SRIOV", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should set the firmware parameters", func() {
+			u.EXPECT().RunCommand("mstconfig", "-e", "-d", "0000:d8:00.0", "q").Return(
+				getBFMstconfigOutput(false, false),
+				"", nil)
+			u.EXPECT().RunCommand("mstconfig", "-d", "0000:d8:00.0", "-y", "set", "ATS_ENABLED=True", "MAX_ACC_OUT_READ=32").Return(
+				"",
+				"", nil)
+			err := m.MlxConfigFW(map[string]MlxNic{"0000:d8:00.0": {TotalVfs: -1,
+				FwParams: map[string]string{"MAX_ACC_OUT_READ": "32", "ATS_ENABLED": "True"}}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should only switch a BlueField in DPU mode to NIC mode", func() {
+			u.EXPECT().RunCommand("mstconfig", "-e", "-d", "0000:d8:00.0", "q").Return(
+				getBFMstconfigOutput(true, false),
+				"", nil)
+			u.EXPECT().RunCommand("mstconfig", "-d", "0000:d8:00.0", "-y", "set",
+				"INTERNAL_CPU_MODEL=EMBEDDED_CPU", "INTERNAL_CPU_PAGE_SUPPLIER=EXT_HOST_PF", "INTERNAL_CPU_ESWITCH_MANAGER=EXT_HOST_PF",
+				"INTERNAL_CPU_IB_VPORT0=EXT_HOST_PF", "INTERNAL_CPU_OFFLOAD_ENGINE=DISABLED").Return(
+				"",
+				"", nil)
+			err := m.MlxConfigFW(map[string]MlxNic{"0000:d8:00.0": {TotalVfs: 10, BlueFieldMode: consts.BlueFieldModeNIC}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should switch a BlueField in NIC mode to DPU mode", func() {
+			u.EXPECT().RunCommand("mstconfig", "-e", "-d", "0000:d8:00.0", "q").Return(
+				getBFMstconfigOutput(false, false),
+				"", nil)
+			u.EXPECT().RunCommand("mstconfig", "-d", "0000:d8:00.0", "-y", "set",
+				"INTERNAL_CPU_MODEL=EMBEDDED_CPU", "INTERNAL_CPU_PAGE_SUPPLIER=ECPF", "INTERNAL_CPU_ESWITCH_MANAGER=ECPF",
+				"INTERNAL_CPU_IB_VPORT0=ECPF", "INTERNAL_CPU_OFFLOAD_ENGINE=ENABLED").Return(
+				"",
+				"", nil)
+			err := m.MlxConfigFW(map[string]MlxNic{"0000:d8:00.0": {TotalVfs: -1, BlueFieldMode: consts.BlueFieldModeDPU}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should return error if args is not right", func() {
 			u.EXPECT().RunCommand("mstconfig", "-e", "-d", "0000:d8:00.0", "q").Return(
 				getBFMstconfigOutput(false, false),
@@ -348,6 +387,10 @@ var _ = Describe("SRIOV", func() {
 			Expect(next.EnableSriov).To(BeTrue())
 			Expect(next.LinkTypeP1).To(Equal("ETH"))
 			Expect(next.LinkTypeP2).To(Equal("ETH"))
+			Expect(current.FwParams).To(HaveKeyWithValue("PCI_BUS0_RESTRICT_SPEED", "PCI_GEN_1(0)"))
+			Expect(current.FwParams).To(HaveKeyWithValue("NUM_OF_VFS", "5"))
+			Expect(current.FwParams).ToNot(HaveKey("Device"))
+			Expect(next.FwParams).To(HaveKeyWithValue("NUM_OF_VFS", "10"))
 		})
 
 		It("should return the current and next firmware configuration without linkType", func() {
@@ -602,6 +645,119 @@ var _ = Describe("SRIOV", func() {
 		})
 	})
 
+	Context("HandleFwParams", func() {
+		var (
+			fwCurrent, fwNext *MlxNic
+			mellanoxNicsSpec  map[string]sriovnetworkv1.Interface
+		)
+		BeforeEach(func() {
+			fwCurrent = &MlxNic{FwParams: map[string]string{"ATS_ENABLED": "False(0)", "MAX_ACC_OUT_READ": "0"}}
+			fwNext = &MlxNic{FwParams: map[string]string{"ATS_ENABLED": "False(0)", "MAX_ACC_OUT_READ": "0"}}
+			mellanoxNicsSpec = map[string]sriovnetworkv1.Interface{
+				"0000:d8:00.0": {PciAddress: "0000:d8:00.0", Mellanox: &sriovnetworkv1.MellanoxConfig{
+					FirmwareParams: map[string]string{"ATS_ENABLED": "true"}}},
+				"0000:d8:00.1": {PciAddress: "0000:d8:00.1", Mellanox: &sriovnetworkv1.MellanoxConfig{
+					FirmwareParams: map[string]string{"MAX_ACC_OUT_READ": "0"}}},
+			}
+		})
+
+		It("should need a reboot if the current value is not the requested one", func() {
+			attrs := &MlxNic{}
+			needReboot, changeWithoutReboot, err := HandleFwParams("0000:d8:00.", fwCurrent, fwNext, attrs, mellanoxNicsSpec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(needReboot).To(BeTrue())
+			Expect(changeWithoutReboot).To(BeFalse())
+			Expect(attrs.FwParams).To(Equal(map[string]string{"ATS_ENABLED": "true"}))
+		})
+
+		It("should change without reboot if only the next boot value differs", func() {
+			fwCurrent.FwParams["ATS_ENABLED"] = "True(1)"
+			attrs := &MlxNic{}
+			needReboot, changeWithoutReboot, err := HandleFwParams("0000:d8:00.", fwCurrent, fwNext, attrs, mellanoxNicsSpec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(needReboot).To(BeFalse())
+			Expect(changeWithoutReboot).To(BeTrue())
+			Expect(attrs.FwParams).To(Equal(map[string]string{"ATS_ENABLED": "true"}))
+		})
+
+		It("should not change the parameters with the requested values", func() {
+			fwCurrent.FwParams["ATS_ENABLED"] = "True(1)"
+			fwNext.FwParams["ATS_ENABLED"] = "True(1)"
+			attrs := &MlxNic{}
+			needReboot, changeWithoutReboot, err := HandleFwParams("0000:d8:00.", fwCurrent, fwNext, attrs, mellanoxNicsSpec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(needReboot).To(BeFalse())
+			Expect(changeWithoutReboot).To(BeFalse())
+			Expect(attrs.FwParams).To(BeNil())
+		})
+
+		It("should fail if the ports request different values", func() {
+			mellanoxNicsSpec["0000:d8:00.1"].Mellanox.FirmwareParams["ATS_ENABLED"] = "False"
+			_, _, err := HandleFwParams("0000:d8:00.", fwCurrent, fwNext, &MlxNic{}, mellanoxNicsSpec)
+			Expect(err).To(MatchError(ContainSubstring("conflicting values")))
+		})
+
+		It("should fail if the parameter is not supported by the NIC", func() {
+			mellanoxNicsSpec["0000:d8:00.0"].Mellanox.FirmwareParams["UNKNOWN_PARAM"] = "1"
+			_, _, err := HandleFwParams("0000:d8:00.", fwCurrent, fwNext, &MlxNic{}, mellanoxNicsSpec)
+			Expect(err).To(MatchError(ContainSubstring("not supported")))
+		})
+
+		It("should fail if the parameter is managed by the operator", func() {
+			mellanoxNicsSpec["0000:d8:00.0"].Mellanox.FirmwareParams[TotalVfs] = "8"
+			_, _, err := HandleFwParams("0000:d8:00.", fwCurrent, fwNext, &MlxNic{}, mellanoxNicsSpec)
+			Expect(err).To(MatchError(ContainSubstring("managed by the operator")))
+		})
+	})
+
+	Context("FwParamValueEqual", func() {
+		It("should compare the names and the numbers of the values", func() {
+			Expect(FwParamValueEqual("True(1)", "True")).To(BeTrue())
+			Expect(FwParamValueEqual("True(1)", "true")).To(BeTrue())
+			Expect(FwParamValueEqual("True(1)", "1")).To(BeTrue())
+			Expect(FwParamValueEqual("True(1)", "0")).To(BeFalse())
+			Expect(FwParamValueEqual("32", "32")).To(BeTrue())
+			Expect(FwParamValueEqual("32", "64")).To(BeFalse())
+		})
+	})
+
+	Context("IsBlueField", func() {
+		It("should return true only for the BlueField device IDs", func() {
+			Expect(IsBlueField("a2d6")).To(BeTrue())
+			Expect(IsBlueField("a2dc")).To(BeTrue())
+			Expect(IsBlueField("101d")).To(BeFalse())
+		})
+	})
+
+	Context("GetRequestedBlueFieldMode", func() {
+		It("should return the mode requested on any of the ports", func() {
+			mellanoxNicsSpec := map[string]sriovnetworkv1.Interface{
+				"0000:d8:00.0": {PciAddress: "0000:d8:00.0"},
+				"0000:d8:00.1": {PciAddress: "0000:d8:00.1", Mellanox: &sriovnetworkv1.MellanoxConfig{BlueFieldMode: consts.BlueFieldModeNIC}},
+			}
+			mode, err := GetRequestedBlueFieldMode("0000:d8:00.", mellanoxNicsSpec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mode).To(Equal(consts.BlueFieldModeNIC))
+		})
+
+		It("should return an empty mode if no mode is requested", func() {
+			mode, err := GetRequestedBlueFieldMode("0000:d8:00.", map[string]sriovnetworkv1.Interface{
+				"0000:d8:00.0": {PciAddress: "0000:d8:00.0"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mode).To(BeEmpty())
+		})
+
+		It("should fail if the ports request different modes", func() {
+			mellanoxNicsSpec := map[string]sriovnetworkv1.Interface{
+				"0000:d8:00.0": {PciAddress: "0000:d8:00.0", Mellanox: &sriovnetworkv1.MellanoxConfig{BlueFieldMode: consts.BlueFieldModeDPU}},
+				"0000:d8:00.1": {PciAddress: "0000:d8:00.1", Mellanox: &sriovnetworkv1.MellanoxConfig{BlueFieldMode: consts.BlueFieldModeNIC}},
+			}
+			_, err := GetRequestedBlueFieldMode("0000:d8:00.", mellanoxNicsSpec)
+			Expect(err).To(MatchError(ContainSubstring("conflicting BlueField modes")))
+		})
+	})
+
 	Context("getOtherPortPCIAddress", func() {
 		It("should return port 1 when given port 0", func() {
 			result := getOtherPortPCIAddress("0000:d8:00.0")
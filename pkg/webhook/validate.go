@@ -19,8 +19,11 @@ package webhook
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -35,6 +38,8 @@ import (
 	sriovnetworkv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/consts"
 	"github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vars"
+	intel "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vendors/intel"
+	mlx "github.com/k8snetworkplumbingwg/sriov-network-operator/pkg/vendors/mellanox"
 )
 
 const (
@@ -46,6 +51,11 @@ const (
 var (
 	nodesSelected     bool
 	interfaceSelected bool
+
+	mlxFwParamNameRegex = regexp.MustCompile(`^[A-Z0-9_]+$`)
+	// maximum length of the interface names of the kernel (IFNAMSIZ - 1)
+	maxInterfaceNameLength = 15
+	pciAddressRegex        = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`)
 )
 
 func validateSriovOperatorConfig(cr *sriovnetworkv1.SriovOperatorConfig, operation v1.Operation) (bool, []string, error) {
@@ -114,7 +124,7 @@ func validateSriovNetworkPoolConfig(cr *sriovnetworkv1.SriovNetworkPoolConfig, o
 	log.Log.V(2).Info("validateSriovNetworkPoolConfig", "object", cr)
 	var warnings []string
 
-	if (cr.Spec.MaxUnavailable != nil || cr.Spec.NodeSelector != nil) && cr.Spec.OvsHardwareOffloadConfig.Name != "" {
+	if (cr.Spec.MaxUnavailable != nil || cr.Spec.NodeSelector != nil || len(cr.Spec.MaintenanceWindows) > 0 || cr.Spec.Rollout != nil) && cr.Spec.OvsHardwareOffloadConfig.Name != "" {
 		return false, warnings, fmt.Errorf("SriovOperatorConfig can't have both parallel configuration and OvsHardwareOffloadConfig")
 	}
 
@@ -125,6 +135,49 @@ func validateSriovNetworkPoolConfig(cr *sriovnetworkv1.SriovNetworkPoolConfig, o
 		}
 	}
 
+	for i := range cr.Spec.MaintenanceWindows {
+		if err := cr.Spec.MaintenanceWindows[i].Validate(); err != nil {
+			return false, warnings, fmt.Errorf("SriovNetworkPoolConfig invalid maintenanceWindows: %v", err)
+		}
+	}
+
+	if cr.Spec.Rollout != nil {
+		if cr.Spec.Rollout.CanaryNodes < 1 {
+			return false, warnings, fmt.Errorf("SriovNetworkPoolConfig invalid rollout: canaryNodes must be at least 1")
+		}
+		if cr.Spec.Rollout.SoakPeriod.Duration < 0 {
+			return false, warnings, fmt.Errorf("SriovNetworkPoolConfig invalid rollout: soakPeriod can't be negative")
+		}
+	}
+
+	return true, warnings, nil
+}
+
+func validateSriovNetworkNodeOverride(cr *sriovnetworkv1.SriovNetworkNodeOverride, operation v1.Operation) (bool, []string, error) {
+	log.Log.V(2).Info("validateSriovNetworkNodeOverride", "object", cr)
+	var warnings []string
+
+	if cr.GetNamespace() != vars.Namespace {
+		warnings = append(warnings, fmt.Sprintf("SriovNetworkNodeOverride is only applied in the %s namespace", vars.Namespace))
+	}
+
+	selected := map[string]bool{}
+	for _, iface := range cr.Spec.Interfaces {
+		if iface.PciAddress == "" && iface.Name == "" {
+			return false, warnings, fmt.Errorf("SriovNetworkNodeOverride interfaces must have a pciAddress or a name")
+		}
+		if iface.PciAddress != "" && iface.Name != "" {
+			return false, warnings, fmt.Errorf("SriovNetworkNodeOverride interface %s can't have both a pciAddress and a name", iface.String())
+		}
+		if selected[iface.String()] {
+			return false, warnings, fmt.Errorf("SriovNetworkNodeOverride interface %s is overridden more than once", iface.String())
+		}
+		selected[iface.String()] = true
+		if iface.Exclude && (iface.NumVfs != nil || iface.Mtu != nil || iface.LinkType != "") {
+			return false, warnings, fmt.Errorf("SriovNetworkNodeOverride interface %s is excluded and can't override other fields", iface.String())
+		}
+	}
+
 	return true, warnings, nil
 }
 
@@ -151,7 +204,8 @@ func validateSriovNetworkNodePolicy(cr *sriovnetworkv1.SriovNetworkNodePolicy, o
 		return admit, warnings, err
 	}
 
-	admit, err = dynamicValidateSriovNetworkNodePolicy(cr)
+	admit, conflictWarnings, err := dynamicValidateSriovNetworkNodePolicy(cr)
+	warnings = append(warnings, conflictWarnings...)
 	if err != nil {
 		return admit, warnings, err
 	}
@@ -165,8 +219,16 @@ func staticValidateSriovNetworkNodePolicy(cr *sriovnetworkv1.SriovNetworkNodePol
 		return false, fmt.Errorf("resource name \"%s\" contains invalid characters, the accepted syntax of the regular expressions is: \"^[a-zA-Z0-9_]+$\"", cr.Spec.ResourceName)
 	}
 
-	if cr.Spec.NicSelector.Vendor == "" && cr.Spec.NicSelector.DeviceID == "" && len(cr.Spec.NicSelector.PfNames) == 0 && len(cr.Spec.NicSelector.RootDevices) == 0 && cr.Spec.NicSelector.NetFilter == "" {
-		return false, fmt.Errorf("at least one of these parameters (vendor, deviceID, pfNames, rootDevices or netFilter) has to be defined in nicSelector in CR %s", cr.GetName())
+	if cr.Spec.NicSelector.IsEmpty() {
+		return false, fmt.Errorf("at least one of these parameters (vendor, deviceID, pfNames, rootDevices, netFilter, drivers, "+
+			"minLinkSpeed, numaNodes, firmwareVersions, physicalSlots or pciLabels) has to be defined in nicSelector in CR %s", cr.GetName())
+	}
+	if err := validateNicSelectorPatterns(&cr.Spec.NicSelector); err != nil {
+		return false, err
+	}
+
+	if err := sriovnetworkv1.ValidateNodeAffinity(cr.Spec.NodeAffinity); err != nil {
+		return false, fmt.Errorf("invalid nodeAffinity in CR %s: %v", cr.GetName(), err)
 	}
 
 	devMode := false
@@ -241,6 +303,28 @@ func staticValidateSriovNetworkNodePolicy(cr *sriovnetworkv1.SriovNetworkNodePol
 	if (cr.Spec.VdpaType == consts.VdpaTypeVirtio || cr.Spec.VdpaType == consts.VdpaTypeVhost) && cr.Spec.EswitchMode != sriovnetworkv1.ESwithModeSwitchDev {
 		return false, fmt.Errorf("vdpa requires the device to be configured in switchdev mode")
 	}
+	if err := validateVfAttributes(cr); err != nil {
+		return false, err
+	}
+	if err := validateIntelConfig(cr); err != nil {
+		return false, err
+	}
+	if err := validateMellanoxConfig(cr); err != nil {
+		return false, err
+	}
+	// devlink params: the device can't be externally managed
+	if len(cr.Spec.DevlinkParams) > 0 && cr.Spec.ExternallyManaged {
+		return false, fmt.Errorf("'devlinkParams' can't be used when the device is externally managed")
+	}
+	// ethtool settings: the PF can't be externally managed, the VFs must use a kernel driver
+	if cr.Spec.Ethtool != nil {
+		if cr.Spec.Ethtool.PF != nil && cr.Spec.ExternallyManaged {
+			return false, fmt.Errorf("'ethtool.pf' can't be used when the device is externally managed")
+		}
+		if cr.Spec.Ethtool.VFs != nil && cr.Spec.DeviceType == consts.DeviceTypeVfioPci {
+			return false, fmt.Errorf("'ethtool.vfs' can't be used with the %s device type", consts.DeviceTypeVfioPci)
+		}
+	}
 	// software bridge management: device must be configured in switchdev mode
 	if !cr.Spec.Bridge.IsEmpty() && cr.Spec.EswitchMode != sriovnetworkv1.ESwithModeSwitchDev {
 		return false, fmt.Errorf("software bridge management requires the device to be configured in switchdev mode")
@@ -249,10 +333,30 @@ func staticValidateSriovNetworkNodePolicy(cr *sriovnetworkv1.SriovNetworkNodePol
 	if !cr.Spec.Bridge.IsEmpty() && cr.Spec.ExternallyManaged {
 		return false, fmt.Errorf("software bridge management can't be used when the device externally managed")
 	}
+	if !cr.Spec.Bridge.IsEmpty() && cr.Spec.Bridge.OVS.Bond != nil {
+		if err := validateOVSBond(cr.Spec.Bridge.OVS.Bond, &cr.Spec.NicSelector); err != nil {
+			return false, fmt.Errorf("invalid 'bridge.ovs.bond' in CR %s: %v", cr.GetName(), err)
+		}
+	}
+	if !cr.Spec.Bridge.IsEmpty() {
+		if err := validateOVSPorts(cr.Spec.Bridge.OVS.Ports); err != nil {
+			return false, fmt.Errorf("invalid 'bridge.ovs.ports' in CR %s: %v", cr.GetName(), err)
+		}
+		// additional ports need a single bridge on the node, port names must be unique
+		if len(cr.Spec.Bridge.OVS.Ports) > 0 && cr.Spec.Bridge.OVS.Bond == nil && selectsMultiplePfs(&cr.Spec.NicSelector) {
+			return false, fmt.Errorf("'bridge.ovs.ports' in CR %s can't be used with a bridge for each of the PFs listed in 'nicSelector', 'bridge.ovs.bond' must be configured",
+				cr.GetName())
+		}
+		if err := validateOVSQoSAndFlows(&cr.Spec.Bridge.OVS.Bridge); err != nil {
+			return false, fmt.Errorf("invalid 'bridge.ovs.bridge' in CR %s: %v", cr.GetName(), err)
+		}
+	}
 	return true, nil
 }
 
-func dynamicValidateSriovNetworkNodePolicy(cr *sriovnetworkv1.SriovNetworkNodePolicy) (bool, error) {
+// dynamicValidateSriovNetworkNodePolicy validates the policy against the nodes, their states and the other policies,
+// the conflicts with the other policies are returned as warnings
+func dynamicValidateSriovNetworkNodePolicy(cr *sriovnetworkv1.SriovNetworkNodePolicy) (bool, []string, error) {
 	nodesSelected = false
 	interfaceSelected = false
 	nodeInterfaceErrorList := make(map[string][]string)
@@ -261,30 +365,36 @@ func dynamicValidateSriovNetworkNodePolicy(cr *sriovnetworkv1.SriovNetworkNodePo
 		LabelSelector: labels.Set(cr.Spec.NodeSelector).String(),
 	})
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 	nsList := &sriovnetworkv1.SriovNetworkNodeStateList{}
 	err = client.List(context.Background(), nsList, &runtimeclient.ListOptions{Namespace: namespace})
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
 	npList := &sriovnetworkv1.SriovNetworkNodePolicyList{}
 	err = client.List(context.Background(), npList, &runtimeclient.ListOptions{Namespace: namespace})
 	if err != nil {
-		return false, err
+		return false, nil, err
 	}
+	var conflicts []sriovnetworkv1.PolicyConflict
 	for _, node := range nodeList.Items {
 		if cr.Selected(&node) {
 			nodesSelected = true
 			err = validatePolicyForNodeStateAndPolicy(nsList, npList, &node, cr, nodeInterfaceErrorList)
 			if err != nil {
-				return false, err
+				return false, nil, err
+			}
+			for i := range nsList.Items {
+				if nsList.Items[i].GetName() == node.GetName() {
+					conflicts = sriovnetworkv1.MergePolicyConflicts(conflicts, cr.GetConflicts(npList.Items, &node, &nsList.Items[i])...)
+				}
 			}
 		}
 	}
 
 	if !nodesSelected {
-		return false, fmt.Errorf("no matched node is selected by the nodeSelector in CR %s", cr.GetName())
+		return false, nil, fmt.Errorf("no matched node is selected by the nodeSelector in CR %s", cr.GetName())
 	}
 	if !interfaceSelected {
 		for nodeName, messages := range nodeInterfaceErrorList {
@@ -292,10 +402,215 @@ func dynamicValidateSriovNetworkNodePolicy(cr *sriovnetworkv1.SriovNetworkNodePo
 				log.Log.V(2).Info("interface selection errors", "nodeName", nodeName, "message", message)
 			}
 		}
-		return false, fmt.Errorf("no supported NIC is selected by the nicSelector in CR %s", cr.GetName())
+		return false, nil, fmt.Errorf("no supported NIC is selected by the nicSelector in CR %s", cr.GetName())
 	}
 
-	return true, nil
+	return true, policyConflictWarnings(cr, conflicts), nil
+}
+
+// policyConflictWarnings returns the admission warnings reporting the conflicts of the policy with the other policies
+func policyConflictWarnings(cr *sriovnetworkv1.SriovNetworkNodePolicy, conflicts []sriovnetworkv1.PolicyConflict) []string {
+	warnings := []string{}
+	for _, c := range conflicts {
+		winner := cr.GetName()
+		if c.Overridden {
+			winner = c.Policy
+		}
+		warnings = append(warnings, fmt.Sprintf("SriovNetworkNodePolicy %s conflicts with policy %s on %d node(s): %s, the settings of policy %s take precedence",
+			cr.GetName(), c.Policy, len(c.Nodes), c.Message, winner))
+	}
+	return warnings
+}
+
+// validateNicSelectorPatterns checks the shell patterns of the nicSelector are valid
+func validateNicSelectorPatterns(selector *sriovnetworkv1.SriovNetworkNicSelector) error {
+	fields := []struct {
+		name     string
+		patterns []string
+	}{
+		{"firmwareVersions", selector.FirmwareVersions},
+		{"physicalSlots", selector.PhysicalSlots},
+		{"pciLabels", selector.PciLabels},
+	}
+	for _, field := range fields {
+		for _, pattern := range field.patterns {
+			if _, err := filepath.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid pattern %q in 'nicSelector.%s': %v", pattern, field.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateOVSBond checks the bond settings of the managed OVS bridge
+func validateOVSBond(bond *sriovnetworkv1.OVSBondConfig, selector *sriovnetworkv1.SriovNetworkNicSelector) error {
+	if bond.Mode == "balance-tcp" && bond.LACP != "active" && bond.LACP != "passive" {
+		return fmt.Errorf("bond mode balance-tcp requires lacp to be active or passive")
+	}
+	for i, member := range bond.Members {
+		if !pciAddressRegex.MatchString(member) {
+			return fmt.Errorf("member %q is not a valid PCI address", member)
+		}
+		if slices.Contains(bond.Members[:i], member) {
+			return fmt.Errorf("member %s is listed more than once", member)
+		}
+		if len(selector.RootDevices) > 0 && !sriovnetworkv1.StringInArray(member, selector.RootDevices) {
+			return fmt.Errorf("member %s is not in 'nicSelector.rootDevices'", member)
+		}
+	}
+	return nil
+}
+
+// selectsMultiplePfs returns true if the nicSelector lists more than one PF by PCI address or by name
+func selectsMultiplePfs(selector *sriovnetworkv1.SriovNetworkNicSelector) bool {
+	if len(selector.RootDevices) > 1 {
+		return true
+	}
+	pfNames := []string{}
+	for _, pfName := range selector.PfNames {
+		pfNames = sriovnetworkv1.UniqueAppend(pfNames, strings.Split(pfName, "#")[0])
+	}
+	return len(pfNames) > 1
+}
+
+// validateOVSPorts checks the additional ports of the managed OVS bridge
+func validateOVSPorts(ports []sriovnetworkv1.OVSPortConfig) error {
+	for i, port := range ports {
+		if port.Name == "" || len(port.Name) > maxInterfaceNameLength {
+			return fmt.Errorf("port name %q must contain 1 to %d characters", port.Name, maxInterfaceNameLength)
+		}
+		if slices.ContainsFunc(ports[:i], func(p sriovnetworkv1.OVSPortConfig) bool { return p.Name == port.Name }) {
+			return fmt.Errorf("port %s is listed more than once", port.Name)
+		}
+		switch port.Type {
+		case consts.OVSPortTypePatch:
+			if port.Peer == "" {
+				return fmt.Errorf("patch port %s requires a peer", port.Name)
+			}
+			if len(port.IPAddresses) > 0 || port.MTURequest != nil {
+				return fmt.Errorf("ipAddresses and mtuRequest can't be used with patch port %s", port.Name)
+			}
+		case consts.OVSPortTypeInternal:
+			if port.Peer != "" {
+				return fmt.Errorf("peer can be used only with patch ports, port %s is internal", port.Name)
+			}
+			for _, ipAddr := range port.IPAddresses {
+				if _, _, err := net.ParseCIDR(ipAddr); err != nil {
+					return fmt.Errorf("address %q of port %s is not in CIDR notation", ipAddr, port.Name)
+				}
+			}
+		default:
+			return fmt.Errorf("unsupported type %q of port %s, supported types are %s and %s",
+				port.Type, port.Name, consts.OVSPortTypePatch, consts.OVSPortTypeInternal)
+		}
+	}
+	return nil
+}
+
+// validateOVSQoSAndFlows checks the QoS and the static flows of the managed OVS bridge
+func validateOVSQoSAndFlows(br *sriovnetworkv1.OVSBridgeConfig) error {
+	if br.QoS != nil {
+		if len(br.QoS.Queues) > 0 && br.QoS.Type != "linux-htb" {
+			return fmt.Errorf("queues are supported only for QoS type linux-htb, got %s", br.QoS.Type)
+		}
+		for i, queue := range br.QoS.Queues {
+			if slices.ContainsFunc(br.QoS.Queues[:i], func(q sriovnetworkv1.OVSQueueConfig) bool { return q.ID == queue.ID }) {
+				return fmt.Errorf("queue %d is listed more than once", queue.ID)
+			}
+		}
+	}
+	for _, flow := range br.Flows {
+		if strings.TrimSpace(flow) == "" {
+			return fmt.Errorf("flows can't be empty")
+		}
+		if strings.Contains(flow, "cookie=") {
+			return fmt.Errorf("flow %q must not set the cookie, the operator marks the flows with its own cookie", flow)
+		}
+	}
+	return nil
+}
+
+// validateVfAttributes checks the administrative VF attributes of the policy
+func validateVfAttributes(cr *sriovnetworkv1.SriovNetworkNodePolicy) error {
+	attrs := cr.Spec.VfAttributes
+	if attrs == nil {
+		return nil
+	}
+	if attrs.VlanQoS != nil && attrs.Vlan == nil {
+		return fmt.Errorf("'vfAttributes.vlanQoS' requires 'vfAttributes.vlan' to be set")
+	}
+	if attrs.MinTxRate != nil && attrs.MaxTxRate != nil && *attrs.MaxTxRate != 0 && *attrs.MinTxRate > *attrs.MaxTxRate {
+		return fmt.Errorf("'vfAttributes.minTxRate' %d can't be greater than 'vfAttributes.maxTxRate' %d", *attrs.MinTxRate, *attrs.MaxTxRate)
+	}
+	if strings.EqualFold(cr.Spec.LinkType, consts.LinkTypeIB) && (attrs.Vlan != nil || attrs.SpoofChk != nil) {
+		return fmt.Errorf("'vfAttributes.vlan' and 'vfAttributes.spoofChk' can be used only with ethernet links")
+	}
+	if cr.Spec.EswitchMode == sriovnetworkv1.ESwithModeSwitchDev {
+		return fmt.Errorf("'vfAttributes' can't be used with 'eSwitchMode: switchdev', the VFs are configured through the representors")
+	}
+	return nil
+}
+
+// validateIntelConfig checks the Intel specific settings of the policy
+func validateIntelConfig(cr *sriovnetworkv1.SriovNetworkNodePolicy) error {
+	intelConfig := cr.Spec.Intel
+	if intelConfig == nil {
+		return nil
+	}
+	if cr.Spec.NicSelector.Vendor != "" && cr.Spec.NicSelector.Vendor != IntelID {
+		return fmt.Errorf("'intel' can be used only with Intel NICs, the nicSelector selects vendor %s", cr.Spec.NicSelector.Vendor)
+	}
+	if cr.Spec.ExternallyManaged {
+		return fmt.Errorf("'intel' can't be used when the device is externally managed")
+	}
+	if intelConfig.EnableRoce != nil && intelConfig.EnableIwarp != nil && *intelConfig.EnableRoce && *intelConfig.EnableIwarp {
+		return fmt.Errorf("'intel.enableRoce' and 'intel.enableIwarp' can't be enabled at the same time")
+	}
+	// the same devlink parameters can't be managed from both fields
+	for _, param := range []struct {
+		name  string
+		field string
+		set   bool
+	}{
+		{intel.DevlinkParamEnableRoce, "enableRoce", intelConfig.EnableRoce != nil},
+		{intel.DevlinkParamEnableIwarp, "enableIwarp", intelConfig.EnableIwarp != nil},
+		{intel.DevlinkParamTxSchedulingLayers, "txSchedulingLayers", intelConfig.TxSchedulingLayers != nil},
+		{intel.DevlinkParamMsixVecPerPfMax, "msixVecPerPfMax", intelConfig.MsixVecPerPfMax != nil},
+	} {
+		if param.set && slices.ContainsFunc(cr.Spec.DevlinkParams, func(p sriovnetworkv1.DevlinkParam) bool { return p.Name == param.name }) {
+			return fmt.Errorf("devlink parameter %s is managed by 'intel.%s' and can't be set in 'devlinkParams'", param.name, param.field)
+		}
+	}
+	return nil
+}
+
+// validateMellanoxConfig checks the Mellanox specific settings of the policy
+func validateMellanoxConfig(cr *sriovnetworkv1.SriovNetworkNodePolicy) error {
+	mellanox := cr.Spec.Mellanox
+	if mellanox == nil {
+		return nil
+	}
+	if cr.Spec.NicSelector.Vendor != "" && cr.Spec.NicSelector.Vendor != MellanoxID {
+		return fmt.Errorf("'mellanox' can be used only with Mellanox NICs, the nicSelector selects vendor %s", cr.Spec.NicSelector.Vendor)
+	}
+	if cr.Spec.ExternallyManaged {
+		return fmt.Errorf("'mellanox' can't be used when the device is externally managed")
+	}
+	for name, value := range mellanox.FirmwareParams {
+		if !mlxFwParamNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid firmware parameter name %q in 'mellanox.firmwareParams'", name)
+		}
+		if slices.Contains(mlx.FwParamsManagedByPolicy, name) {
+			return fmt.Errorf("firmware parameter %s is managed from the other fields of the policy and can't be set in 'mellanox.firmwareParams'", name)
+		}
+		if value == "" {
+			return fmt.Errorf("empty value for the firmware parameter %s in 'mellanox.firmwareParams'", name)
+		}
+		if mellanox.BlueFieldMode != "" && strings.HasPrefix(name, "INTERNAL_CPU_") {
+			return fmt.Errorf("firmware parameter %s is managed from 'mellanox.blueFieldMode' and can't be set in 'mellanox.firmwareParams'", name)
+		}
+	}
+	return nil
 }
 
 func validatePolicyForNodeStateAndPolicy(nsList *sriovnetworkv1.SriovNetworkNodeStateList, npList *sriovnetworkv1.SriovNetworkNodePolicyList, node *corev1.Node, cr *sriovnetworkv1.SriovNetworkNodePolicy, nodeInterfaceErrorList map[string][]string) error {
@@ -327,12 +642,14 @@ func validatePolicyForNodeState(policy *sriovnetworkv1.SriovNetworkNodePolicy, s
 	log.Log.V(2).Info("validatePolicyForNodeState(): validate policy for node", "policy-name",
 		policy.GetName(), "node-name", state.GetName())
 	interfaceSelectedForNode := false
+	selectedCount := 0
 	var noInterfacesSelectedLog []string
 	for _, iface := range state.Status.Interfaces {
 		err := validateNicModel(&policy.Spec.NicSelector, &iface, node)
 		if err == nil {
 			interfaceSelected = true
 			interfaceSelectedForNode = true
+			selectedCount++
 			if policy.GetName() != consts.DefaultPolicyName && policy.Spec.NumVfs == 0 {
 				return nil, fmt.Errorf("numVfs(%d) in CR %s is not allowed", policy.Spec.NumVfs, policy.GetName())
 			}
@@ -342,6 +659,11 @@ func validatePolicyForNodeState(policy *sriovnetworkv1.SriovNetworkNodePolicy, s
 			if policy.Spec.NumVfs > MlxMaxVFs && iface.Vendor == MellanoxID {
 				return nil, fmt.Errorf("numVfs(%d) in CR %s exceed the maximum allowed value(%d) interface(%s)", policy.Spec.NumVfs, policy.GetName(), MlxMaxVFs, iface.Name)
 			}
+			// the MSI-X vectors of the Intel settings are set with the devlink parameters of the PF
+			if policy.Spec.Intel != nil && policy.Spec.Intel.MsixVecPerPfMax != nil && iface.Vendor != IntelID {
+				return nil, fmt.Errorf("'intel.msixVecPerPfMax' in CR %s can be used only with Intel NICs, interface(%s) has vendor %s",
+					policy.GetName(), iface.Name, iface.Vendor)
+			}
 
 			// Externally create validations
 			if policy.Spec.ExternallyManaged {
@@ -357,6 +679,13 @@ func validatePolicyForNodeState(policy *sriovnetworkv1.SriovNetworkNodePolicy, s
 					return nil, fmt.Errorf("LinkType(%s) in CR %s is not equal to the LinkType for the PF externally value(%s)", policy.Spec.LinkType, policy.GetName(), iface.LinkType)
 				}
 			}
+			// BlueField mode: only BlueField DPUs are supported, a DPU is switched to NIC mode
+			// only if its PF is reported in the status of the node
+			if policy.Spec.Mellanox != nil && policy.Spec.Mellanox.BlueFieldMode != "" &&
+				(iface.Vendor != MellanoxID || !mlx.IsBlueField(iface.DeviceID)) {
+				return nil, fmt.Errorf("BlueField mode %s in CR %s not supported by device %s (vendor %s, device ID %s) of interface(%s)",
+					policy.Spec.Mellanox.BlueFieldMode, policy.GetName(), iface.PciAddress, iface.Vendor, iface.DeviceID, iface.Name)
+			}
 			// vdpa: only mellanox cards are supported
 			if (policy.Spec.VdpaType == consts.VdpaTypeVirtio || policy.Spec.VdpaType == consts.VdpaTypeVhost) && iface.Vendor != MellanoxID {
 				return nil, fmt.Errorf("vendor(%s) in CR %s not supported for vdpa interface(%s)", iface.Vendor, policy.GetName(), iface.Name)
@@ -370,6 +699,11 @@ func validatePolicyForNodeState(policy *sriovnetworkv1.SriovNetworkNodePolicy, s
 	if !interfaceSelectedForNode {
 		return noInterfacesSelectedLog, nil
 	}
+	// additional ports need a single bridge on the node, port names must be unique
+	if ovs := policy.Spec.Bridge.OVS; ovs != nil && len(ovs.Ports) > 0 && ovs.Bond == nil && selectedCount > 1 {
+		return nil, fmt.Errorf("'bridge.ovs.ports' in CR %s can't be used with a bridge for each of the %d PFs selected on node %s, 'bridge.ovs.bond' must be configured",
+			policy.GetName(), selectedCount, state.GetName())
+	}
 	return nil, nil
 }
 
@@ -21,12 +21,14 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	. "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
@@ -51,6 +53,7 @@ func TestMain(m *testing.M) {
 		"15b3 101b 101c", // ConnectX-6
 		"15b3 101d 101e", // ConnectX-6 Dx
 		"15b3 a2d6 101e", // MT42822 BlueField-2 integrated ConnectX-6 Dx
+		"15b3 a2dc 101e", // MT43244 BlueField-3 integrated ConnectX-7 Dx
 		"14e4 16d7 16dc", // BCM57414 2x25G
 		"14e4 1750 1806", // BCM75508 2x100G
 	}
@@ -269,6 +272,140 @@ func TestValidateSriovNetworkPoolConfigWithParallelAndHWOffload(t *testing.T) {
 	g.Expect(ok).To(BeFalse())
 }
 
+func TestValidateSriovNetworkPoolConfigWithMaintenanceWindows(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	config := newDefaultNetworkPoolConfig()
+	config.Spec.MaintenanceWindows = []MaintenanceWindow{
+		{Schedule: "0 2 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/Paris"},
+	}
+	client = fake.NewClientBuilder().WithScheme(vars.Scheme).Build()
+
+	ok, _, err := validateSriovNetworkPoolConfig(config, "CREATE")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	config.Spec.MaintenanceWindows[0].Schedule = "0 2 * *"
+	ok, _, err = validateSriovNetworkPoolConfig(config, "UPDATE")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(ok).To(BeFalse())
+
+	config.Spec.MaintenanceWindows[0].Schedule = "0 2 * * 6"
+	config.Spec.MaintenanceWindows[0].Duration = metav1.Duration{}
+	ok, _, err = validateSriovNetworkPoolConfig(config, "UPDATE")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestValidateSriovNetworkPoolConfigWithRollout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	config := newDefaultNetworkPoolConfig()
+	config.Spec.Rollout = &RolloutConfig{CanaryNodes: 1, SoakPeriod: metav1.Duration{Duration: 30 * time.Minute}}
+	client = fake.NewClientBuilder().WithScheme(vars.Scheme).Build()
+
+	ok, _, err := validateSriovNetworkPoolConfig(config, "CREATE")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	config.Spec.Rollout.CanaryNodes = 0
+	ok, _, err = validateSriovNetworkPoolConfig(config, "UPDATE")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(ok).To(BeFalse())
+
+	config.Spec.Rollout.CanaryNodes = 1
+	config.Spec.Rollout.SoakPeriod = metav1.Duration{Duration: -time.Minute}
+	ok, _, err = validateSriovNetworkPoolConfig(config, "UPDATE")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(ok).To(BeFalse())
+
+	config = &SriovNetworkPoolConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "hwol"},
+		Spec: SriovNetworkPoolConfigSpec{
+			OvsHardwareOffloadConfig: OvsHardwareOffloadConfig{Name: "worker"},
+			Rollout:                  &RolloutConfig{CanaryNodes: 1},
+		},
+	}
+	ok, _, err = validateSriovNetworkPoolConfig(config, "CREATE")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestValidateSriovNetworkNodeOverride(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	override := &SriovNetworkNodeOverride{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Namespace: vars.Namespace},
+		Spec: SriovNetworkNodeOverrideSpec{
+			Interfaces: []InterfaceOverride{
+				{PciAddress: "0000:86:00.0", NumVfs: ptr.To(8), Mtu: ptr.To(1500)},
+				{Name: "ens803f1", Exclude: true},
+			},
+		},
+	}
+	ok, warnings, err := validateSriovNetworkNodeOverride(override, "CREATE")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+	g.Expect(warnings).To(BeEmpty())
+
+	override.Spec.Interfaces[1].Mtu = ptr.To(9000)
+	ok, _, err = validateSriovNetworkNodeOverride(override, "UPDATE")
+	g.Expect(err).To(MatchError("SriovNetworkNodeOverride interface ens803f1 is excluded and can't override other fields"))
+	g.Expect(ok).To(BeFalse())
+
+	override.Spec.Interfaces[1] = InterfaceOverride{PciAddress: "0000:86:00.0", Mtu: ptr.To(9000)}
+	ok, _, err = validateSriovNetworkNodeOverride(override, "UPDATE")
+	g.Expect(err).To(MatchError("SriovNetworkNodeOverride interface 0000:86:00.0 is overridden more than once"))
+	g.Expect(ok).To(BeFalse())
+
+	override.Spec.Interfaces[1] = InterfaceOverride{Mtu: ptr.To(9000)}
+	ok, _, err = validateSriovNetworkNodeOverride(override, "UPDATE")
+	g.Expect(err).To(MatchError("SriovNetworkNodeOverride interfaces must have a pciAddress or a name"))
+	g.Expect(ok).To(BeFalse())
+
+	override.Spec.Interfaces[1] = InterfaceOverride{PciAddress: "0000:86:00.1", Name: "ens803f1", Mtu: ptr.To(9000)}
+	ok, _, err = validateSriovNetworkNodeOverride(override, "UPDATE")
+	g.Expect(err).To(MatchError("SriovNetworkNodeOverride interface 0000:86:00.1 can't have both a pciAddress and a name"))
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestPolicyConflictWarnings(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Labels: map[string]string{"feature.node.kubernetes.io/network-sriov.capable": "true"}}}
+	state := newNodeState()
+	newPolicy := func(name string, priority int, pfName string, mtu int) SriovNetworkNodePolicy {
+		return SriovNetworkNodePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: vars.Namespace},
+			Spec: SriovNetworkNodePolicySpec{
+				NicSelector:  SriovNetworkNicSelector{PfNames: []string{pfName}},
+				NodeSelector: map[string]string{"feature.node.kubernetes.io/network-sriov.capable": "true"},
+				NumVfs:       8,
+				Mtu:          mtu,
+				Priority:     priority,
+				ResourceName: name,
+			},
+		}
+	}
+	policy := newPolicy("p1", 10, "ens803f0#0-3", 9000)
+	policies := []SriovNetworkNodePolicy{
+		newPolicy("p1", 10, "ens803f0#0-1", 1500),
+		newPolicy("p2", 20, "ens803f0#4-7", 1500),
+		newPolicy("p3", 5, "ens803f0#2-5", 0),
+	}
+
+	warnings := policyConflictWarnings(&policy, policy.GetConflicts(policies, node, state))
+	g.Expect(warnings).To(Equal([]string{
+		"SriovNetworkNodePolicy p1 conflicts with policy p2 on 1 node(s): mtu 9000 of PF ens803f0 conflicts with mtu 1500 of policy p2, " +
+			"the settings of policy p1 take precedence",
+		"SriovNetworkNodePolicy p1 conflicts with policy p3 on 1 node(s): VF range 0-3 of PF ens803f0 overlaps VF range 2-5 of policy p3, " +
+			"the settings of policy p3 take precedence",
+	}))
+
+	policy.Spec.NicSelector.PfNames = []string{"ens803f1"}
+	g.Expect(policyConflictWarnings(&policy, policy.GetConflicts(policies, node, state))).To(BeEmpty())
+}
+
 func TestValidateSriovNetworkNodePolicyWithDefaultPolicy(t *testing.T) {
 	var err error
 	var ok bool
@@ -886,6 +1023,291 @@ func TestStaticValidateSriovNetworkNodePolicyWithConflictIsRdmaAndDeviceType(t *
 	g.Expect(ok).To(Equal(false))
 }
 
+func TestStaticValidateSriovNetworkNodePolicyWithVfAttributes(t *testing.T) {
+	newPolicy := func(attrs *VfAttributes) *SriovNetworkNodePolicy {
+		return &SriovNetworkNodePolicy{
+			Spec: SriovNetworkNodePolicySpec{
+				DeviceType: constants.DeviceTypeVfioPci,
+				NicSelector: SriovNetworkNicSelector{
+					Vendor:   "8086",
+					DeviceID: "158b",
+				},
+				NodeSelector: map[string]string{
+					"feature.node.kubernetes.io/network-sriov.capable": "true",
+				},
+				NumVfs:       1,
+				ResourceName: "p0",
+				VfAttributes: attrs,
+			},
+		}
+	}
+	g := NewGomegaWithT(t)
+
+	ok, err := staticValidateSriovNetworkNodePolicy(newPolicy(&VfAttributes{Vlan: ptr.To(100), VlanQoS: ptr.To(2), Trust: ptr.To(true)}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy(&VfAttributes{VlanQoS: ptr.To(2)}))
+	g.Expect(err).To(MatchError(ContainSubstring("'vfAttributes.vlanQoS' requires 'vfAttributes.vlan'")))
+	g.Expect(ok).To(BeFalse())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy(&VfAttributes{MinTxRate: ptr.To(2000), MaxTxRate: ptr.To(1000)}))
+	g.Expect(err).To(MatchError(ContainSubstring("can't be greater than")))
+	g.Expect(ok).To(BeFalse())
+
+	policy := newPolicy(&VfAttributes{Vlan: ptr.To(100)})
+	policy.Spec.LinkType = "ib"
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("can be used only with ethernet links")))
+	g.Expect(ok).To(BeFalse())
+
+	policy = newPolicy(&VfAttributes{Trust: ptr.To(true)})
+	policy.Spec.DeviceType = constants.DeviceTypeNetDevice
+	policy.Spec.EswitchMode = ESwithModeSwitchDev
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("can't be used with 'eSwitchMode: switchdev'")))
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestStaticValidateSriovNetworkNodePolicyWithIntelConfig(t *testing.T) {
+	newPolicy := func(vendor string, intel *IntelConfig) *SriovNetworkNodePolicy {
+		return &SriovNetworkNodePolicy{
+			Spec: SriovNetworkNodePolicySpec{
+				DeviceType: constants.DeviceTypeNetDevice,
+				NicSelector: SriovNetworkNicSelector{
+					Vendor: vendor,
+				},
+				NodeSelector: map[string]string{
+					"feature.node.kubernetes.io/network-sriov.capable": "true",
+				},
+				NumVfs:       1,
+				ResourceName: "p0",
+				Intel:        intel,
+			},
+		}
+	}
+	g := NewGomegaWithT(t)
+
+	ok, err := staticValidateSriovNetworkNodePolicy(newPolicy("8086", &IntelConfig{DDPPackage: "ice_comms-1.3.45.0.pkg", FwLldp: ptr.To(false), EnableRoce: ptr.To(true)}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy("15b3", &IntelConfig{FwLldp: ptr.To(false)}))
+	g.Expect(err).To(MatchError(ContainSubstring("'intel' can be used only with Intel NICs")))
+	g.Expect(ok).To(BeFalse())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy("8086", &IntelConfig{EnableRoce: ptr.To(true), EnableIwarp: ptr.To(true)}))
+	g.Expect(err).To(MatchError(ContainSubstring("can't be enabled at the same time")))
+	g.Expect(ok).To(BeFalse())
+
+	policy := newPolicy("8086", &IntelConfig{MsixVecPerPfMax: ptr.To(128)})
+	policy.Spec.DevlinkParams = []DevlinkParam{{Name: "msix_vec_per_pf_max", Value: "64", Cmode: "driverinit"}}
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("is managed by 'intel.msixVecPerPfMax'")))
+	g.Expect(ok).To(BeFalse())
+
+	policy = newPolicy("8086", &IntelConfig{EnableRoce: ptr.To(true)})
+	policy.Spec.DevlinkParams = []DevlinkParam{{Name: "msix_vec_per_pf_max", Value: "64", Cmode: "driverinit"}}
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+}
+
+func TestStaticValidateSriovNetworkNodePolicyWithMellanoxConfig(t *testing.T) {
+	newPolicy := func(vendor string, params map[string]string) *SriovNetworkNodePolicy {
+		return &SriovNetworkNodePolicy{
+			Spec: SriovNetworkNodePolicySpec{
+				DeviceType: constants.DeviceTypeNetDevice,
+				NicSelector: SriovNetworkNicSelector{
+					Vendor: vendor,
+				},
+				NodeSelector: map[string]string{
+					"feature.node.kubernetes.io/network-sriov.capable": "true",
+				},
+				NumVfs:       1,
+				ResourceName: "p0",
+				Mellanox:     &MellanoxConfig{FirmwareParams: params},
+			},
+		}
+	}
+	g := NewGomegaWithT(t)
+
+	ok, err := staticValidateSriovNetworkNodePolicy(newPolicy("15b3", map[string]string{"ATS_ENABLED": "True", "MAX_ACC_OUT_READ": "32"}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy("8086", map[string]string{"ATS_ENABLED": "True"}))
+	g.Expect(err).To(MatchError(ContainSubstring("'mellanox' can be used only with Mellanox NICs")))
+	g.Expect(ok).To(BeFalse())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy("15b3", map[string]string{"NUM_OF_VFS": "16"}))
+	g.Expect(err).To(MatchError(ContainSubstring("firmware parameter NUM_OF_VFS is managed from the other fields of the policy")))
+	g.Expect(ok).To(BeFalse())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy("15b3", map[string]string{"ats_enabled": "True"}))
+	g.Expect(err).To(MatchError(ContainSubstring("invalid firmware parameter name")))
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestStaticValidateSriovNetworkNodePolicyWithBlueFieldModeAndInternalCPUFirmwareParams(t *testing.T) {
+	policy := &SriovNetworkNodePolicy{
+		Spec: SriovNetworkNodePolicySpec{
+			DeviceType: constants.DeviceTypeNetDevice,
+			NicSelector: SriovNetworkNicSelector{
+				Vendor: "15b3",
+			},
+			NodeSelector: map[string]string{
+				"feature.node.kubernetes.io/network-sriov.capable": "true",
+			},
+			NumVfs:       1,
+			ResourceName: "p0",
+			Mellanox: &MellanoxConfig{
+				BlueFieldMode:  constants.BlueFieldModeNIC,
+				FirmwareParams: map[string]string{"INTERNAL_CPU_MODEL": "EMBEDDED_CPU"},
+			},
+		},
+	}
+	g := NewGomegaWithT(t)
+	ok, err := staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("firmware parameter INTERNAL_CPU_MODEL is managed from 'mellanox.blueFieldMode'")))
+	g.Expect(ok).To(BeFalse())
+
+	policy.Spec.Mellanox.FirmwareParams = nil
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+}
+
+func TestValidatePolicyForNodeStateWithBlueFieldMode(t *testing.T) {
+	state := &SriovNetworkNodeState{
+		Status: SriovNetworkNodeStateStatus{
+			Interfaces: []InterfaceExt{
+				{
+					DeviceID:   "a2d6",
+					Driver:     "mlx5_core",
+					Name:       "p0",
+					PciAddress: "0000:03:00.0",
+					Vendor:     "15b3",
+					TotalVfs:   64,
+				},
+				{
+					DeviceID:   "101d",
+					Driver:     "mlx5_core",
+					Name:       "ens1f0",
+					PciAddress: "0000:d8:00.0",
+					Vendor:     "15b3",
+					TotalVfs:   64,
+				},
+			},
+		},
+	}
+	policy := &SriovNetworkNodePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "p1",
+		},
+		Spec: SriovNetworkNodePolicySpec{
+			DeviceType: constants.DeviceTypeNetDevice,
+			NicSelector: SriovNetworkNicSelector{
+				PfNames: []string{"p0"},
+				Vendor:  "15b3",
+			},
+			NodeSelector: map[string]string{
+				"feature.node.kubernetes.io/network-sriov.capable": "true",
+			},
+			NumVfs:       4,
+			ResourceName: "p0",
+			Mellanox:     &MellanoxConfig{BlueFieldMode: constants.BlueFieldModeNIC},
+		},
+	}
+	g := NewGomegaWithT(t)
+	_, err := validatePolicyForNodeState(policy, state, NewNode())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	policy.Spec.NicSelector.PfNames = []string{"ens1f0"}
+	_, err = validatePolicyForNodeState(policy, state, NewNode())
+	g.Expect(err).To(MatchError("BlueField mode nic in CR p1 not supported by device 0000:d8:00.0 (vendor 15b3, device ID 101d) of interface(ens1f0)"))
+
+	// a BlueField-3 in DPU mode is switched to NIC mode only if its PF is reported in the status
+	policy.Spec.NicSelector.PfNames = []string{"p1"}
+	interfaces, err := validatePolicyForNodeState(policy, state, NewNode())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(interfaces).To(HaveLen(2))
+
+	state.Status.Interfaces = append(state.Status.Interfaces, InterfaceExt{
+		DeviceID:   "a2dc",
+		Driver:     "mlx5_core",
+		Name:       "p1",
+		PciAddress: "0000:04:00.0",
+		Vendor:     "15b3",
+		TotalVfs:   64,
+	})
+	interfaces, err = validatePolicyForNodeState(policy, state, NewNode())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(interfaces).To(BeEmpty())
+}
+
+func TestStaticValidateSriovNetworkNodePolicyWithDevlinkParamsAndExternallyManaged(t *testing.T) {
+	policy := &SriovNetworkNodePolicy{
+		Spec: SriovNetworkNodePolicySpec{
+			DeviceType: constants.DeviceTypeNetDevice,
+			NicSelector: SriovNetworkNicSelector{
+				Vendor: "15b3",
+			},
+			NodeSelector: map[string]string{
+				"feature.node.kubernetes.io/network-sriov.capable": "true",
+			},
+			NumVfs:            1,
+			ResourceName:      "p0",
+			ExternallyManaged: true,
+			DevlinkParams:     []DevlinkParam{{Name: "max_macs", Value: "32", Cmode: constants.DevlinkParamCmodeDriverinit}},
+		},
+	}
+	g := NewGomegaWithT(t)
+	ok, err := staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("'devlinkParams' can't be used when the device is externally managed")))
+	g.Expect(ok).To(BeFalse())
+
+	policy.Spec.ExternallyManaged = false
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+}
+
+func TestStaticValidateSriovNetworkNodePolicyWithEthtool(t *testing.T) {
+	policy := &SriovNetworkNodePolicy{
+		Spec: SriovNetworkNodePolicySpec{
+			DeviceType: constants.DeviceTypeNetDevice,
+			NicSelector: SriovNetworkNicSelector{
+				Vendor: "15b3",
+			},
+			NodeSelector: map[string]string{
+				"feature.node.kubernetes.io/network-sriov.capable": "true",
+			},
+			NumVfs:            1,
+			ResourceName:      "p0",
+			ExternallyManaged: true,
+			Ethtool: &EthtoolConfig{
+				PF:  &EthtoolSettings{Channels: &EthtoolChannels{Combined: ptr.To(8)}},
+				VFs: &EthtoolSettings{Features: map[string]bool{"rx-vlan-filter": false}},
+			},
+		},
+	}
+	g := NewGomegaWithT(t)
+	ok, err := staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("'ethtool.pf' can't be used when the device is externally managed")))
+	g.Expect(ok).To(BeFalse())
+
+	policy.Spec.ExternallyManaged = false
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	policy.Spec.DeviceType = constants.DeviceTypeVfioPci
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("'ethtool.vfs' can't be used with the vfio-pci device type")))
+	g.Expect(ok).To(BeFalse())
+}
+
 func TestStaticValidateSriovNetworkNodePolicyWithConflictDeviceTypeAndVirtioVdpaType(t *testing.T) {
 	policy := &SriovNetworkNodePolicy{
 		Spec: SriovNetworkNodePolicySpec{
@@ -1119,6 +1541,74 @@ func TestStaticValidateSriovNetworkNodePolicyWithInvalidNicSelector(t *testing.T
 	g.Expect(ok).To(Equal(false))
 }
 
+func TestStaticValidateSriovNetworkNodePolicyWithPfStatusNicSelector(t *testing.T) {
+	policy := &SriovNetworkNodePolicy{
+		Spec: SriovNetworkNodePolicySpec{
+			DeviceType: "netdevice",
+			NicSelector: SriovNetworkNicSelector{
+				Drivers:          []string{"ice"},
+				MinLinkSpeed:     25000,
+				FirmwareVersions: []string{"4.40*"},
+			},
+			NodeSelector: map[string]string{
+				"feature.node.kubernetes.io/network-sriov.capable": "true",
+			},
+			NumVfs:       8,
+			ResourceName: "p0",
+		},
+	}
+	g := NewGomegaWithT(t)
+	ok, err := staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	policy.Spec.NicSelector.PciLabels = []string{"NIC[1"}
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("invalid pattern \"NIC[1\" in 'nicSelector.pciLabels'")))
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestStaticValidateSriovNetworkNodePolicyWithNodeAffinity(t *testing.T) {
+	policy := &SriovNetworkNodePolicy{
+		Spec: SriovNetworkNodePolicySpec{
+			DeviceType: "netdevice",
+			NicSelector: SriovNetworkNicSelector{
+				Vendor: "8086",
+			},
+			NodeSelector: map[string]string{},
+			NodeAffinity: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "node-role.kubernetes.io/worker", Operator: corev1.NodeSelectorOpExists},
+					{Key: "example.com/no-sriov", Operator: corev1.NodeSelectorOpDoesNotExist},
+				},
+			}}},
+			NumVfs:       8,
+			ResourceName: "p0",
+		},
+	}
+	g := NewGomegaWithT(t)
+	ok, err := staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	policy.Spec.NodeAffinity.NodeSelectorTerms[0].MatchExpressions[0].Values = []string{"true"}
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("invalid nodeAffinity in CR")))
+	g.Expect(ok).To(BeFalse())
+
+	policy.Spec.NodeAffinity.NodeSelectorTerms[0] = corev1.NodeSelectorTerm{MatchFields: []corev1.NodeSelectorRequirement{
+		{Key: "metadata.labels", Operator: corev1.NodeSelectorOpIn, Values: []string{"worker-0"}},
+	}}
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("only metadata.name is supported")))
+	g.Expect(ok).To(BeFalse())
+
+	policy.Spec.NodeAffinity.NodeSelectorTerms = nil
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("at least one node selector term")))
+	g.Expect(ok).To(BeFalse())
+}
+
 func TestStaticValidateSriovNetworkNodePolicyWithInvalidLinkTypeForSwitchdev(t *testing.T) {
 	policy := &SriovNetworkNodePolicy{
 		Spec: SriovNetworkNodePolicySpec{
@@ -1346,3 +1836,220 @@ func TestValidatePolicyForNodePolicyAllowSwitchdevWithExternallyManage(t *testin
 	err := validatePolicyForNodePolicy(policy, appliedPolicy)
 	g.Expect(err).NotTo(HaveOccurred())
 }
+
+func TestStaticValidateSriovNetworkNodePolicyWithBridgeBond(t *testing.T) {
+	newPolicy := func(bond *OVSBondConfig) *SriovNetworkNodePolicy {
+		return &SriovNetworkNodePolicy{
+			Spec: SriovNetworkNodePolicySpec{
+				DeviceType:  "netdevice",
+				EswitchMode: "switchdev",
+				NumVfs:      4,
+				Bridge:      Bridge{OVS: &OVSConfig{Bond: bond}},
+				NicSelector: SriovNetworkNicSelector{
+					RootDevices: []string{"0000:86:00.0", "0000:86:00.1"},
+				},
+				NodeSelector: map[string]string{
+					"feature.node.kubernetes.io/network-sriov.capable": "true",
+				},
+				ResourceName: "p0",
+			},
+		}
+	}
+	g := NewGomegaWithT(t)
+
+	ok, err := staticValidateSriovNetworkNodePolicy(newPolicy(&OVSBondConfig{
+		Mode: "balance-tcp", LACP: "active", Members: []string{"0000:86:00.0", "0000:86:00.1"}}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy(&OVSBondConfig{Mode: "balance-tcp"}))
+	g.Expect(err).To(MatchError(ContainSubstring("balance-tcp requires lacp")))
+	g.Expect(ok).To(BeFalse())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy(&OVSBondConfig{Members: []string{"86:00.0"}}))
+	g.Expect(err).To(MatchError(ContainSubstring("not a valid PCI address")))
+	g.Expect(ok).To(BeFalse())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy(&OVSBondConfig{Members: []string{"0000:86:00.0", "0000:86:00.0"}}))
+	g.Expect(err).To(MatchError(ContainSubstring("listed more than once")))
+	g.Expect(ok).To(BeFalse())
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy(&OVSBondConfig{Members: []string{"0000:87:00.0"}}))
+	g.Expect(err).To(MatchError(ContainSubstring("is not in 'nicSelector.rootDevices'")))
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestStaticValidateSriovNetworkNodePolicyWithBridgePorts(t *testing.T) {
+	newPolicy := func(ports ...OVSPortConfig) *SriovNetworkNodePolicy {
+		return &SriovNetworkNodePolicy{
+			Spec: SriovNetworkNodePolicySpec{
+				DeviceType:  "netdevice",
+				EswitchMode: "switchdev",
+				NumVfs:      4,
+				Bridge:      Bridge{OVS: &OVSConfig{Ports: ports}},
+				NicSelector: SriovNetworkNicSelector{
+					RootDevices: []string{"0000:86:00.0"},
+				},
+				NodeSelector: map[string]string{
+					"feature.node.kubernetes.io/network-sriov.capable": "true",
+				},
+				ResourceName: "p0",
+			},
+		}
+	}
+	g := NewGomegaWithT(t)
+
+	ok, err := staticValidateSriovNetworkNodePolicy(newPolicy(
+		OVSPortConfig{Name: "patch-br-int", Type: "patch", Peer: "patch-br-sriov"},
+		OVSPortConfig{Name: "host0", Type: "internal", IPAddresses: []string{"192.168.1.10/24", "fd00::10/64"}, MTURequest: ptr.To(1400)}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	for _, tc := range []struct {
+		port OVSPortConfig
+		err  string
+	}{
+		{OVSPortConfig{Name: "a-very-long-port-name", Type: "internal"}, "must contain 1 to 15 characters"},
+		{OVSPortConfig{Name: "patch-br-int", Type: "patch"}, "requires a peer"},
+		{OVSPortConfig{Name: "patch-br-int", Type: "patch", Peer: "p", IPAddresses: []string{"192.168.1.10/24"}}, "can't be used with patch port"},
+		{OVSPortConfig{Name: "host0", Type: "internal", Peer: "p"}, "peer can be used only with patch ports"},
+		{OVSPortConfig{Name: "host0", Type: "internal", IPAddresses: []string{"192.168.1.10"}}, "is not in CIDR notation"},
+		{OVSPortConfig{Name: "host0", Type: "system"}, "unsupported type"},
+	} {
+		ok, err = staticValidateSriovNetworkNodePolicy(newPolicy(tc.port))
+		g.Expect(err).To(MatchError(ContainSubstring(tc.err)))
+		g.Expect(ok).To(BeFalse())
+	}
+
+	ok, err = staticValidateSriovNetworkNodePolicy(newPolicy(
+		OVSPortConfig{Name: "host0", Type: "internal"}, OVSPortConfig{Name: "host0", Type: "internal"}))
+	g.Expect(err).To(MatchError(ContainSubstring("listed more than once")))
+	g.Expect(ok).To(BeFalse())
+
+	// the ports need a single bridge, the policy can't list multiple PFs without a bond
+	policy := newPolicy(OVSPortConfig{Name: "host0", Type: "internal"})
+	policy.Spec.NicSelector.RootDevices = []string{"0000:86:00.0", "0000:86:00.1"}
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("'bridge.ovs.bond' must be configured")))
+	g.Expect(ok).To(BeFalse())
+
+	policy.Spec.NicSelector = SriovNetworkNicSelector{PfNames: []string{"ens803f0#0-1", "ens803f0#2-3"}}
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	policy.Spec.NicSelector = SriovNetworkNicSelector{PfNames: []string{"ens803f0", "ens803f1"}}
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).To(MatchError(ContainSubstring("'bridge.ovs.bond' must be configured")))
+	g.Expect(ok).To(BeFalse())
+
+	policy.Spec.Bridge.OVS.Bond = &OVSBondConfig{}
+	ok, err = staticValidateSriovNetworkNodePolicy(policy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+}
+
+func TestStaticValidateSriovNetworkNodePolicyWithBridgeQoSAndFlows(t *testing.T) {
+	newPolicy := func(br OVSBridgeConfig) *SriovNetworkNodePolicy {
+		return &SriovNetworkNodePolicy{
+			Spec: SriovNetworkNodePolicySpec{
+				DeviceType:  "netdevice",
+				EswitchMode: "switchdev",
+				NumVfs:      4,
+				Bridge:      Bridge{OVS: &OVSConfig{Bridge: br}},
+				NicSelector: SriovNetworkNicSelector{
+					RootDevices: []string{"0000:86:00.0"},
+				},
+				NodeSelector: map[string]string{
+					"feature.node.kubernetes.io/network-sriov.capable": "true",
+				},
+				ResourceName: "p0",
+			},
+		}
+	}
+	g := NewGomegaWithT(t)
+
+	ok, err := staticValidateSriovNetworkNodePolicy(newPolicy(OVSBridgeConfig{
+		QoS: &OVSQoSConfig{
+			Type:        "linux-htb",
+			OtherConfig: map[string]string{"max-rate": "10000000000"},
+			Queues: []OVSQueueConfig{
+				{ID: 0, OtherConfig: map[string]string{"min-rate": "1000000000"}},
+				{ID: 1, OtherConfig: map[string]string{"max-rate": "5000000000"}},
+			},
+		},
+		Flows: []string{"table=0,priority=100,in_port=pf0,actions=set_queue:1,NORMAL"},
+	}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+
+	for _, tc := range []struct {
+		br  OVSBridgeConfig
+		err string
+	}{
+		{OVSBridgeConfig{QoS: &OVSQoSConfig{Type: "egress-policer", Queues: []OVSQueueConfig{{ID: 0}}}}, "supported only for QoS type linux-htb"},
+		{OVSBridgeConfig{QoS: &OVSQoSConfig{Type: "linux-htb", Queues: []OVSQueueConfig{{ID: 1}, {ID: 1}}}}, "queue 1 is listed more than once"},
+		{OVSBridgeConfig{Flows: []string{" "}}, "flows can't be empty"},
+		{OVSBridgeConfig{Flows: []string{"cookie=0x1,priority=10,actions=drop"}}, "must not set the cookie"},
+	} {
+		ok, err = staticValidateSriovNetworkNodePolicy(newPolicy(tc.br))
+		g.Expect(err).To(MatchError(ContainSubstring(tc.err)))
+		g.Expect(ok).To(BeFalse())
+	}
+}
+
+func TestValidatePolicyForNodeStateWithBridgePortsAndMultiplePFs(t *testing.T) {
+	state := newNodeState()
+	policy := &SriovNetworkNodePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1"},
+		Spec: SriovNetworkNodePolicySpec{
+			DeviceType:  "netdevice",
+			EswitchMode: "switchdev",
+			NicSelector: SriovNetworkNicSelector{
+				RootDevices: []string{"0000:86:00.0", "0000:86:00.1"},
+			},
+			NodeSelector: map[string]string{
+				"feature.node.kubernetes.io/network-sriov.capable": "true",
+			},
+			NumVfs:       4,
+			ResourceName: "p0",
+			Bridge: Bridge{OVS: &OVSConfig{
+				Ports: []OVSPortConfig{{Name: "host0", Type: "internal"}},
+			}},
+		},
+	}
+	g := NewGomegaWithT(t)
+	_, err := validatePolicyForNodeState(policy, state, NewNode())
+	g.Expect(err).To(MatchError(ContainSubstring("'bridge.ovs.bond' must be configured")))
+
+	policy.Spec.Bridge.OVS.Bond = &OVSBondConfig{}
+	_, err = validatePolicyForNodeState(policy, state, NewNode())
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestValidatePolicyForNodeStateWithIntelMsixVectorsOnNonIntelNic(t *testing.T) {
+	state := newNodeState()
+	state.Status.Interfaces[2].Vendor = "15b3"
+	policy := &SriovNetworkNodePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1"},
+		Spec: SriovNetworkNodePolicySpec{
+			DeviceType: "netdevice",
+			NicSelector: SriovNetworkNicSelector{
+				RootDevices: []string{"0000:86:00.2"},
+			},
+			NodeSelector: map[string]string{
+				"feature.node.kubernetes.io/network-sriov.capable": "true",
+			},
+			NumVfs:       4,
+			ResourceName: "p0",
+			Intel:        &IntelConfig{MsixVecPerPfMax: ptr.To(128)},
+		},
+	}
+	g := NewGomegaWithT(t)
+	_, err := validatePolicyForNodeState(policy, state, NewNode())
+	g.Expect(err).To(MatchError(ContainSubstring("'intel.msixVecPerPfMax' in CR p1 can be used only with Intel NICs")))
+
+	policy.Spec.NicSelector.RootDevices = []string{"0000:86:00.0"}
+	_, err = validatePolicyForNodeState(policy, state, NewNode())
+	g.Expect(err).NotTo(HaveOccurred())
+}
@@ -114,6 +114,21 @@ func ValidateCustomResource(ar v1.AdmissionReview) *v1.AdmissionResponse {
 			}
 		}
 
+	case "SriovNetworkNodeOverride":
+		override := sriovnetworkv1.SriovNetworkNodeOverride{}
+
+		err = json.Unmarshal(raw, &override)
+		if err != nil {
+			log.Log.Error(err, "failed to unmarshal object")
+			return toV1AdmissionResponse(err)
+		}
+
+		if reviewResponse.Allowed, reviewResponse.Warnings, err = validateSriovNetworkNodeOverride(&override, ar.Request.Operation); err != nil {
+			reviewResponse.Result = &metav1.Status{
+				Reason: metav1.StatusReason(err.Error()),
+			}
+		}
+
 	case "SriovNetwork":
 		network := sriovnetworkv1.SriovNetwork{}
 